@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/distributed"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rest"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// main runs the coordinator service, which splits the path tracing requests in tiles across ray tracing workers.
+// The workers are the comma separated base URLs of the -workers flag or of the WORKER_ADDRESSES environment variable.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	none
+//
+func main() {
+	address := flag.String("address", ":8080", "Address the coordinator listens on.")
+	workers := flag.String("workers", os.Getenv("WORKER_ADDRESSES"),
+		"Comma separated base URLs of the ray tracing workers, like http://localhost:8081,http://localhost:8082.")
+	tileSize := flag.Int("tile-size", distributed.DefaultTileSize, "Lines and columns of the tiles sent to workers.")
+	maxAttempts := flag.Int("attempts", distributed.DefaultMaxAttempts, "Attempts of each tile before failing.")
+	maxWorkerFailures := flag.Int("worker-failures", distributed.DefaultMaxWorkerFailures,
+		"Tiles in a row a worker fails before it is left out of the render.")
+	retryDelay := flag.Duration("retry-delay", distributed.DefaultRetryDelay, "Wait of a worker after a failed tile.")
+	flag.Parse()
+
+	coordinator, err := distributed.Init(strings.Split(*workers, ","), *tileSize, *maxAttempts,
+		*maxWorkerFailures, *retryDelay)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/path-tracing", rest.RunDistributedPathTracing(coordinator))
+	router.HandleFunc("/validate", rest.ValidateScene).Methods(http.MethodPost)
+
+	server := &http.Server{
+		Handler:      router,
+		Addr:         *address,
+		WriteTimeout: 4 * time.Hour,
+		ReadTimeout:  4 * time.Hour,
+	}
+
+	fmt.Println("Coordinator running with workers", strings.Join(coordinator.GetWorkerAddresses(), ", "))
+
+	err = server.ListenAndServe()
+	log.Fatal(err)
+}
@@ -0,0 +1,140 @@
+package distributed
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultTileSize is the number of lines and columns of the tiles the Coordinator sends to the workers when the
+// service does not choose one.
+const DefaultTileSize = 64
+
+// DefaultMaxAttempts is the number of times the Coordinator tries to render a tile before failing the render when the
+// service does not choose one.
+const DefaultMaxAttempts = 3
+
+// DefaultMaxWorkerFailures is the number of tiles in a row a worker fails before the Coordinator stops sending it tiles
+// when the service does not choose one. It is below DefaultMaxAttempts, so a dead worker alone never fails a render.
+const DefaultMaxWorkerFailures = 2
+
+// DefaultRetryDelay is the time a worker waits after failing a tile before taking another one when the service does
+// not choose one.
+const DefaultRetryDelay = time.Second
+
+// Coordinator is a class for a render split in tiles across ray tracing workers.
+//
+// Members:
+// 	workerAddresses   - The base URLs of the ray tracing workers.
+// 	tileSize          - The number of lines and columns of the tiles sent to the workers.
+// 	maxAttempts       - The number of times a tile is tried before failing the render.
+// 	maxWorkerFailures - The number of tiles in a row a worker fails before it is left out of the render.
+// 	retryDelay        - The time a worker waits after failing a tile, so the other workers take it.
+// 	client            - The HTTP client of the requests to the workers.
+//
+type Coordinator struct {
+	workerAddresses   []string
+	tileSize          int
+	maxAttempts       int
+	maxWorkerFailures int
+	retryDelay        time.Duration
+	client            *http.Client
+}
+
+// GetWorkerAddresses gets the base URLs of the ray tracing workers.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The base URLs.
+//
+func (coordinator *Coordinator) GetWorkerAddresses() []string {
+	return coordinator.workerAddresses
+}
+
+// GetTileSize gets the number of lines and columns of the tiles sent to the workers.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The size of the tiles.
+//
+func (coordinator *Coordinator) GetTileSize() int {
+	return coordinator.tileSize
+}
+
+// GetMaxAttempts gets the number of times a tile is tried before failing the render.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of attempts.
+//
+func (coordinator *Coordinator) GetMaxAttempts() int {
+	return coordinator.maxAttempts
+}
+
+// GetMaxWorkerFailures gets the number of tiles in a row a worker fails before it is left out of the render.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of failures.
+//
+func (coordinator *Coordinator) GetMaxWorkerFailures() int {
+	return coordinator.maxWorkerFailures
+}
+
+// GetRetryDelay gets the time a worker waits after failing a tile.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The delay.
+//
+func (coordinator *Coordinator) GetRetryDelay() time.Duration {
+	return coordinator.retryDelay
+}
+
+// Init initializes a Coordinator.
+//
+// Parameters:
+// 	workerAddresses   - The base URLs of the ray tracing workers, like http://localhost:8081.
+// 	tileSize          - The number of lines and columns of the tiles sent to the workers.
+// 	maxAttempts       - The number of times a tile is tried before failing the render.
+// 	maxWorkerFailures - The number of tiles in a row a worker fails before it is left out of the render.
+// 	retryDelay        - The time a worker waits after failing a tile, so the other workers take it.
+//
+// Returns:
+// 	The Coordinator.
+// 	An error.
+//
+func Init(workerAddresses []string, tileSize, maxAttempts, maxWorkerFailures int, retryDelay time.Duration) (
+	*Coordinator, error) {
+	if len(workerAddresses) == 0 {
+		return nil, workersError()
+	}
+	if tileSize < 1 {
+		return nil, tileSizeError(tileSize)
+	}
+	if maxAttempts < 1 {
+		return nil, maxAttemptsError(maxAttempts)
+	}
+	if maxWorkerFailures < 1 {
+		return nil, maxWorkerFailuresError(maxWorkerFailures)
+	}
+	trimmedAddresses := make([]string, len(workerAddresses))
+	for addressIndex, workerAddress := range workerAddresses {
+		trimmedAddresses[addressIndex] = strings.TrimSuffix(strings.TrimSpace(workerAddress), "/")
+		if trimmedAddresses[addressIndex] == "" {
+			return nil, workersError()
+		}
+	}
+	return &Coordinator{workerAddresses: trimmedAddresses, tileSize: tileSize, maxAttempts: maxAttempts,
+		maxWorkerFailures: maxWorkerFailures, retryDelay: retryDelay, client: &http.Client{}}, nil
+}
@@ -0,0 +1,49 @@
+package distributed
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestCoordinator_Init tests the instantiation of a Coordinator.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestCoordinator_Init(t *testing.T) {
+	coordinator, err := Init([]string{"http://localhost:8081/", " http://localhost:8082"}, 32, 2, 4,
+		time.Millisecond)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]string{"http://localhost:8081", "http://localhost:8082"},
+		coordinator.GetWorkerAddresses()))
+	test_helpers.AssertEqual(t, 32, coordinator.GetTileSize())
+	test_helpers.AssertEqual(t, 2, coordinator.GetMaxAttempts())
+	test_helpers.AssertEqual(t, 4, coordinator.GetMaxWorkerFailures())
+	test_helpers.AssertEqual(t, time.Millisecond, coordinator.GetRetryDelay())
+}
+
+// TestCoordinator_Init_Error tests the instantiation of a Coordinator with invalid settings.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestCoordinator_Init_Error(t *testing.T) {
+	_, err := Init([]string{}, DefaultTileSize, DefaultMaxAttempts, DefaultMaxWorkerFailures, DefaultRetryDelay)
+	test_helpers.AssertNotNilError(t, err)
+	_, err = Init([]string{" "}, DefaultTileSize, DefaultMaxAttempts, DefaultMaxWorkerFailures, DefaultRetryDelay)
+	test_helpers.AssertNotNilError(t, err)
+	_, err = Init([]string{"http://localhost:8081"}, 0, DefaultMaxAttempts, DefaultMaxWorkerFailures, DefaultRetryDelay)
+	test_helpers.AssertNotNilError(t, err)
+	_, err = Init([]string{"http://localhost:8081"}, DefaultTileSize, 0, DefaultMaxWorkerFailures, DefaultRetryDelay)
+	test_helpers.AssertNotNilError(t, err)
+	_, err = Init([]string{"http://localhost:8081"}, DefaultTileSize, DefaultMaxAttempts, 0, DefaultRetryDelay)
+	test_helpers.AssertNotNilError(t, err)
+}
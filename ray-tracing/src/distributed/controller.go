@@ -0,0 +1,281 @@
+package distributed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/path_tracing"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/radiance_matrix"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tile"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Controller is a class for controlling the renders split across ray tracing workers.
+//
+// Members:
+// 	none
+//
+type Controller struct {}
+
+// splitPathTracingJson splits the path tracing request of the whole render in the JSON of everything but its
+// parameters, marshalled once for all the tiles, and its parameters, which change for every tile.
+//
+// Parameters:
+// 	pathTracingJson - The JSON body of the path tracing request of the whole render.
+//
+// Returns:
+// 	The JSON object of the request without the parameters.
+// 	The parameters of the request.
+// 	An error.
+//
+func (*Controller) splitPathTracingJson(pathTracingJson []byte) ([]byte, map[string]interface{}, error) {
+	var pathTracingData map[string]json.RawMessage
+	err := json.Unmarshal(pathTracingJson, &pathTracingData)
+	if err != nil {
+		return nil, nil, err
+	}
+	var parametersData map[string]interface{}
+	err = json.Unmarshal(pathTracingData["pathTracingParameters"], &parametersData)
+	if err != nil {
+		return nil, nil, err
+	}
+	delete(pathTracingData, "pathTracingParameters")
+	sceneJson, err := json.Marshal(pathTracingData)
+	return sceneJson, parametersData, err
+}
+
+// buildTileRequest builds the body of the path tracing request of a tile, the request of the whole render with the
+// window of the tile.
+//
+// Parameters:
+// 	sceneJson      - The JSON object of the request of the whole render without the parameters.
+// 	parametersData - The parameters of the request of the whole render.
+// 	currentTile    - The tile.
+//
+// Returns:
+// 	The JSON body of the request.
+// 	An error.
+//
+func (*Controller) buildTileRequest(sceneJson []byte, parametersData map[string]interface{},
+	currentTile *tile.Tile) ([]byte, error) {
+	tileParametersData := make(map[string]interface{}, len(parametersData))
+	for key, value := range parametersData {
+		tileParametersData[key] = value
+	}
+	tileParametersData["windowStartLine"] = currentTile.GetStartLine()
+	tileParametersData["windowStartColumn"] = currentTile.GetStartColumn()
+	tileParametersData["windowEndLine"] = currentTile.GetEndLine()
+	tileParametersData["windowEndColumn"] = currentTile.GetEndColumn()
+	parametersJson, err := json.Marshal(tileParametersData)
+	if err != nil {
+		return nil, err
+	}
+
+	// The parameters open the object, followed by the members of the scene after its opening brace.
+	var tileRequest bytes.Buffer
+	tileRequest.Grow(len(sceneJson) + len(parametersJson) + 32)
+	tileRequest.WriteString(`{"pathTracingParameters":`)
+	tileRequest.Write(parametersJson)
+	if len(sceneJson) > len("{}") {
+		tileRequest.WriteByte(',')
+	}
+	tileRequest.Write(sceneJson[1:])
+	return tileRequest.Bytes(), nil
+}
+
+// renderTile sends the request of a tile to a worker, which answers with the radiance of the pixels of the tile as a
+// PFM image.
+//
+// Parameters:
+// 	ctx           - The context that cancels the request.
+// 	coordinator   - The Coordinator.
+// 	workerAddress - The base URL of the worker.
+// 	tileRequest   - The JSON body of the request of the tile.
+//
+// Returns:
+// 	The RadianceMatrix of the tile.
+// 	An error.
+//
+func (*Controller) renderTile(ctx context.Context, coordinator *Coordinator, workerAddress string,
+	tileRequest []byte) (*radiance_matrix.RadianceMatrix, error) {
+	request, err := http.NewRequest(http.MethodPost, workerAddress+"/path-tracing?crop=window",
+		bytes.NewReader(tileRequest))
+	if err != nil {
+		return nil, err
+	}
+	request = request.WithContext(ctx)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", radiance_matrix.PfmFormat)
+
+	response, err := coordinator.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, workerStatusError(workerAddress, response.StatusCode)
+	}
+	radianceMatrixController := radiance_matrix.Controller{}
+	return radianceMatrixController.DecodePfm(response.Body)
+}
+
+// copyTile copies the radiance of the pixels of a tile to the RadianceMatrix of the screen.
+//
+// Parameters:
+// 	source      - The RadianceMatrix of the tile.
+// 	target      - The RadianceMatrix of the screen.
+// 	currentTile - The tile.
+//
+// Returns:
+// 	none
+//
+func (*Controller) copyTile(source, target *radiance_matrix.RadianceMatrix, currentTile *tile.Tile) {
+	radiance := make([]float64, 3)
+	for lineIndex, line := range source.GetRadiances() {
+		for columnIndex, sourceRadiance := range line {
+			for colorIndex := 0; colorIndex < 3; colorIndex++ {
+				radiance[colorIndex] = float64(sourceRadiance[colorIndex])
+			}
+			_ = target.SetRadiance(currentTile.GetStartLine()+lineIndex, currentTile.GetStartColumn()+columnIndex,
+				radiance)
+		}
+	}
+}
+
+// Run runs a path tracing split in tiles of the window of the request, which the workers take from a channel.
+// A failed tile goes back to the channel, and the worker that failed it waits before taking another tile so the
+// other workers retry it. A worker that fails too many tiles in a row is left out of the render. The render fails
+// once a tile fails in all its attempts or all workers were left out.
+//
+// Parameters:
+// 	ctx             - The context that cancels the render.
+// 	coordinator     - The Coordinator.
+// 	pathTracingJson - The JSON body of the path tracing request, sent to the workers with the window of each tile.
+// 	pathTracer      - The PathTracer parsed from the path tracing data.
+// 	parameters      - The parameters parsed from the path tracing data.
+//
+// Returns:
+// 	The radiance matrix representing the rendered image.
+// 	An error.
+//
+func (controller *Controller) Run(ctx context.Context, coordinator *Coordinator,
+	pathTracingJson []byte, pathTracer *path_tracing.PathTracer,
+	parameters *path_tracing.Parameters) (*radiance_matrix.RadianceMatrix, error) {
+	pathTracingController := path_tracing.Controller{}
+	err := pathTracingController.ValidateParameters(pathTracer, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	tileController := tile.Controller{}
+	tiles, err := tileController.SplitWindow(parameters.GetWindowStartLine(), parameters.GetWindowStartColumn(),
+		parameters.GetWindowEndLine(), parameters.GetWindowEndColumn(), coordinator.GetTileSize())
+	if err != nil {
+		return nil, err
+	}
+	radianceMatrix := radiance_matrix.Init(pathTracer.GetPixelScreen())
+	if len(tiles) == 0 {
+		return radianceMatrix, nil
+	}
+	sceneJson, parametersData, err := controller.splitPathTracingJson(pathTracingJson)
+	if err != nil {
+		return nil, err
+	}
+
+	renderContext, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var radianceMatrixMutex sync.Mutex
+	remainingTiles := int64(len(tiles))
+	var renderError error
+	var failOnce sync.Once
+
+	// Each tile index is owned by the worker that took it from the channel, which alone updates its attempts.
+	attempts := make([]int, len(tiles))
+	tilesChannel := make(chan int, len(tiles))
+	for tileIndex := range tiles {
+		tilesChannel <- tileIndex
+	}
+
+	healthyWorkers := int64(len(coordinator.GetWorkerAddresses()))
+	var workers sync.WaitGroup
+	for _, workerAddress := range coordinator.GetWorkerAddresses() {
+		workers.Add(1)
+		go func(workerAddress string) {
+			defer workers.Done()
+			consecutiveFailures := 0
+			for {
+				var tileIndex int
+				select {
+				case <-renderContext.Done():
+					return
+				case tileIndex = <-tilesChannel:
+				}
+
+				currentTile := tiles[tileIndex]
+				tileRequest, err := controller.buildTileRequest(sceneJson, parametersData, currentTile)
+				if err != nil {
+					failOnce.Do(func() {
+						renderError = err
+						cancel()
+					})
+					return
+				}
+				tileRadiance, err := controller.renderTile(renderContext, coordinator, workerAddress, tileRequest)
+				if err == nil && (tileRadiance.Lines() != currentTile.GetEndLine()-currentTile.GetStartLine() ||
+					tileRadiance.Columns() != currentTile.GetEndColumn()-currentTile.GetStartColumn()) {
+					err = workerTileSizeError(workerAddress, currentTile)
+				}
+				if err == nil {
+					consecutiveFailures = 0
+					radianceMatrixMutex.Lock()
+					controller.copyTile(tileRadiance, radianceMatrix, currentTile)
+					radianceMatrixMutex.Unlock()
+					if atomic.AddInt64(&remainingTiles, -1) == 0 {
+						cancel()
+					}
+					continue
+				}
+				if renderContext.Err() != nil {
+					return
+				}
+
+				attempts[tileIndex]++
+				if attempts[tileIndex] >= coordinator.GetMaxAttempts() {
+					failOnce.Do(func() {
+						renderError = tileFailedError(currentTile, attempts[tileIndex], err)
+						cancel()
+					})
+					return
+				}
+				tilesChannel <- tileIndex
+				consecutiveFailures++
+				if consecutiveFailures >= coordinator.GetMaxWorkerFailures() {
+					if atomic.AddInt64(&healthyWorkers, -1) == 0 {
+						failOnce.Do(func() {
+							renderError = workersFailedError(consecutiveFailures, err)
+							cancel()
+						})
+					}
+					return
+				}
+				select {
+				case <-renderContext.Done():
+					return
+				case <-time.After(coordinator.GetRetryDelay()):
+				}
+			}
+		}(workerAddress)
+	}
+	workers.Wait()
+
+	if renderError != nil {
+		return nil, renderError
+	}
+	if atomic.LoadInt64(&remainingTiles) > 0 {
+		return nil, ctx.Err()
+	}
+	return radianceMatrix, nil
+}
@@ -0,0 +1,354 @@
+package distributed
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/marshaller"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/path_tracing"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/radiance_matrix"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/screen"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tile"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// sphereRequest is the body of a path tracing request of a screen of 9x7 pixels whose camera looks at a diffuse
+// sphere lit by a wall that is a light.
+const sphereRequest = `{
+	"pathTracingParameters": {"raysPerPixel": 4, "recursions": 3, "windowStartLine": 1, "windowStartColumn": 2,
+		"windowEndLine": 7, "windowEndColumn": 8, "estimator": "unbiased", "seed": 5},
+	"pixelScreen": {"width": 9, "height": 7},
+	"sceneCamera": {
+		"position": {"coordinates": [0, 0, 0]},
+		"look": {"coordinates": [1, 0, 0]},
+		"up": {"coordinates": [0, 0, 1]},
+		"right": {"coordinates": [0, -1, 0]},
+		"fieldOfView": 60,
+		"distanceToScreen": 1
+	},
+	"lights": [{
+		"lightIntensity": 2,
+		"color": [1, 0.5, 0.25],
+		"lightObject": {
+			"name": "wall",
+			"lightCharacteristics": {"color": [0.5, 0.5, 0.5], "specularReflection": 0, "roughNess": 0,
+				"transmissionReflection": 0, "diffuseReflection": 1},
+			"normals": [{"coordinates": [-1, 0, 0]}],
+			"repository": {"points": [{"coordinates": [10, -100, -100]}, {"coordinates": [10, 100, -100]},
+				{"coordinates": [10, 100, 100]}, {"coordinates": [10, -100, 100]}]},
+			"triangles": [{"verticesIndices": [0, 1, 2], "verticesNormalsIndices": [0, 0, 0]},
+				{"verticesIndices": [0, 2, 3], "verticesNormalsIndices": [0, 0, 0]}]
+		}
+	}],
+	"objects": [{
+		"name": "sphere",
+		"lightCharacteristics": {"color": [0.8, 0.8, 0.8], "specularReflection": 0, "roughNess": 0,
+			"transmissionReflection": 0, "diffuseReflection": 1},
+		"normals": [],
+		"repository": {"points": [{"coordinates": [4, 0, 0]}]},
+		"triangles": [],
+		"spheres": [{"centerPointIndex": 0, "radius": 1}]
+	}]
+}`
+
+// parseRequest parses a path tracing request to a map.
+//
+// Parameters:
+//  t       - Test instance.
+//  request - The JSON request.
+//
+// Returns:
+//  The path tracing data.
+//
+func parseRequest(t *testing.T, request string) map[string]interface{} {
+	var pathTracingData map[string]interface{}
+	test_helpers.AssertNilError(t, json.Unmarshal([]byte(request), &pathTracingData))
+	return pathTracingData
+}
+
+// startWorker starts a ray tracing worker on localhost, whose first requests fail, that crops its images to the window
+// of the request when asked to.
+//
+// Parameters:
+//  t              - Test instance.
+//  failedRequests - The number of requests that fail before the worker renders.
+//  requests       - The counter of the requests the worker received.
+//
+// Returns:
+//  The server of the worker.
+//
+func startWorker(t *testing.T, failedRequests int64, requests *int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		if atomic.AddInt64(requests, 1) <= failedRequests {
+			http.Error(responseWriter, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		var pathTracingData map[string]interface{}
+		if json.NewDecoder(request.Body).Decode(&pathTracingData) != nil {
+			http.Error(responseWriter, "invalid request", http.StatusBadRequest)
+			return
+		}
+		marshallerController := &marshaller.Controller{}
+		pathTracer, parameters, err := marshallerController.ParsePathTracingFromMap(pathTracingData)
+		if err != nil {
+			http.Error(responseWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+		pathTracingController := path_tracing.Controller{}
+		radianceMatrix, err := pathTracingController.RunRadiance(request.Context(), pathTracer, parameters)
+		if err != nil {
+			http.Error(responseWriter, err.Error(), http.StatusBadRequest)
+			return
+		}
+		radianceMatrixController := radiance_matrix.Controller{}
+		if request.URL.Query().Get("crop") == "window" {
+			radianceMatrix, err = radianceMatrixController.Crop(radianceMatrix, parameters.GetWindowStartLine(),
+				parameters.GetWindowStartColumn(), parameters.GetWindowEndLine(), parameters.GetWindowEndColumn())
+			test_helpers.AssertNilError(t, err)
+		}
+		responseWriter.Header().Set("Content-Type", radiance_matrix.PfmFormat)
+		test_helpers.AssertNilError(t, radianceMatrixController.EncodePfm(radianceMatrix, responseWriter))
+	}))
+}
+
+// runCoordinator parses a path tracing request and runs it with a Coordinator.
+//
+// Parameters:
+//  t           - Test instance.
+//  ctx         - The context of the render.
+//  coordinator - The Coordinator.
+//  request     - The JSON request.
+//
+// Returns:
+//  The RadianceMatrix.
+//  An error.
+//
+func runCoordinator(t *testing.T, ctx context.Context, coordinator *Coordinator, request string) (
+	*radiance_matrix.RadianceMatrix, error) {
+	controller := Controller{}
+	pathTracingData := parseRequest(t, request)
+	marshallerController := &marshaller.Controller{}
+	pathTracer, parameters, err := marshallerController.ParsePathTracingFromMap(pathTracingData)
+	test_helpers.AssertNilError(t, err)
+	return controller.Run(ctx, coordinator, []byte(request), pathTracer, parameters)
+}
+
+// renderLocally renders a path tracing request without workers.
+//
+// Parameters:
+//  t       - Test instance.
+//  request - The JSON request.
+//
+// Returns:
+//  The RadianceMatrix.
+//
+func renderLocally(t *testing.T, request string) *radiance_matrix.RadianceMatrix {
+	marshallerController := &marshaller.Controller{}
+	pathTracer, parameters, err := marshallerController.ParsePathTracingFromMap(parseRequest(t, request))
+	test_helpers.AssertNilError(t, err)
+	pathTracingController := path_tracing.Controller{}
+	radianceMatrix, err := pathTracingController.RunRadiance(context.Background(), pathTracer, parameters)
+	test_helpers.AssertNilError(t, err)
+	return radianceMatrix
+}
+
+// TestController_BuildTileRequest tests that the request of a tile only changes the window of the render.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_BuildTileRequest(t *testing.T) {
+	controller := Controller{}
+	sceneJson, parametersData, err := controller.splitPathTracingJson([]byte(sphereRequest))
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, nil, parseRequest(t, string(sceneJson))["pathTracingParameters"])
+	currentTile, err := tile.Init(2, 3, 4, 6)
+	test_helpers.AssertNilError(t, err)
+
+	tileRequest, err := controller.buildTileRequest(sceneJson, parametersData, currentTile)
+	test_helpers.AssertNilError(t, err)
+	tileData := parseRequest(t, string(tileRequest))
+	tileParameters := tileData["pathTracingParameters"].(map[string]interface{})
+	test_helpers.AssertEqual(t, 2.0, tileParameters["windowStartLine"])
+	test_helpers.AssertEqual(t, 3.0, tileParameters["windowStartColumn"])
+	test_helpers.AssertEqual(t, 4.0, tileParameters["windowEndLine"])
+	test_helpers.AssertEqual(t, 6.0, tileParameters["windowEndColumn"])
+	test_helpers.AssertEqual(t, 5.0, tileParameters["seed"])
+	test_helpers.AssertEqual(t, 1.0, parametersData["windowStartLine"])
+	test_helpers.AssertEqual(t, 9.0, tileData["pixelScreen"].(map[string]interface{})["width"])
+	test_helpers.AssertEqual(t, len(parseRequest(t, sphereRequest)), len(tileData))
+}
+
+// TestController_Run tests that the tiles rendered by many workers merge to the image rendered without workers.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Run(t *testing.T) {
+	requests := make([]int64, 3)
+	workerAddresses := make([]string, len(requests))
+	for workerIndex := range requests {
+		worker := startWorker(t, 0, &requests[workerIndex])
+		defer worker.Close()
+		workerAddresses[workerIndex] = worker.URL
+	}
+	coordinator, err := Init(workerAddresses, 2, DefaultMaxAttempts, DefaultMaxWorkerFailures, time.Millisecond)
+	test_helpers.AssertNilError(t, err)
+
+	radianceMatrix, err := runCoordinator(t, context.Background(), coordinator, sphereRequest)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, renderLocally(t, sphereRequest).IsEqual(radianceMatrix))
+	test_helpers.AssertEqual(t, int64(9), requests[0]+requests[1]+requests[2])
+}
+
+// TestController_Run_Retry tests that the tiles failed by a worker are rendered again.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Run_Retry(t *testing.T) {
+	var flakyRequests, healthyRequests int64
+	flakyWorker := startWorker(t, 2, &flakyRequests)
+	defer flakyWorker.Close()
+	healthyWorker := startWorker(t, 0, &healthyRequests)
+	defer healthyWorker.Close()
+	coordinator, err := Init([]string{flakyWorker.URL, healthyWorker.URL}, 2, 3, 3, time.Millisecond)
+	test_helpers.AssertNilError(t, err)
+
+	radianceMatrix, err := runCoordinator(t, context.Background(), coordinator, sphereRequest)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, renderLocally(t, sphereRequest).IsEqual(radianceMatrix))
+	test_helpers.AssertEqual(t, true, atomic.LoadInt64(&flakyRequests) >= 2)
+}
+
+// TestController_Run_TileFailedError tests that the render fails once a tile fails in all its attempts.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Run_TileFailedError(t *testing.T) {
+	var requests int64
+	deadWorker := startWorker(t, 1000, &requests)
+	defer deadWorker.Close()
+	coordinator, err := Init([]string{deadWorker.URL}, 2, 3, 1000, time.Millisecond)
+	test_helpers.AssertNilError(t, err)
+
+	_, err = runCoordinator(t, context.Background(), coordinator, sphereRequest)
+	test_helpers.AssertNotNilError(t, err)
+	// The failed tiles go to the end of the queue, so the first of the 9 tiles fails its third time after all the
+	// others failed twice.
+	test_helpers.AssertEqual(t, int64(19), atomic.LoadInt64(&requests))
+}
+
+// TestController_Run_DeadWorker tests that a worker that fails every tile is left out of the render, which the healthy
+// worker completes.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Run_DeadWorker(t *testing.T) {
+	var deadRequests, healthyRequests int64
+	deadWorker := startWorker(t, 1000, &deadRequests)
+	defer deadWorker.Close()
+	healthyWorker := startWorker(t, 0, &healthyRequests)
+	defer healthyWorker.Close()
+	coordinator, err := Init([]string{deadWorker.URL, healthyWorker.URL}, 2, DefaultMaxAttempts,
+		DefaultMaxWorkerFailures, time.Millisecond)
+	test_helpers.AssertNilError(t, err)
+
+	radianceMatrix, err := runCoordinator(t, context.Background(), coordinator, sphereRequest)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, renderLocally(t, sphereRequest).IsEqual(radianceMatrix))
+	test_helpers.AssertEqual(t, true, atomic.LoadInt64(&deadRequests) <= int64(DefaultMaxWorkerFailures))
+	test_helpers.AssertEqual(t, int64(9), atomic.LoadInt64(&healthyRequests))
+}
+
+// TestController_Run_WorkersFailedError tests that the render fails once all its workers were left out.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Run_WorkersFailedError(t *testing.T) {
+	var requests int64
+	deadWorker := startWorker(t, 1000, &requests)
+	defer deadWorker.Close()
+	coordinator, err := Init([]string{deadWorker.URL}, 2, DefaultMaxAttempts, DefaultMaxWorkerFailures,
+		time.Millisecond)
+	test_helpers.AssertNilError(t, err)
+
+	_, err = runCoordinator(t, context.Background(), coordinator, sphereRequest)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, int64(DefaultMaxWorkerFailures), atomic.LoadInt64(&requests))
+}
+
+// TestController_Run_WorkerTileSizeError tests that the images of another size than their tiles are failed attempts.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Run_WorkerTileSizeError(t *testing.T) {
+	var requests int64
+	worker := httptest.NewServer(http.HandlerFunc(func(responseWriter http.ResponseWriter, request *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		radianceMatrixController := radiance_matrix.Controller{}
+		renderedScreen, err := screen.Init(9, 7)
+		test_helpers.AssertNilError(t, err)
+		responseWriter.Header().Set("Content-Type", radiance_matrix.PfmFormat)
+		test_helpers.AssertNilError(t, radianceMatrixController.EncodePfm(radiance_matrix.Init(renderedScreen),
+			responseWriter))
+	}))
+	defer worker.Close()
+	coordinator, err := Init([]string{worker.URL}, 2, 1, DefaultMaxWorkerFailures, time.Millisecond)
+	test_helpers.AssertNilError(t, err)
+
+	_, err = runCoordinator(t, context.Background(), coordinator, sphereRequest)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, int64(1), atomic.LoadInt64(&requests))
+}
+
+// TestController_Run_Canceled tests that a canceled render stops with the error of the context.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Run_Canceled(t *testing.T) {
+	var requests int64
+	worker := startWorker(t, 0, &requests)
+	defer worker.Close()
+	coordinator, err := Init([]string{worker.URL}, 2, DefaultMaxAttempts, DefaultMaxWorkerFailures,
+		time.Millisecond)
+	test_helpers.AssertNilError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = runCoordinator(t, ctx, coordinator, sphereRequest)
+	test_helpers.AssertEqual(t, context.Canceled, err)
+}
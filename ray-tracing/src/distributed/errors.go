@@ -0,0 +1,119 @@
+package distributed
+
+import (
+	"errors"
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tile"
+)
+
+// workersError is the error where a Coordinator has no workers.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+//  An Error.
+//
+func workersError() error {
+	return errors.New("Invalid workers. Expected at least one worker address")
+}
+
+// tileSizeError is the error where the size of the tiles of a Coordinator is not positive.
+//
+// Parameters:
+// 	tileSize - The size of the tiles.
+//
+// Returns:
+//  An Error.
+//
+func tileSizeError(tileSize int) error {
+	errorMessage := fmt.Sprintf("Invalid tile size %d. Expected at least 1", tileSize)
+	return errors.New(errorMessage)
+}
+
+// maxAttemptsError is the error where a Coordinator would not try the tiles.
+//
+// Parameters:
+// 	maxAttempts - The number of attempts.
+//
+// Returns:
+//  An Error.
+//
+func maxAttemptsError(maxAttempts int) error {
+	errorMessage := fmt.Sprintf("Invalid number of attempts %d. Expected at least 1", maxAttempts)
+	return errors.New(errorMessage)
+}
+
+// maxWorkerFailuresError is the error where a Coordinator would leave its workers out before they render a tile.
+//
+// Parameters:
+// 	maxWorkerFailures - The number of failures.
+//
+// Returns:
+//  An Error.
+//
+func maxWorkerFailuresError(maxWorkerFailures int) error {
+	errorMessage := fmt.Sprintf("Invalid number of worker failures %d. Expected at least 1", maxWorkerFailures)
+	return errors.New(errorMessage)
+}
+
+// workerStatusError is the error where a worker did not render a tile.
+//
+// Parameters:
+// 	workerAddress - The base URL of the worker.
+// 	statusCode    - The status code of the response of the worker.
+//
+// Returns:
+//  An Error.
+//
+func workerStatusError(workerAddress string, statusCode int) error {
+	errorMessage := fmt.Sprintf("Worker %s answered with status %d", workerAddress, statusCode)
+	return errors.New(errorMessage)
+}
+
+// workerTileSizeError is the error where a worker rendered an image of another size than its tile.
+//
+// Parameters:
+// 	workerAddress - The base URL of the worker.
+// 	currentTile   - The tile.
+//
+// Returns:
+//  An Error.
+//
+func workerTileSizeError(workerAddress string, currentTile *tile.Tile) error {
+	errorMessage := fmt.Sprintf("Worker %s rendered an image of another size than the tile from %d %d to %d %d",
+		workerAddress, currentTile.GetStartLine(), currentTile.GetStartColumn(), currentTile.GetEndLine(),
+		currentTile.GetEndColumn())
+	return errors.New(errorMessage)
+}
+
+// tileFailedError is the error where a tile failed in all its attempts.
+//
+// Parameters:
+// 	failedTile - The tile.
+// 	attempts   - The number of attempts.
+// 	lastError  - The error of the last attempt.
+//
+// Returns:
+//  An Error.
+//
+func tileFailedError(failedTile *tile.Tile, attempts int, lastError error) error {
+	errorMessage := fmt.Sprintf("Tile from %d %d to %d %d failed after %d attempts: %v",
+		failedTile.GetStartLine(), failedTile.GetStartColumn(), failedTile.GetEndLine(), failedTile.GetEndColumn(),
+		attempts, lastError)
+	return errors.New(errorMessage)
+}
+
+// workersFailedError is the error where all workers were left out of a render after failing tiles in a row.
+//
+// Parameters:
+// 	failures  - The number of tiles in a row the last worker failed.
+// 	lastError - The error of the last failure.
+//
+// Returns:
+//  An Error.
+//
+func workersFailedError(failures int, lastError error) error {
+	errorMessage := fmt.Sprintf("All workers failed %d tiles in a row: %v", failures, lastError)
+	return errors.New(errorMessage)
+}
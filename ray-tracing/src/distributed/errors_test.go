@@ -0,0 +1,134 @@
+package distributed
+
+import (
+	"errors"
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tile"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestCoordinator_WorkersError tests the error where a Coordinator has no workers.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestCoordinator_WorkersError(t *testing.T) {
+	err := workersError()
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, "Invalid workers. Expected at least one worker address", err.Error())
+}
+
+// TestCoordinator_TileSizeError tests the error where the size of the tiles of a Coordinator is not positive.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestCoordinator_TileSizeError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid tile size %d. Expected at least 1", 0)
+	err := tileSizeError(0)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestCoordinator_MaxAttemptsError tests the error where a Coordinator would not try the tiles.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestCoordinator_MaxAttemptsError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid number of attempts %d. Expected at least 1", 0)
+	err := maxAttemptsError(0)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestCoordinator_MaxWorkerFailuresError tests the error where a Coordinator would leave its workers out before they
+// render a tile.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestCoordinator_MaxWorkerFailuresError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid number of worker failures %d. Expected at least 1", 0)
+	err := maxWorkerFailuresError(0)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestCoordinator_WorkerStatusError tests the error where a worker did not render a tile.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestCoordinator_WorkerStatusError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Worker %s answered with status %d", "http://localhost:8081", 500)
+	err := workerStatusError("http://localhost:8081", 500)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestCoordinator_WorkerTileSizeError tests the error where a worker rendered an image of another size than its tile.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestCoordinator_WorkerTileSizeError(t *testing.T) {
+	currentTile, err := tile.Init(0, 2, 4, 6)
+	test_helpers.AssertNilError(t, err)
+	expectedErrorMessage := fmt.Sprintf("Worker %s rendered an image of another size than the tile from 0 2 to 4 6",
+		"http://localhost:8081")
+	err = workerTileSizeError("http://localhost:8081", currentTile)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestCoordinator_TileFailedError tests the error where a tile failed in all its attempts.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestCoordinator_TileFailedError(t *testing.T) {
+	failedTile, err := tile.Init(0, 2, 4, 6)
+	test_helpers.AssertNilError(t, err)
+	expectedErrorMessage := fmt.Sprintf("Tile from %d %d to %d %d failed after %d attempts: %v", 0, 2, 4, 6, 3,
+		"timeout")
+	err = tileFailedError(failedTile, 3, errors.New("timeout"))
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestCoordinator_WorkersFailedError tests the error where all workers were left out of a render.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestCoordinator_WorkersFailedError(t *testing.T) {
+	err := workersFailedError(2, errors.New("unavailable"))
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, "All workers failed 2 tiles in a row: unavailable", err.Error())
+}
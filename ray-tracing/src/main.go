@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"github.com/gorilla/mux"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rest"
@@ -10,12 +11,22 @@ import (
 )
 
 func main() {
+	address := flag.String("address", ":8081", "Address the ray tracing service listens on.")
+	flag.Parse()
+
 	router := mux.NewRouter()
 	router.HandleFunc("/path-tracing", rest.RunPathTracing)
+	router.HandleFunc("/validate", rest.ValidateScene).Methods(http.MethodPost)
+	router.HandleFunc("/jobs", rest.SubmitJob).Methods(http.MethodPost)
+	router.HandleFunc("/jobs/{id}", rest.GetJob).Methods(http.MethodGet)
+	router.HandleFunc("/jobs/{id}", rest.CancelJob).Methods(http.MethodDelete)
+	router.HandleFunc("/jobs/{id}/result", rest.GetJobResult).Methods(http.MethodGet)
+	router.HandleFunc("/jobs/{id}/estimate", rest.GetJobEstimate).Methods(http.MethodGet)
+	router.HandleFunc("/jobs/{id}/events", rest.StreamJobEvents).Methods(http.MethodGet)
 
 	server := &http.Server{
 		Handler:      router,
-		Addr:         ":8081",
+		Addr:         *address,
 		WriteTimeout: 4 * time.Hour,
 		ReadTimeout:  4 * time.Hour,
 	}
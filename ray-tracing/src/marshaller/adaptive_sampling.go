@@ -0,0 +1,29 @@
+package marshaller
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/path_tracing"
+)
+
+// parseAdaptiveSamplingFromDTO parses the optional adaptive sampling of the path tracing parameters from its DTO.
+//
+// Parameters:
+//  path                - The JSON path of the adaptive sampling.
+//  adaptiveSamplingDTO - The adaptive sampling as a DTO, nil if there is none.
+//
+// Returns:
+// 	The AdaptiveSampling, or nil.
+// 	An error.
+//
+func (*Controller) parseAdaptiveSamplingFromDTO(path string, adaptiveSamplingDTO *AdaptiveSamplingDTO) (
+	*path_tracing.AdaptiveSampling, error) {
+	if adaptiveSamplingDTO == nil {
+		return nil, nil
+	}
+
+	adaptiveSampling, err := path_tracing.InitAdaptiveSampling(*adaptiveSamplingDTO.MinimumSamples,
+		*adaptiveSamplingDTO.MaximumSamples, *adaptiveSamplingDTO.NoiseThreshold)
+	if err != nil {
+		return nil, invalidParameterError(path, err)
+	}
+	return adaptiveSampling, nil
+}
@@ -2,8 +2,14 @@ package marshaller
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/color_matrix"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/path_tracing"
+	"reflect"
+	"strconv"
+	"strings"
 )
 
 // Controller is a class for controlling the marshaller of the application.
@@ -26,52 +32,247 @@ func (controller *Controller) ColorMatrixToJson(colorMatrix *color_matrix.ColorM
 	dtoColorMatrix := ColorMatrixDTO{Colors: colorMatrix.GetColors()}
 	return json.Marshal(dtoColorMatrix)
 }
-// ParsePathTracingFromMap parses the inputs for a path tracing run.
+
+// ObjectsToJson parses objects to the JSON of the objects of a path tracing run.
+// Objects with an explicit material can not be parsed.
+//
+// Parameters:
+//  objects - The objects.
+//
+// Returns:
+// 	The JSON.
+// 	An error.
+//
+func (controller *Controller) ObjectsToJson(objects []*object.Object) ([]byte, error) {
+	dtoObjects := ObjectsDTO{Objects: make([]ObjectDTO, len(objects))}
+	for objectIndex, currentObject := range objects {
+		characteristics := currentObject.GetLightCharacteristics()
+		if characteristics.GetMaterial() != nil {
+			return nil, errors.New("unable to parse object with explicit material")
+		}
+
+		repository := currentObject.GetRepository()
+		points := make([]CoordinatesDTO, repository.NumberOfPoints())
+		for pointIndex := range points {
+			currentPoint, _ := repository.GetPoint(pointIndex)
+			coordinates := make([]float64, currentPoint.Dimension())
+			for coordinateIndex := range coordinates {
+				coordinates[coordinateIndex], _ = currentPoint.GetCoordinate(coordinateIndex)
+			}
+			points[pointIndex] = CoordinatesDTO{Coordinates: coordinates}
+		}
+
+		triangles := make([]TriangleDTO, len(currentObject.GetTriangles()))
+		for triangleIndex, currentTriangle := range currentObject.GetTriangles() {
+			verticesIndices := make([]int, 3)
+			verticesNormalsIndices := make([]int, 3)
+			for vertexIndex := 0; vertexIndex < 3; vertexIndex++ {
+				verticesIndices[vertexIndex], _ = currentTriangle.GetVertexIndex(vertexIndex)
+				verticesNormalsIndices[vertexIndex], _ = currentTriangle.GetVertexNormalIndex(vertexIndex)
+			}
+			triangles[triangleIndex] = TriangleDTO{VerticesIndices: verticesIndices,
+				VerticesNormalsIndices: verticesNormalsIndices}
+		}
+
+		spheres := make([]SphereDTO, len(currentObject.GetSpheres()))
+		for sphereIndex, currentSphere := range currentObject.GetSpheres() {
+			spheres[sphereIndex] = SphereDTO{CenterPointIndex: currentSphere.GetCenterPointIndex(),
+				Radius: currentSphere.GetRadius()}
+		}
+
+		normals := make([]CoordinatesDTO, len(currentObject.GetNormals()))
+		for normalIndex, currentNormal := range currentObject.GetNormals() {
+			normals[normalIndex] = CoordinatesDTO{Coordinates: currentNormal.CopyAllCoordinates()}
+		}
+
+		dtoObjects.Objects[objectIndex] = ObjectDTO{
+			Name:       currentObject.GetName(),
+			Repository: RepositoryDTO{Points: points},
+			Triangles:  triangles,
+			Spheres:    spheres,
+			Normals:    normals,
+			LightCharacteristics: LightCharacteristicsDTO{
+				Color:                  characteristics.GetColor(),
+				SpecularReflection:     characteristics.GetSpecularReflection(),
+				RoughNess:              characteristics.GetRoughNess(),
+				TransmissionReflection: characteristics.GetTransmissionReflection(),
+				DiffuseReflection:      characteristics.GetDiffuseReflection(),
+				RefractiveIndex:        characteristics.GetRefractiveIndex(),
+			},
+		}
+	}
+	return json.Marshal(dtoObjects)
+}
+
+// jsonTypeName gets the name of the JSON type a Go type is decoded from.
+//
+// Parameters:
+//  goType - The Go type.
+//
+// Returns:
+// 	The name of the JSON type.
+//
+func jsonTypeName(goType reflect.Type) string {
+	switch goType.Kind() {
+	case reflect.Ptr:
+		return jsonTypeName(goType.Elem())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	}
+	return "object"
+}
+
+// decodedFieldPath converts the path of a field from the JSON decoder, like objects.3.name, to a JSON path.
+//
+// Parameters:
+//  decodedField - The path of the field from the decoder.
+//
+// Returns:
+// 	The JSON path, like objects[3].name.
+//
+func decodedFieldPath(decodedField string) string {
+	path := ""
+	for _, segment := range strings.Split(decodedField, ".") {
+		if index, err := strconv.Atoi(segment); err == nil {
+			path = indexPath(path, index)
+		} else {
+			path = fieldPath(path, segment)
+		}
+	}
+	return path
+}
+
+// decodingError converts an error of the decoding of the JSON of a path tracing run to a SchemaError.
+// Only the first value of the wrong type is reported.
+//
+// Parameters:
+//  err - The error of the decoding.
+//
+// Returns:
+// 	The SchemaError.
+//
+func decodingError(err error) error {
+	var syntaxError *json.SyntaxError
+	var typeError *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxError):
+		return schemaError([]*FieldError{initFieldError("",
+			fmt.Sprintf("invalid JSON at offset %d: %s", syntaxError.Offset, syntaxError.Error()))})
+	case errors.As(err, &typeError):
+		return schemaError([]*FieldError{initFieldError(decodedFieldPath(typeError.Field),
+			fmt.Sprintf("expected %s, got %s", jsonTypeName(typeError.Type), typeError.Value))})
+	}
+	return invalidFieldError("", err)
+}
+
+// ParsePathTracingFromJson parses the inputs for a path tracing run from their JSON.
+// The JSON is decoded to a PathTracingDTO and validated before it is parsed, and every error is a SchemaError with
+// the JSON paths of the invalid fields, or a ParametersError when only the parameters can not run the path tracing.
+//
+// Parameters:
+//  pathTracingJson - The JSON of the path tracing data.
+//
+// Returns:
+// 	The PathTracer.
+// 	The parameters of the path tracing.
+// 	An error.
+//
+func (controller *Controller) ParsePathTracingFromJson(pathTracingJson []byte) (*path_tracing.PathTracer,
+	*path_tracing.Parameters, error) {
+	var pathTracingDTO PathTracingDTO
+	err := json.Unmarshal(pathTracingJson, &pathTracingDTO)
+	if err != nil {
+		return nil, nil, decodingError(err)
+	}
+
+	dtoValidator := &validator{}
+	err = dtoValidator.validatePathTracing(&pathTracingDTO)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return controller.parsePathTracingFromDTO(&pathTracingDTO)
+}
+
+// ParsePathTracingFromMap parses the inputs for a path tracing run from their decoded JSON.
 //
 // Parameters:
 //  pathTracingData - The path tracing data.
 //
 // Returns:
 // 	The PathTracer.
-// 	The number of rays per pixel.
-// 	The number recursions of each ray.
-// 	The starting line index of the window of the screen to use the path tracing.
-// 	The starting column index of the window of the screen to use the path tracing.
-// 	The ending line index of the window of the screen to use the path tracing.
-// 	The ending column index of the window of the screen to use the path tracing.
+// 	The parameters of the path tracing.
 // 	An error.
 //
 func (controller *Controller) ParsePathTracingFromMap(pathTracingData map[string]interface{}) (
-	*path_tracing.PathTracer, int, int, int, int, int, int, error) {
+	*path_tracing.PathTracer, *path_tracing.Parameters, error) {
+	pathTracingJson, err := json.Marshal(pathTracingData)
+	if err != nil {
+		return nil, nil, decodingError(err)
+	}
+	return controller.ParsePathTracingFromJson(pathTracingJson)
+}
+
+// parsePathTracingFromDTO parses the inputs for a path tracing run from their validated DTO.
+//
+// Parameters:
+//  pathTracingDTO - The path tracing data as a DTO.
+//
+// Returns:
+// 	The PathTracer.
+// 	The parameters of the path tracing.
+// 	An error.
+//
+func (controller *Controller) parsePathTracingFromDTO(pathTracingDTO *PathTracingDTO) (*path_tracing.PathTracer,
+	*path_tracing.Parameters, error) {
+	pathTracingParametersInstance, err := controller.parsePathTracingParametersFromDTO("pathTracingParameters",
+		pathTracingDTO.PathTracingParameters)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pixelScreen, err := controller.parsePixelScreenFromDTO("pixelScreen", pathTracingDTO.PixelScreen)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sceneCamera, err := controller.parseCameraFromDTO("sceneCamera", pathTracingDTO.SceneCamera)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	pathTracingParametersInstance, err := controller.parsePathTracingParametersFromMap(
-		pathTracingData)
+	lights, err := controller.parseLightsFromDTO(pathTracingDTO.Lights)
 	if err != nil {
-		return nil, 0, 0, 0, 0, 0, 0, err
+		return nil, nil, err
 	}
 
-	pixelScreen, err := controller.parsePixelScreenFromMap(pathTracingData)
+	objects, err := controller.parseObjectsFromDTO(pathTracingDTO.Objects)
 	if err != nil {
-		return nil, 0, 0, 0, 0, 0, 0, err
+		return nil, nil, err
 	}
 
-	sceneCamera, err := controller.parseCameraFromMap(pathTracingData)
+	meshes, err := controller.parseMeshesFromDTO(pathTracingDTO.Meshes)
 	if err != nil {
-		return nil, 0, 0, 0, 0, 0, 0, err
+		return nil, nil, err
 	}
 
-	lights, err := controller.parseLightsFromMap(pathTracingData)
+	instances, err := controller.parseInstancesFromDTO(pathTracingDTO.Instances, meshes)
 	if err != nil {
-		return nil, 0, 0, 0, 0, 0, 0, err
+		return nil, nil, err
 	}
 
-	objects, err := controller.parseObjectsFromMap(pathTracingData)
+	pathTracer, err := path_tracing.InitWithInstances(objects, instances, pixelScreen, sceneCamera, lights)
 	if err != nil {
-		return nil, 0, 0, 0, 0, 0, 0, err
+		return nil, nil, invalidFieldError("", err)
 	}
 
-	pathTracer := path_tracing.Init(objects, pixelScreen, sceneCamera, lights)
-	return pathTracer, pathTracingParametersInstance.raysPerPixel, pathTracingParametersInstance.recursions,
-	pathTracingParametersInstance.windowStartLine, pathTracingParametersInstance.windowStartColumn,
-	pathTracingParametersInstance.windowEndLine, pathTracingParametersInstance.windowEndColumn, nil
+	return pathTracer, pathTracingParametersInstance, nil
 }
@@ -0,0 +1,433 @@
+package marshaller
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// sceneRequest is the JSON of a path tracing run of a screen of 4x3 pixels lit by a triangle, formatted with the
+// path tracing parameters and the objects.
+const sceneRequest = `{
+	"pathTracingParameters": %s,
+	"pixelScreen": {"width": 4, "height": 3},
+	"sceneCamera": {
+		"position": {"coordinates": [0, 0, 0]},
+		"look": {"coordinates": [1, 0, 0]},
+		"up": {"coordinates": [0, 0, 1]},
+		"right": {"coordinates": [0, -1, 0]},
+		"fieldOfView": 60,
+		"distanceToScreen": 1
+	},
+	"lights": [{
+		"lightIntensity": 1,
+		"color": [1, 1, 1],
+		"lightObject": {
+			"name": "light",
+			"lightCharacteristics": {"color": [1, 1, 1], "specularReflection": 0, "roughNess": 0,
+				"transmissionReflection": 0, "diffuseReflection": 1},
+			"normals": [{"coordinates": [-1, 0, 0]}],
+			"repository": {"points": [{"coordinates": [5, -1, -1]}, {"coordinates": [5, 1, -1]},
+				{"coordinates": [5, 0, 1]}]},
+			"triangles": [{"verticesIndices": [0, 1, 2], "verticesNormalsIndices": [0, 0, 0]}]
+		}
+	}],
+	"objects": %s
+}`
+
+// sceneParameters are the path tracing parameters of sceneRequest.
+const sceneParameters = `{"raysPerPixel": 2, "recursions": 3, "windowStartLine": 0, "windowStartColumn": 0,
+	"windowEndLine": 3, "windowEndColumn": 4}`
+
+// sceneObject is an object of sceneRequest, formatted with its triangles.
+const sceneObject = `{
+	"name": "ball",
+	"lightCharacteristics": {"color": [0.5, 0.5, 0.5], "specularReflection": 0, "roughNess": 0,
+		"transmissionReflection": 0, "diffuseReflection": 1, "material": {"type": "phong", "exponent": 10}},
+	"normals": [{"coordinates": [0, 0, 1]}],
+	"repository": {"points": [{"coordinates": [3, 0, 0]}, {"coordinates": [4, 0, 0]}, {"coordinates": [4, 1, 0]}]},
+	"triangles": %s,
+	"spheres": [{"centerPointIndex": 0, "radius": 0.5}]
+}`
+
+// assertFieldErrors asserts that parsing a path tracing run fails with the expected invalid fields.
+//
+// Parameters:
+//  t                   - Test instance.
+//  pathTracingJson     - The JSON of the path tracing run.
+//  expectedFieldErrors - The expected descriptions of the invalid fields, in order.
+//
+// Returns:
+//  none
+//
+func assertFieldErrors(t *testing.T, pathTracingJson string, expectedFieldErrors ...string) {
+	controller := Controller{}
+	_, _, err := controller.ParsePathTracingFromJson([]byte(pathTracingJson))
+	test_helpers.AssertNotNilError(t, err)
+	fieldErrors := err.(*SchemaError).GetFieldErrors()
+	test_helpers.AssertEqual(t, len(expectedFieldErrors), len(fieldErrors))
+	for fieldErrorIndex, fieldError := range fieldErrors {
+		test_helpers.AssertEqual(t, expectedFieldErrors[fieldErrorIndex], fieldError.Error())
+	}
+}
+
+// TestController_ParsePathTracingFromJson tests parsing the inputs of a path tracing run, with the defaults of the
+// optional fields.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParsePathTracingFromJson(t *testing.T) {
+	controller := Controller{}
+	objects := fmt.Sprintf("[%s]", fmt.Sprintf(sceneObject,
+		`[{"verticesIndices": [0, 1, 2], "verticesNormalsIndices": [0, 0, 0]}]`))
+	pathTracer, parameters, err := controller.ParsePathTracingFromJson(
+		[]byte(fmt.Sprintf(sceneRequest, sceneParameters, objects)))
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 4, pathTracer.GetPixelScreen().GetWidth())
+	test_helpers.AssertEqual(t, 3, pathTracer.GetPixelScreen().GetHeight())
+	test_helpers.AssertEqual(t, 1, len(pathTracer.GetLights()))
+	test_helpers.AssertEqual(t, 1, len(pathTracer.GetObjects()))
+	test_helpers.AssertEqual(t, 1, len(pathTracer.GetObjects()[0].GetSpheres()))
+	test_helpers.AssertEqual(t, true, pathTracer.GetObjects()[0].GetLightCharacteristics().GetMaterial() != nil)
+	test_helpers.AssertEqual(t, 2, parameters.GetRaysPerPixel())
+	test_helpers.AssertEqual(t, 1, parameters.GetPasses())
+}
+
+// TestController_ParsePathTracingFromJson_MissingFields tests parsing a path tracing run without its required fields.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParsePathTracingFromJson_MissingFields(t *testing.T) {
+	assertFieldErrors(t, `{}`, "pathTracingParameters: is required", "pixelScreen: is required",
+		"sceneCamera: is required", "lights: is required", "objects: is required")
+	assertFieldErrors(t, fmt.Sprintf(sceneRequest, `{"raysPerPixel": 2}`, `[{"name": "empty"}]`),
+		"pathTracingParameters.recursions: is required", "pathTracingParameters.windowStartLine: is required",
+		"pathTracingParameters.windowStartColumn: is required", "pathTracingParameters.windowEndLine: is required",
+		"pathTracingParameters.windowEndColumn: is required", "objects[0].repository: is required",
+		"objects[0].normals: is required", "objects[0].triangles: is required",
+		"objects[0].lightCharacteristics: is required")
+}
+
+// TestController_ParsePathTracingFromJson_InvalidTriangles tests parsing a path tracing run with triangles whose
+// indexes are not points or normals of their object.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParsePathTracingFromJson_InvalidTriangles(t *testing.T) {
+	triangles := `[{"verticesIndices": [0, 1, 2], "verticesNormalsIndices": [0, 0, 0]},
+		{"verticesIndices": [0, 40, 2], "verticesNormalsIndices": [0, -1, 0]},
+		{"verticesIndices": [0, 1], "verticesNormalsIndices": [0, 0, 0]}]`
+	objects := fmt.Sprintf("[%s, %s]", fmt.Sprintf(sceneObject, "[]"), fmt.Sprintf(sceneObject, triangles))
+	assertFieldErrors(t, fmt.Sprintf(sceneRequest, sceneParameters, objects),
+		"objects[1].triangles[1].verticesIndices[1]: index 40 out of range",
+		"objects[1].triangles[1].verticesNormalsIndices[1]: index -1 out of range",
+		"objects[1].triangles[2].verticesIndices: expected 3 elements, got 2")
+}
+
+// TestController_ParsePathTracingFromJson_InvalidGeometry tests parsing a path tracing run with objects that can
+// not be rendered.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParsePathTracingFromJson_InvalidGeometry(t *testing.T) {
+	triangles := `[{"verticesIndices": [0, 1, 1], "verticesNormalsIndices": [0, 0, 0]}]`
+	objects := fmt.Sprintf("[%s]", fmt.Sprintf(sceneObject, triangles))
+	assertFieldErrors(t, fmt.Sprintf(sceneRequest, sceneParameters, objects),
+		"objects[0].triangles[0]: degenerate triangle, point 1 is more than one vertex")
+
+	objects = `[{"name": "flat", "normals": [{"coordinates": [0, 0, 0]}],
+		"repository": {"points": [{"coordinates": [0, 0, 0]}, {"coordinates": [1, 1, 1]}, {"coordinates": [2, 2, 2]}]},
+		"triangles": [{"verticesIndices": [0, 1, 2], "verticesNormalsIndices": [0, 0, 0]}],
+		"lightCharacteristics": {"color": [1, 1, 1], "specularReflection": 0, "roughNess": 0,
+			"transmissionReflection": 0, "diffuseReflection": 1}}]`
+	assertFieldErrors(t, fmt.Sprintf(sceneRequest, sceneParameters, objects),
+		"objects[0].normals[0]: zero-length normal",
+		"objects[0].triangles[0]: degenerate triangle, its vertices are collinear")
+
+	zeroLookRequest := strings.Replace(fmt.Sprintf(sceneRequest, sceneParameters, "[]"),
+		`"look": {"coordinates": [1, 0, 0]}`, `"look": {"coordinates": [0, 0, 0]}`, 1)
+	assertFieldErrors(t, zeroLookRequest, "sceneCamera.look: zero-length vector")
+
+	objects = `[{"mesh": "o floor\nv 0 0 0\nv nan 0 0\nv 0 1 0\nf 1 2 3\n"}]`
+	assertFieldErrors(t, fmt.Sprintf(sceneRequest, sceneParameters, objects),
+		`objects[0].mesh: object "floor": repository.points[1]: coordinates must be finite, got [NaN 0 0]`,
+		`objects[0].mesh: object "floor": normals[0]: coordinates must be finite, got [NaN NaN NaN]`)
+}
+
+// TestController_ParsePathTracingFromJson_Transform tests placing objects and meshes by their transformations.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParsePathTracingFromJson_Transform(t *testing.T) {
+	controller := Controller{}
+	transformedObject := strings.Replace(fmt.Sprintf(sceneObject,
+		`[{"verticesIndices": [0, 1, 2], "verticesNormalsIndices": [0, 0, 0]}]`), `"name": "ball",`,
+		`"name": "ball", "transform": {"scale": [2, 2, 2], "rotation": {"axis": [0, 0, 1], "angle": 90},
+			"translation": [0, 0, 1]},`, 1)
+	mesh := `{"mesh": "v 0 0 0\nv 1 0 0\nv 0 1 0\nf 1 2 3\n",
+		"transform": {"matrix": [[1, 0, 0, 5], [0, 1, 0, 0], [0, 0, 1, 0], [0, 0, 0, 1]]}}`
+	objects := fmt.Sprintf("[%s, %s]", transformedObject, mesh)
+	pathTracer, _, err := controller.ParsePathTracingFromJson(
+		[]byte(fmt.Sprintf(sceneRequest, sceneParameters, objects)))
+	test_helpers.AssertNilError(t, err)
+
+	ball := pathTracer.GetObjects()[0]
+	expectedPoints := [][]float64{{0, 6, 1}, {0, 8, 1}, {-2, 8, 1}}
+	for pointIndex, expectedCoordinates := range expectedPoints {
+		currentPoint, err := ball.GetRepository().GetPoint(pointIndex)
+		test_helpers.AssertNilError(t, err)
+		for coordinateIndex, expectedCoordinate := range expectedCoordinates {
+			coordinate, err := currentPoint.GetCoordinate(coordinateIndex)
+			test_helpers.AssertNilError(t, err)
+			test_helpers.AssertEqual(t, true, math.Abs(expectedCoordinate-coordinate) < 1e-12)
+		}
+	}
+	test_helpers.AssertEqual(t, true, math.Abs(1-ball.GetSpheres()[0].GetRadius()) < 1e-12)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0, 0, 1}, ball.GetNormals()[0].CopyAllCoordinates()))
+
+	meshPoint, err := pathTracer.GetObjects()[1].GetRepository().GetPoint(1)
+	test_helpers.AssertNilError(t, err)
+	meshCoordinate, err := meshPoint.GetCoordinate(0)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 6.0, meshCoordinate)
+}
+
+// TestController_ParsePathTracingFromJson_InvalidTransform tests parsing a path tracing run with transformations
+// that can not place their objects.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParsePathTracingFromJson_InvalidTransform(t *testing.T) {
+	objectWithTransform := func(transform string) string {
+		return strings.Replace(fmt.Sprintf(sceneObject, "[]"), `"name": "ball",`,
+			fmt.Sprintf(`"name": "ball", "transform": %s,`, transform), 1)
+	}
+	objects := fmt.Sprintf("[%s, %s, %s]",
+		objectWithTransform(`{"scale": [1, 2], "rotation": {"axis": [0, 1, 0]}}`),
+		objectWithTransform(`{"rotation": {"euler": [0, 90, 0], "angle": 90}, "matrix": [[1, 0, 0, 0]]}`),
+		objectWithTransform(`{"matrix": [[1, 0, 0, 0], [0, 1, 0, 0], [0, 0, 1], [1, 0, 0, 1]]}`))
+	assertFieldErrors(t, fmt.Sprintf(sceneRequest, sceneParameters, objects),
+		"objects[0].transform.rotation.angle: is required",
+		"objects[0].transform.scale: expected 3 elements, got 2",
+		"objects[1].transform.rotation: euler can not be combined with axis and angle",
+		"objects[1].transform.matrix: can not be combined with translation, rotation or scale",
+		"objects[1].transform.matrix: expected 4 elements, got 1",
+		"objects[2].transform.matrix[2]: expected 4 elements, got 3",
+		"objects[2].transform.matrix[3]: expected [0 0 0 1] for an affine transformation, got [1 0 0 1]")
+
+	for _, transform := range []string{`{"scale": [1, 2, 1]}`, `{"scale": [0, 0, 0]}`,
+		`{"rotation": {"axis": [0, 0, 0], "angle": 90}}`} {
+		objects = fmt.Sprintf("[%s]", objectWithTransform(transform))
+		controller := Controller{}
+		_, _, err := controller.ParsePathTracingFromJson([]byte(fmt.Sprintf(sceneRequest, sceneParameters, objects)))
+		test_helpers.AssertNotNilError(t, err)
+		test_helpers.AssertEqual(t, true, strings.HasPrefix(err.(*SchemaError).GetFieldErrors()[0].GetPath(),
+			"objects[0].transform"))
+	}
+}
+
+// TestController_ParsePathTracingFromJson_MeshInstances tests parsing a path tracing run with instances of meshes,
+// which share the geometry of their meshes.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParsePathTracingFromJson_MeshInstances(t *testing.T) {
+	controller := Controller{}
+	ball := fmt.Sprintf(sceneObject, `[{"verticesIndices": [0, 1, 2], "verticesNormalsIndices": [0, 0, 0]}]`)
+	triangle := `{"name": "triangle", "mesh": "v 0 0 0\nv 1 0 0\nv 0 1 0\nf 1 2 3\n"}`
+	instances := `[{"meshName": "ball", "transform": {"translation": [0, 2, 0]}},
+		{"meshName": "ball", "name": "red ball", "lightCharacteristics": {"color": [1, 0, 0], "specularReflection": 0,
+			"roughNess": 0, "transmissionReflection": 0, "diffuseReflection": 1}},
+		{"meshName": "triangle", "transform": {"scale": [1, 1, 3]}}]`
+	objects := fmt.Sprintf(`[], "meshes": [%s, %s], "instances": %s`, ball, triangle, instances)
+	pathTracer, _, err := controller.ParsePathTracingFromJson(
+		[]byte(fmt.Sprintf(sceneRequest, sceneParameters, objects)))
+	test_helpers.AssertNilError(t, err)
+
+	test_helpers.AssertEqual(t, 0, len(pathTracer.GetObjects()))
+	sceneInstances := pathTracer.GetInstances()
+	test_helpers.AssertEqual(t, 3, len(sceneInstances))
+	test_helpers.AssertEqual(t, 2, pathTracer.GetObjectsHierarchy().NumberOfGeometries())
+
+	firstBall := sceneInstances[0].GetObject()
+	secondBall := sceneInstances[1].GetObject()
+	test_helpers.AssertEqual(t, "ball", firstBall.GetName())
+	test_helpers.AssertEqual(t, true, firstBall.GetRepository() == secondBall.GetRepository())
+	test_helpers.AssertEqual(t, true, sceneInstances[0].GetMesh() == sceneInstances[1].GetMesh())
+	translation, err := sceneInstances[0].GetTransformation().GetValue(1, 3)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 2.0, translation)
+	test_helpers.AssertEqual(t, true, firstBall.GetLightCharacteristics().GetMaterial() != nil)
+
+	test_helpers.AssertEqual(t, "red ball", secondBall.GetName())
+	test_helpers.AssertEqual(t, false, sceneInstances[1].IsTransformed())
+	test_helpers.AssertEqual(t, true,
+		reflect.DeepEqual([]float64{1, 0, 0}, secondBall.GetLightCharacteristics().GetColor()))
+	test_helpers.AssertEqual(t, true, secondBall.GetLightCharacteristics().GetMaterial() == nil)
+	test_helpers.AssertEqual(t, true, sceneInstances[2].IsTransformed())
+}
+
+// TestController_ParsePathTracingFromJson_InvalidMeshInstances tests parsing a path tracing run with meshes and
+// instances that can not be placed on the scene.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParsePathTracingFromJson_InvalidMeshInstances(t *testing.T) {
+	ball := fmt.Sprintf(sceneObject, "[]")
+	instances := `[{"meshName": "chair", "transform": {"scale": [1, 1]}}, {"lightCharacteristics": {"color": [1, 0, 0],
+		"specularReflection": 0, "roughNess": 0, "transmissionReflection": 0}}]`
+	objects := fmt.Sprintf(`[], "meshes": [%s, %s, {"mesh": "v 0 0 0"}], "instances": %s`, ball, ball, instances)
+	assertFieldErrors(t, fmt.Sprintf(sceneRequest, sceneParameters, objects),
+		`meshes[1].name: duplicate mesh name "ball"`,
+		"meshes[2].name: is required",
+		`instances[0].meshName: unknown mesh "chair"`,
+		"instances[0].transform.scale: expected 3 elements, got 2",
+		"instances[1].meshName: is required",
+		"instances[1].lightCharacteristics.diffuseReflection: is required")
+
+	objects = fmt.Sprintf(`[], "meshes": [%s], "instances": [{"meshName": "ball", "transform": {"scale": [0, 1, 1]}}]`,
+		ball)
+	controller := Controller{}
+	_, _, err := controller.ParsePathTracingFromJson([]byte(fmt.Sprintf(sceneRequest, sceneParameters, objects)))
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, "instances[0].transform", err.(*SchemaError).GetFieldErrors()[0].GetPath())
+}
+
+// TestController_ParsePathTracingFromJson_InvalidValues tests parsing a path tracing run with values that are not
+// valid for their fields.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParsePathTracingFromJson_InvalidValues(t *testing.T) {
+	invalidObject := `{"name": "glass", "normals": [], "triangles": [],
+		"repository": {"points": [{"coordinates": [0, 0]}]},
+		"spheres": [{"centerPointIndex": 1, "radius": 0}],
+		"lightCharacteristics": {"color": [1, 1], "specularReflection": 0, "roughNess": 0,
+			"transmissionReflection": 0, "diffuseReflection": 1, "material": {"type": "glass"}}}`
+	objects := fmt.Sprintf(`[%s, {"mesh": "v 0 0 0", "meshEncoding": "gzip"}, %s]`, fmt.Sprintf(sceneObject, "[]"),
+		invalidObject)
+	assertFieldErrors(t, fmt.Sprintf(sceneRequest, sceneParameters, objects),
+		`objects[1].meshEncoding: unknown value "gzip", expected one of [text base64]`,
+		"objects[2].repository.points[0].coordinates: expected 3 elements, got 2",
+		"objects[2].spheres[0].centerPointIndex: index 1 out of range",
+		"objects[2].spheres[0].radius: expected more than 0, got 0",
+		"objects[2].lightCharacteristics.color: expected 3 elements, got 2",
+		`objects[2].lightCharacteristics.material.type: unknown value "glass", expected one of `+
+			"[lambertian phong ggx mirror dielectric]")
+}
+
+// TestController_ParsePathTracingFromJson_InvalidJson tests parsing a path tracing run whose JSON can not be decoded.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParsePathTracingFromJson_InvalidJson(t *testing.T) {
+	assertFieldErrors(t, `{"pixelScreen": `, "invalid JSON at offset 16: unexpected end of JSON input")
+	assertFieldErrors(t, `{"pixelScreen": {"width": 1.5}}`, "pixelScreen.width: expected integer, got number 1.5")
+	triangles := `[{"verticesIndices": [0, "1", 2], "verticesNormalsIndices": [0, 0, 0]}]`
+	objects := fmt.Sprintf("[%s]", fmt.Sprintf(sceneObject, triangles))
+	assertFieldErrors(t, fmt.Sprintf(sceneRequest, sceneParameters, objects),
+		"objects[0].triangles[0].verticesIndices[1]: expected integer, got string")
+}
+
+// TestController_ParsePathTracingFromJson_InvalidParameters tests parsing a path tracing run whose scene is valid but
+// whose parameters can not run it.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParsePathTracingFromJson_InvalidParameters(t *testing.T) {
+	controller := Controller{}
+	parameters := `{"raysPerPixel": 2, "recursions": 3, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 3, "windowEndColumn": 4, "toneMapping": {"operator": "filmic"}}`
+	_, _, err := controller.ParsePathTracingFromJson([]byte(fmt.Sprintf(sceneRequest, parameters, "[]")))
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, "pathTracingParameters.toneMapping",
+		err.(*ParametersError).GetFieldErrors()[0].GetPath())
+
+	parameters = `{"raysPerPixel": 2, "recursions": 3, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 3, "windowEndColumn": 4, "passes": 0, "tileSize": 0, "estimator": "foo", "sampler": "bar",
+		"russianRouletteDepth": -1, "adaptiveSampling": {"minimumSamples": 1, "maximumSamples": 0,
+		"noiseThreshold": 0}}`
+	_, _, err = controller.ParsePathTracingFromJson([]byte(fmt.Sprintf(sceneRequest, parameters, "[]")))
+	test_helpers.AssertNotNilError(t, err)
+	expectedFieldErrors := []string{
+		"pathTracingParameters.passes: expected at least 1, got 0",
+		"pathTracingParameters.tileSize: expected at least 1, got 0",
+		`pathTracingParameters.estimator: unknown value "foo", expected one of [legacy unbiased]`,
+		`pathTracingParameters.sampler: unknown value "bar", expected one of [independent stratified halton sobol]`,
+		"pathTracingParameters.russianRouletteDepth: expected at least 0, got -1",
+		"pathTracingParameters.adaptiveSampling.minimumSamples: expected at least 2, got 1",
+		"pathTracingParameters.adaptiveSampling.maximumSamples: expected at least 1, got 0",
+		"pathTracingParameters.adaptiveSampling.noiseThreshold: expected more than 0, got 0",
+	}
+	fieldErrors := err.(*ParametersError).GetFieldErrors()
+	test_helpers.AssertEqual(t, len(expectedFieldErrors), len(fieldErrors))
+	for fieldErrorIndex, fieldError := range fieldErrors {
+		test_helpers.AssertEqual(t, expectedFieldErrors[fieldErrorIndex], fieldError.Error())
+	}
+
+	parameters = `{"raysPerPixel": 2, "windowStartLine": 0, "windowStartColumn": 0, "windowEndLine": 3,
+		"windowEndColumn": 4, "passes": 0}`
+	assertFieldErrors(t, fmt.Sprintf(sceneRequest, parameters, "[]"), "pathTracingParameters.recursions: is required")
+}
+
+// TestController_ParsePathTracingFromMap tests parsing the inputs of a path tracing run from their decoded JSON.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParsePathTracingFromMap(t *testing.T) {
+	controller := Controller{}
+	_, _, err := controller.ParsePathTracingFromMap(map[string]interface{}{"objects": []interface{}{}})
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, 4, len(err.(*SchemaError).GetFieldErrors()))
+}
@@ -0,0 +1,102 @@
+package marshaller
+
+import (
+	"errors"
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/scene_validation"
+)
+
+// invalidWavefrontLineError is the error where a line of a Wavefront file can not be parsed.
+//
+// Parameters:
+//	format     - The format of the file, OBJ or MTL.
+//	lineNumber - The number of the line, starting at 1.
+//	line       - The line.
+//
+// Returns:
+//  An Error.
+//
+func invalidWavefrontLineError(format string, lineNumber int, line string) error {
+	errorMessage := fmt.Sprintf("Invalid %s line %d: %s.", format, lineNumber, line)
+	return errors.New(errorMessage)
+}
+
+// unknownMtlMaterialError is the error where an OBJ file uses a material that is not in its material library.
+//
+// Parameters:
+//	materialName - The name of the material.
+//
+// Returns:
+//  An Error.
+//
+func unknownMtlMaterialError(materialName string) error {
+	errorMessage := fmt.Sprintf("Material %s is not in the material library.", materialName)
+	return errors.New(errorMessage)
+}
+
+// schemaError is the error where the JSON of a path tracing run does not describe a valid scene.
+//
+// Parameters:
+//	fieldErrors - The invalid fields.
+//
+// Returns:
+//  An Error.
+//
+func schemaError(fieldErrors []*FieldError) error {
+	return &SchemaError{fieldErrors: fieldErrors}
+}
+
+// invalidFieldError is the error where a single field of the JSON of a path tracing run is invalid.
+//
+// Parameters:
+//	path - The JSON path of the field.
+//	err  - What is wrong with the field.
+//
+// Returns:
+//  An Error.
+//
+func invalidFieldError(path string, err error) error {
+	return schemaError([]*FieldError{initFieldError(path, err.Error())})
+}
+
+// parametersError is the error where the parameters of a path tracing run can not run the path tracing.
+//
+// Parameters:
+//	fieldErrors - The invalid parameters.
+//
+// Returns:
+//  An Error.
+//
+func parametersError(fieldErrors []*FieldError) error {
+	return &ParametersError{fieldErrors: fieldErrors}
+}
+
+// invalidParameterError is the error where a parameter of a path tracing run can not run the path tracing.
+//
+// Parameters:
+//	path - The JSON path of the parameter.
+//	err  - What is wrong with the parameter.
+//
+// Returns:
+//  An Error.
+//
+func invalidParameterError(path string, err error) error {
+	return parametersError([]*FieldError{initFieldError(path, err.Error())})
+}
+
+// sceneIssuesError is the error where the parsed objects of a path tracing run can not be rendered.
+//
+// Parameters:
+//	path   - The JSON path of the object.
+//	issues - The issues of the object, with paths relative to it.
+//
+// Returns:
+//  An Error.
+//
+func sceneIssuesError(path string, issues []*scene_validation.Issue) error {
+	fieldErrors := make([]*FieldError, len(issues))
+	for issueIndex, issue := range issues {
+		fieldErrors[issueIndex] = initFieldError(fieldPath(path, issue.GetPath()), issue.GetMessage())
+	}
+	return schemaError(fieldErrors)
+}
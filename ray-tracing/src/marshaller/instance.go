@@ -0,0 +1,129 @@
+package marshaller
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/instance"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
+)
+
+// parseMeshesFromDTO parses the meshes of the scene from their DTOs.
+//
+// Parameters:
+//  meshDTOs - The meshes as DTOs.
+//
+// Returns:
+// 	The objects of every mesh by its name, more than one for Wavefront OBJ files.
+// 	An error.
+//
+func (controller *Controller) parseMeshesFromDTO(meshDTOs []SceneObjectDTO) (map[string][]*object.Object, error) {
+	meshes := make(map[string][]*object.Object, len(meshDTOs))
+	for meshIndex := range meshDTOs {
+		meshObjects, err := controller.parseSceneObjectFromDTO(indexPath("meshes", meshIndex), &meshDTOs[meshIndex])
+		if err != nil {
+			return nil, err
+		}
+		meshes[*meshDTOs[meshIndex].Name] = meshObjects
+	}
+	return meshes, nil
+}
+
+// instantiateObjectFromDTO builds the object of an instance of a mesh, which shares the geometry of the object of the
+// mesh and replaces its name and light characteristics when the instance has them.
+//
+// Parameters:
+//  path        - The JSON path of the instance.
+//  instanceDTO - The instance as a DTO.
+//  meshObject  - The object of the mesh.
+//
+// Returns:
+// 	The object of the instance.
+// 	An error.
+//
+func (controller *Controller) instantiateObjectFromDTO(path string, instanceDTO *InstanceDTO,
+	meshObject *object.Object) (*object.Object, error) {
+	if instanceDTO.Name == nil && instanceDTO.LightCharacteristics == nil {
+		return meshObject, nil
+	}
+	name := meshObject.GetName()
+	if instanceDTO.Name != nil {
+		name = *instanceDTO.Name
+	}
+	if instanceDTO.LightCharacteristics != nil {
+		return controller.initObjectFromDTO(fieldPath(path, "lightCharacteristics"), name,
+			meshObject.GetRepository(), meshObject.GetTriangles(), meshObject.GetSpheres(), meshObject.GetNormals(),
+			instanceDTO.LightCharacteristics)
+	}
+
+	characteristics := meshObject.GetLightCharacteristics()
+	instanceObject, err := object.Init(name, meshObject.GetRepository(), meshObject.GetTriangles(),
+		meshObject.GetSpheres(), meshObject.GetNormals(), characteristics.GetColor(),
+		characteristics.GetSpecularReflection(), characteristics.GetRoughNess(),
+		characteristics.GetTransmissionReflection(), characteristics.GetDiffuseReflection(),
+		characteristics.GetRefractiveIndex(), characteristics.GetMaterial())
+	if err != nil {
+		return nil, invalidFieldError(path, err)
+	}
+	return instanceObject, nil
+}
+
+// parseInstanceFromDTO parses an instance of a mesh from its DTO.
+//
+// Parameters:
+//  path        - The JSON path of the instance.
+//  instanceDTO - The instance as a DTO.
+//  meshes      - The objects of every mesh by its name.
+//
+// Returns:
+// 	The instances of the objects of the mesh.
+// 	An error.
+//
+func (controller *Controller) parseInstanceFromDTO(path string, instanceDTO *InstanceDTO,
+	meshes map[string][]*object.Object) ([]*instance.Instance, error) {
+	transformPath := fieldPath(path, "transform")
+	var transformation *matrix.Matrix
+	if instanceDTO.Transform != nil {
+		var err error
+		transformation, err = controller.parseTransformFromDTO(transformPath, instanceDTO.Transform)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	meshObjects := meshes[*instanceDTO.MeshName]
+	instances := make([]*instance.Instance, len(meshObjects))
+	for objectIndex, meshObject := range meshObjects {
+		instanceObject, err := controller.instantiateObjectFromDTO(path, instanceDTO, meshObject)
+		if err != nil {
+			return nil, err
+		}
+		instances[objectIndex], err = instance.InitOfMesh(meshObject, instanceObject, transformation)
+		if err != nil {
+			return nil, invalidFieldError(transformPath, err)
+		}
+	}
+	return instances, nil
+}
+
+// parseInstancesFromDTO parses the instances of the meshes of the scene from their DTOs.
+//
+// Parameters:
+//  instanceDTOs - The instances as DTOs.
+//  meshes       - The objects of every mesh by its name.
+//
+// Returns:
+// 	The list of instances.
+// 	An error.
+//
+func (controller *Controller) parseInstancesFromDTO(instanceDTOs []InstanceDTO,
+	meshes map[string][]*object.Object) ([]*instance.Instance, error) {
+	instances := make([]*instance.Instance, 0, len(instanceDTOs))
+	for instanceIndex := range instanceDTOs {
+		currentInstances, err := controller.parseInstanceFromDTO(indexPath("instances", instanceIndex),
+			&instanceDTOs[instanceIndex], meshes)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, currentInstances...)
+	}
+	return instances, nil
+}
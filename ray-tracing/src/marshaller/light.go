@@ -1,103 +1,47 @@
 package marshaller
 
 import (
-	"errors"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/light"
-	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
 )
 
-// parseLightObjectFromMap parses a light object from a map.
+// parseLightFromDTO parses a light from its DTO.
 //
 // Parameters:
-//  lightData - The light data.
-//
-// Returns:
-// 	An object.
-// 	An error.
-//
-func (controller *Controller) parseLightObjectFromMap(lightData map[string]interface{}) (*object.Object, error) {
-	errorMessage := "unable to parse light object"
-
-	lightObjectInterface, found := lightData["lightObject"]
-	if !found {
-		return nil, errors.New(errorMessage)
-	}
-	lightObjectMap, parsed := lightObjectInterface.(map[string]interface{})
-	if !parsed {
-		return nil, errors.New(errorMessage)
-	}
-
-	lightObject, err := controller.parseObjectFromMap(lightObjectMap)
-	if err != nil {
-		return nil, errors.New(errorMessage)
-	}
-	return lightObject, nil
-}
-
-// parseLightFromMap parses a light from a map.
-//
-// Parameters:
-//  lightData - The light data.
+//  path     - The JSON path of the light.
+//  lightDTO - The light as a DTO.
 //
 // Returns:
 // 	A light.
 // 	An error.
 //
-func (controller *Controller) parseLightFromMap(lightData map[string]interface{}) (*light.Light, error) {
-	errorMessage := "unable to parse light"
-
-	lightIntensity, err := controller.parseFloatFromMap(lightData, "lightIntensity")
+func (controller *Controller) parseLightFromDTO(path string, lightDTO *LightDTO) (*light.Light, error) {
+	lightObject, err := controller.parseObjectFromDTO(fieldPath(path, "lightObject"), lightDTO.LightObject)
 	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, err
 	}
 
-	color, err := controller.parseFloatListFromMap(lightData, "color")
+	parsedLight, err := light.Init(*lightDTO.LightIntensity, lightObject, lightDTO.Color)
 	if err != nil {
-		return nil, errors.New(errorMessage)
-	}
-
-	lightObject, err := controller.parseLightObjectFromMap(lightData)
-	if err != nil {
-		return nil, errors.New(errorMessage)
-	}
-
-	parsedLight, err := light.Init(lightIntensity, lightObject, color)
-	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, invalidFieldError(path, err)
 	}
 	return parsedLight, nil
 }
 
-// parseLightsFromMap parses lights from map.
+// parseLightsFromDTO parses lights from their DTOs.
 //
 // Parameters:
-//  pathTracingData - The path tracing data.
+//  lightDTOs - The lights as DTOs.
 //
 // Returns:
 // 	The list of lights.
 // 	An error.
 //
-func (controller *Controller) parseLightsFromMap(pathTracingData map[string]interface{}) ([]*light.Light, error) {
-	errorMessage := "unable to parse lights"
-
-	lightsInterface, found := pathTracingData["lights"]
-	if !found {
-		return nil, errors.New(errorMessage)
-	}
-	lightsInterfaceList, parsed := lightsInterface.([]interface{})
-	if !parsed {
-		return nil, errors.New(errorMessage)
-	}
-
-	lights := make([]*light.Light, len(lightsInterfaceList))
-	for lightIndex := 0; lightIndex < len(lightsInterfaceList); lightIndex++ {
-		lightMap, parsed := lightsInterfaceList[lightIndex].(map[string]interface{})
-		if !parsed {
-			return nil, errors.New(errorMessage)
-		}
-		currentLight, err := controller.parseLightFromMap(lightMap)
+func (controller *Controller) parseLightsFromDTO(lightDTOs []LightDTO) ([]*light.Light, error) {
+	lights := make([]*light.Light, len(lightDTOs))
+	for lightIndex := range lightDTOs {
+		currentLight, err := controller.parseLightFromDTO(indexPath("lights", lightIndex), &lightDTOs[lightIndex])
 		if err != nil {
-			return nil, errors.New(errorMessage)
+			return nil, err
 		}
 		lights[lightIndex] = currentLight
 	}
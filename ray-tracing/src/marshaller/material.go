@@ -0,0 +1,45 @@
+package marshaller
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/material"
+)
+
+// parseMaterialFromDTO parses the optional material of an object from its DTO.
+// The material uses the color of the object and, for dielectrics, its index of refraction.
+//
+// Parameters:
+//  path            - The JSON path of the material.
+//  materialDTO     - The material as a DTO, nil if there is none.
+//  color           - RGB for the color of the object.
+//  refractiveIndex - The index of refraction of the material of the object.
+//
+// Returns:
+// 	The material, or nil if there is none.
+// 	An error.
+//
+func (*Controller) parseMaterialFromDTO(path string, materialDTO *MaterialDTO, color []float64,
+	refractiveIndex float64) (material.Material, error) {
+	if materialDTO == nil {
+		return nil, nil
+	}
+
+	var parsedMaterial material.Material
+	var err error
+	switch *materialDTO.Type {
+	case "lambertian":
+		parsedMaterial, err = material.InitLambertian(color)
+	case "phong":
+		parsedMaterial, err = material.InitPhong(color, *materialDTO.Exponent)
+	case "ggx":
+		parsedMaterial, err = material.InitGGX(color, *materialDTO.Roughness)
+	case "mirror":
+		parsedMaterial, err = material.InitMirror(color)
+	case "dielectric":
+		parsedMaterial, err = material.InitDielectric(color, refractiveIndex)
+	}
+	if err != nil {
+		return nil, invalidFieldError(path, err)
+	}
+
+	return parsedMaterial, nil
+}
@@ -0,0 +1,189 @@
+package marshaller
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// percentagesResolution is the resolution the percentages of the MTL materials are rounded to, a power of two so the
+// percentages sum exactly 1.
+const percentagesResolution = 1024
+
+// mtlMaterial is a class for a material of a Wavefront MTL material library.
+//
+// Members:
+// 	diffuseColor      - The Kd color.
+// 	specularColor     - The Ks color.
+// 	specularExponent  - The Ns exponent.
+// 	refractiveIndex   - The Ni index of refraction.
+// 	dissolve          - The d opacity.
+// 	illuminationModel - The illum model.
+//
+type mtlMaterial struct {
+	diffuseColor      []float64
+	specularColor     []float64
+	specularExponent  float64
+	refractiveIndex   float64
+	dissolve          float64
+	illuminationModel int
+}
+
+// initDefaultMtlMaterial initializes the material used by faces without a material.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	A mtlMaterial.
+//
+func initDefaultMtlMaterial() *mtlMaterial {
+	return &mtlMaterial{diffuseColor: []float64{0.8, 0.8, 0.8}, specularColor: []float64{0, 0, 0},
+		specularExponent: 0, refractiveIndex: 1, dissolve: 1, illuminationModel: 1}
+}
+
+// parseWavefrontFloats parses the numbers after the keyword of a line of a Wavefront file.
+//
+// Parameters:
+// 	fields         - The fields of the line, starting with the keyword.
+// 	numberOfFloats - The number of floats that must be parsed.
+//
+// Returns:
+// 	The floats.
+// 	If the line has the floats.
+//
+func (*Controller) parseWavefrontFloats(fields []string, numberOfFloats int) ([]float64, bool) {
+	if len(fields) < numberOfFloats+1 {
+		return nil, false
+	}
+	floats := make([]float64, numberOfFloats)
+	for index := 0; index < numberOfFloats; index++ {
+		parsedFloat, err := strconv.ParseFloat(fields[index+1], 64)
+		if err != nil {
+			return nil, false
+		}
+		floats[index] = parsedFloat
+	}
+	return floats, true
+}
+
+// parseMtl parses a Wavefront MTL material library.
+// Only the keywords used to build the light characteristics are read, the others are ignored.
+//
+// Parameters:
+// 	mtlContent - The content of the material library.
+//
+// Returns:
+// 	The materials by name.
+// 	An error.
+//
+func (controller *Controller) parseMtl(mtlContent string) (map[string]*mtlMaterial, error) {
+	materials := make(map[string]*mtlMaterial)
+	var currentMaterial *mtlMaterial
+
+	for lineIndex, line := range strings.Split(mtlContent, "\n") {
+		if commentIndex := strings.Index(line, "#"); commentIndex >= 0 {
+			line = line[:commentIndex]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "newmtl" {
+			if len(fields) < 2 {
+				return nil, invalidWavefrontLineError("MTL", lineIndex+1, line)
+			}
+			currentMaterial = initDefaultMtlMaterial()
+			materials[strings.Join(fields[1:], " ")] = currentMaterial
+			continue
+		}
+		if currentMaterial == nil {
+			continue
+		}
+
+		isValid := true
+		switch fields[0] {
+		case "Kd":
+			currentMaterial.diffuseColor, isValid = controller.parseWavefrontFloats(fields, 3)
+		case "Ks":
+			currentMaterial.specularColor, isValid = controller.parseWavefrontFloats(fields, 3)
+		case "Ns", "Ni", "d", "Tr", "illum":
+			var value []float64
+			value, isValid = controller.parseWavefrontFloats(fields, 1)
+			if !isValid {
+				break
+			}
+			switch fields[0] {
+			case "Ns":
+				currentMaterial.specularExponent = value[0]
+			case "Ni":
+				currentMaterial.refractiveIndex = value[0]
+			case "d":
+				currentMaterial.dissolve = value[0]
+			case "Tr":
+				currentMaterial.dissolve = 1 - value[0]
+			case "illum":
+				currentMaterial.illuminationModel = int(value[0])
+			}
+		}
+		if !isValid {
+			return nil, invalidWavefrontLineError("MTL", lineIndex+1, line)
+		}
+	}
+	return materials, nil
+}
+
+// findLightCharacteristics converts a MTL material to the light characteristics of an object.
+// The color is the diffuse color, or the specular color when there is no diffuse color. The diffuse and specular
+// percentages are proportional to the strongest channel of each color, sharing the opacity, and the transmission
+// percentage is the transparency. The roughness matches the width of the highlight of the specular exponent, and the
+// illumination models with ray traced reflection are perfect mirrors.
+//
+// Parameters:
+// 	currentMaterial - The MTL material.
+//
+// Returns:
+//  RGB for the color of the object.
+//  Percentage of specular rays.
+//  How much reflections rays get distorted.
+//  Percentage of transmission rays.
+//  Percentage of diffuse rays.
+//  The index of refraction of the material of the object.
+//
+func (*Controller) findLightCharacteristics(currentMaterial *mtlMaterial) ([]float64, float64, float64, float64,
+	float64, float64) {
+	diffuseStrength := math.Max(currentMaterial.diffuseColor[0], math.Max(currentMaterial.diffuseColor[1],
+		currentMaterial.diffuseColor[2]))
+	specularStrength := math.Max(currentMaterial.specularColor[0], math.Max(currentMaterial.specularColor[1],
+		currentMaterial.specularColor[2]))
+
+	baseColor := currentMaterial.diffuseColor
+	if diffuseStrength <= 0 && specularStrength > 0 {
+		baseColor = currentMaterial.specularColor
+	}
+	color := make([]float64, 3)
+	for colorIndex := 0; colorIndex < 3; colorIndex++ {
+		color[colorIndex] = math.Min(1, math.Max(0, baseColor[colorIndex]))
+	}
+
+	opacity := math.Min(1, math.Max(0, currentMaterial.dissolve))
+	transmissionReflection := math.Round((1-opacity)*percentagesResolution) / percentagesResolution
+	specularReflection := 0.0
+	if diffuseStrength+specularStrength > 0 {
+		specularReflection = math.Round((1-transmissionReflection)*math.Max(0, specularStrength)/
+			(math.Max(0, diffuseStrength)+math.Max(0, specularStrength))*percentagesResolution) /
+			percentagesResolution
+	}
+	diffuseReflection := 1 - transmissionReflection - specularReflection
+
+	roughNess := math.Sqrt(2 / (math.Max(0, currentMaterial.specularExponent) + 2))
+	if currentMaterial.illuminationModel == 3 || currentMaterial.illuminationModel == 5 {
+		roughNess = 0
+	}
+
+	refractiveIndex := currentMaterial.refractiveIndex
+	if refractiveIndex <= 0 {
+		refractiveIndex = 1
+	}
+	return color, specularReflection, roughNess, transmissionReflection, diffuseReflection, refractiveIndex
+}
@@ -0,0 +1,415 @@
+package marshaller
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/point_repository"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/scene_validation"
+	"strconv"
+	"strings"
+)
+
+// defaultObjGroupName is the name of the faces that come before any group of a Wavefront OBJ file.
+const defaultObjGroupName = "default"
+
+// objGroup is a class for the faces of a Wavefront OBJ file that share a group and a material.
+//
+// Members:
+// 	groupName    - The name of the group or object.
+// 	materialName - The name of the material, empty when there is none.
+// 	faces        - The faces as lists of vertex indexes and lists of normal indexes, -1 when there is no normal.
+//
+type objGroup struct {
+	groupName    string
+	materialName string
+	faces        [][][]int
+}
+
+// parseObjIndex parses an index of a face vertex, which starts at 1 or counts backwards from the end when negative.
+//
+// Parameters:
+// 	field          - The index as text.
+// 	numberOfValues - The number of values read so far.
+//
+// Returns:
+// 	The index starting at 0.
+// 	If the index is valid.
+//
+func (*Controller) parseObjIndex(field string, numberOfValues int) (int, bool) {
+	index, err := strconv.Atoi(field)
+	if err != nil || index == 0 {
+		return 0, false
+	}
+	if index < 0 {
+		index = numberOfValues + index
+	} else {
+		index--
+	}
+	return index, index >= 0 && index < numberOfValues
+}
+
+// parseObjFace parses the vertices of a face of a Wavefront OBJ file, as v, v/vt, v//vn or v/vt/vn.
+//
+// Parameters:
+// 	fields          - The fields of the line, starting with the keyword.
+// 	numberOfPoints  - The number of vertices read so far.
+// 	numberOfNormals - The number of normals read so far.
+//
+// Returns:
+// 	The vertex indexes.
+// 	The normal indexes, -1 for all vertices if any of them has no normal.
+// 	If the face is valid.
+//
+func (controller *Controller) parseObjFace(fields []string, numberOfPoints, numberOfNormals int) ([]int, []int,
+	bool) {
+	if len(fields) < 4 {
+		return nil, nil, false
+	}
+	vertexIndexes := make([]int, len(fields)-1)
+	normalIndexes := make([]int, len(fields)-1)
+	hasAllNormals := true
+	for fieldIndex, field := range fields[1:] {
+		references := strings.Split(field, "/")
+		if len(references) > 3 {
+			return nil, nil, false
+		}
+		vertexIndex, isValid := controller.parseObjIndex(references[0], numberOfPoints)
+		if !isValid {
+			return nil, nil, false
+		}
+		vertexIndexes[fieldIndex] = vertexIndex
+		normalIndexes[fieldIndex] = -1
+		if len(references) == 3 && references[2] != "" {
+			normalIndex, isValid := controller.parseObjIndex(references[2], numberOfNormals)
+			if !isValid {
+				return nil, nil, false
+			}
+			normalIndexes[fieldIndex] = normalIndex
+		} else {
+			hasAllNormals = false
+		}
+	}
+	if !hasAllNormals {
+		for fieldIndex := range normalIndexes {
+			normalIndexes[fieldIndex] = -1
+		}
+	}
+	return vertexIndexes, normalIndexes, true
+}
+
+// parseObjGroups parses the vertices, normals and faces of a Wavefront OBJ file.
+// Texture coordinates, material libraries, smoothing groups and other keywords are ignored.
+//
+// Parameters:
+// 	objContent - The content of the OBJ file.
+//
+// Returns:
+// 	The vertices.
+// 	The normals.
+// 	The faces grouped by group and material, in the order they first appear.
+// 	An error.
+//
+func (controller *Controller) parseObjGroups(objContent string) ([]*point.Point, []*vector.Vector, []*objGroup,
+	error) {
+	points := make([]*point.Point, 0)
+	normals := make([]*vector.Vector, 0)
+	groups := make([]*objGroup, 0)
+	groupsByKey := make(map[string]*objGroup)
+	groupName := defaultObjGroupName
+	materialName := ""
+
+	for lineIndex, line := range strings.Split(objContent, "\n") {
+		if commentIndex := strings.Index(line, "#"); commentIndex >= 0 {
+			line = line[:commentIndex]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "v":
+			coordinates, isValid := controller.parseWavefrontFloats(fields, 3)
+			if !isValid {
+				return nil, nil, nil, invalidWavefrontLineError("OBJ", lineIndex+1, line)
+			}
+			currentPoint, _ := point.Init(3)
+			for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+				_ = currentPoint.SetCoordinate(coordinateIndex, coordinates[coordinateIndex])
+			}
+			points = append(points, currentPoint)
+		case "vn":
+			coordinates, isValid := controller.parseWavefrontFloats(fields, 3)
+			if !isValid {
+				return nil, nil, nil, invalidWavefrontLineError("OBJ", lineIndex+1, line)
+			}
+			currentNormal, _ := vector.Init(3)
+			for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+				_ = currentNormal.SetCoordinate(coordinateIndex, coordinates[coordinateIndex])
+			}
+			normals = append(normals, currentNormal)
+		case "o", "g":
+			groupName = defaultObjGroupName
+			if len(fields) > 1 {
+				groupName = strings.Join(fields[1:], " ")
+			}
+		case "usemtl":
+			if len(fields) < 2 {
+				return nil, nil, nil, invalidWavefrontLineError("OBJ", lineIndex+1, line)
+			}
+			materialName = strings.Join(fields[1:], " ")
+		case "f":
+			vertexIndexes, normalIndexes, isValid := controller.parseObjFace(fields, len(points), len(normals))
+			if !isValid {
+				return nil, nil, nil, invalidWavefrontLineError("OBJ", lineIndex+1, line)
+			}
+			groupKey := groupName + "\n" + materialName
+			currentGroup, found := groupsByKey[groupKey]
+			if !found {
+				currentGroup = &objGroup{groupName: groupName, materialName: materialName}
+				groupsByKey[groupKey] = currentGroup
+				groups = append(groups, currentGroup)
+			}
+			currentGroup.faces = append(currentGroup.faces, [][]int{vertexIndexes, normalIndexes})
+		}
+	}
+	return points, normals, groups, nil
+}
+
+// findFaceNormal finds the normal of a triangle from the order of its vertices.
+//
+// Parameters:
+// 	vertices - The vertices of the triangle.
+//
+// Returns:
+// 	The normalized normal.
+// 	If the triangle has area.
+//
+func (*Controller) findFaceNormal(vertices []*point.Point) (*vector.Vector, bool) {
+	pointController := point.Controller{}
+	vectorController := vector.Controller{}
+	firstEdge, _ := pointController.ExtractVector(vertices[0], vertices[1])
+	secondEdge, _ := pointController.ExtractVector(vertices[0], vertices[2])
+	normal, _ := vectorController.CrossProduct(firstEdge, secondEdge)
+	if vectorController.Norm(normal) == 0 {
+		return nil, false
+	}
+	return vectorController.Normalize(normal), true
+}
+
+// buildObjObject builds an object with the faces of a group of a Wavefront OBJ file.
+// The faces are split in triangles as fans and the faces without normals get the normal of their triangles.
+//
+// Parameters:
+// 	name            - The name of the object.
+// 	points          - The vertices of the OBJ file.
+// 	normals         - The normals of the OBJ file.
+// 	currentGroup    - The group.
+// 	currentMaterial - The material of the group.
+//
+// Returns:
+// 	The object, nil if none of the triangles has area.
+// 	An error.
+//
+func (controller *Controller) buildObjObject(name string, points []*point.Point, normals []*vector.Vector,
+	currentGroup *objGroup, currentMaterial *mtlMaterial) (*object.Object, error) {
+	objectPoints := make([]*point.Point, 0)
+	objectNormals := make([]*vector.Vector, 0)
+	objectTriangles := make([]*triangle.Triangle, 0)
+	pointsIndexes := make(map[int]int)
+	normalsIndexes := make(map[int]int)
+
+	findPointIndex := func(pointIndex int) int {
+		objectPointIndex, found := pointsIndexes[pointIndex]
+		if !found {
+			objectPointIndex = len(objectPoints)
+			pointsIndexes[pointIndex] = objectPointIndex
+			objectPoints = append(objectPoints, points[pointIndex])
+		}
+		return objectPointIndex
+	}
+	findNormalIndex := func(normalIndex int) int {
+		objectNormalIndex, found := normalsIndexes[normalIndex]
+		if !found {
+			objectNormalIndex = len(objectNormals)
+			normalsIndexes[normalIndex] = objectNormalIndex
+			objectNormals = append(objectNormals, normals[normalIndex])
+		}
+		return objectNormalIndex
+	}
+
+	for _, face := range currentGroup.faces {
+		vertexIndexes := face[0]
+		normalIndexes := face[1]
+		for fanIndex := 1; fanIndex < len(vertexIndexes)-1; fanIndex++ {
+			cornerIndexes := []int{0, fanIndex, fanIndex + 1}
+			triangleVertices := make([]*point.Point, 3)
+			for vertexIndex, cornerIndex := range cornerIndexes {
+				triangleVertices[vertexIndex] = points[vertexIndexes[cornerIndex]]
+			}
+			faceNormal, hasArea := controller.findFaceNormal(triangleVertices)
+			if !hasArea {
+				continue
+			}
+
+			triangleVerticesIndexes := make([]int, 3)
+			triangleNormalsIndexes := make([]int, 3)
+			for vertexIndex, cornerIndex := range cornerIndexes {
+				triangleVerticesIndexes[vertexIndex] = findPointIndex(vertexIndexes[cornerIndex])
+				if normalIndexes[cornerIndex] >= 0 {
+					triangleNormalsIndexes[vertexIndex] = findNormalIndex(normalIndexes[cornerIndex])
+				} else {
+					triangleNormalsIndexes[vertexIndex] = len(objectNormals)
+				}
+			}
+			if normalIndexes[0] < 0 {
+				objectNormals = append(objectNormals, faceNormal)
+			}
+			currentTriangle, err := triangle.Init(triangleVerticesIndexes, triangleNormalsIndexes)
+			if err != nil {
+				return nil, err
+			}
+			objectTriangles = append(objectTriangles, currentTriangle)
+		}
+	}
+	if len(objectTriangles) == 0 {
+		return nil, nil
+	}
+
+	repository, err := point_repository.Init(objectPoints, 3)
+	if err != nil {
+		return nil, err
+	}
+	color, specularReflection, roughNess, transmissionReflection, diffuseReflection, refractiveIndex :=
+		controller.findLightCharacteristics(currentMaterial)
+	return object.Init(name, repository, objectTriangles, []*sphere.Sphere{}, objectNormals, color,
+		specularReflection, roughNess, transmissionReflection, diffuseReflection, refractiveIndex, nil)
+}
+
+// ParseObjectsFromObj parses the objects of a Wavefront OBJ file and its MTL material library.
+// Every group or object of the file becomes an object, split by material when it uses more than one. Faces without a
+// material use a light gray diffuse one.
+//
+// Parameters:
+// 	objContent - The content of the OBJ file.
+// 	mtlContent - The content of the MTL file, empty when there is none.
+//
+// Returns:
+// 	The objects.
+// 	An error.
+//
+func (controller *Controller) ParseObjectsFromObj(objContent, mtlContent string) ([]*object.Object, error) {
+	materials, err := controller.parseMtl(mtlContent)
+	if err != nil {
+		return nil, err
+	}
+	points, normals, groups, err := controller.parseObjGroups(objContent)
+	if err != nil {
+		return nil, err
+	}
+
+	groupsPerName := make(map[string]int)
+	for _, currentGroup := range groups {
+		groupsPerName[currentGroup.groupName]++
+	}
+
+	objects := make([]*object.Object, 0, len(groups))
+	for _, currentGroup := range groups {
+		currentMaterial := initDefaultMtlMaterial()
+		if currentGroup.materialName != "" {
+			namedMaterial, found := materials[currentGroup.materialName]
+			if !found {
+				return nil, unknownMtlMaterialError(currentGroup.materialName)
+			}
+			currentMaterial = namedMaterial
+		}
+
+		name := currentGroup.groupName
+		if groupsPerName[name] > 1 && currentGroup.materialName != "" {
+			name = name + "/" + currentGroup.materialName
+		}
+		currentObject, err := controller.buildObjObject(name, points, normals, currentGroup, currentMaterial)
+		if err != nil {
+			return nil, err
+		}
+		if currentObject != nil {
+			objects = append(objects, currentObject)
+		}
+	}
+	return objects, nil
+}
+
+// decodeMeshText decodes the text of an inline mesh file.
+//
+// Parameters:
+// 	meshText     - The text of the file.
+// 	meshEncoding - The encoding of the text, text or base64.
+//
+// Returns:
+// 	The content of the file.
+// 	An error.
+//
+func (*Controller) decodeMeshText(meshText, meshEncoding string) (string, error) {
+	switch meshEncoding {
+	case "text":
+		return meshText, nil
+	case "base64":
+		decodedMesh, err := base64.StdEncoding.DecodeString(meshText)
+		if err != nil {
+			return "", err
+		}
+		return string(decodedMesh), nil
+	}
+	return "", errors.New("invalid mesh encoding")
+}
+
+// parseMeshFromDTO parses the objects of an inline Wavefront OBJ mesh from its DTO, all placed by its transformation.
+// The issues of the parsed objects are reported at the mesh, prefixed by the names of the objects.
+//
+// Parameters:
+//  path      - The JSON path of the object of the mesh.
+//  objectDTO - The object data as a DTO, with the mesh and its optional material library and encoding.
+//
+// Returns:
+// 	The objects.
+// 	An error.
+//
+func (controller *Controller) parseMeshFromDTO(path string, objectDTO *SceneObjectDTO) ([]*object.Object, error) {
+	meshEncoding := optionalString(objectDTO.MeshEncoding, "text")
+	objContent, err := controller.decodeMeshText(*objectDTO.Mesh, meshEncoding)
+	if err != nil {
+		return nil, invalidFieldError(fieldPath(path, "mesh"), err)
+	}
+	mtlContent, err := controller.decodeMeshText(optionalString(objectDTO.MaterialLibrary, ""), meshEncoding)
+	if err != nil {
+		return nil, invalidFieldError(fieldPath(path, "materialLibrary"), err)
+	}
+
+	objects, err := controller.ParseObjectsFromObj(objContent, mtlContent)
+	if err != nil {
+		return nil, invalidFieldError(fieldPath(path, "mesh"), err)
+	}
+	objects, err = controller.transformObjectsFromDTO(path, objectDTO.Transform, objects)
+	if err != nil {
+		return nil, err
+	}
+
+	sceneValidationController := scene_validation.Controller{}
+	var fieldErrors []*FieldError
+	for _, meshObject := range objects {
+		for _, issue := range sceneValidationController.ValidateObject(meshObject) {
+			fieldErrors = append(fieldErrors, initFieldError(fieldPath(path, "mesh"),
+				fmt.Sprintf("object %q: %s", meshObject.GetName(), issue.Error())))
+		}
+	}
+	if len(fieldErrors) > 0 {
+		return nil, schemaError(fieldErrors)
+	}
+	return objects, nil
+}
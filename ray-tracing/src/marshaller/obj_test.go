@@ -0,0 +1,215 @@
+package marshaller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"reflect"
+	"testing"
+)
+
+// quadObj is an OBJ file with a quad with normals and a triangle without normals using negative indexes.
+const quadObj = `# test mesh
+mtllib scene.mtl
+v 0 0 0
+v 1 0 0
+v 1 1 0
+v 0 1 0
+vn 0 0 1
+vt 0 0
+o floor
+usemtl white
+f 1//1 2//1 3//1 4//1
+o wall
+usemtl glass
+f -4 -2 -1
+`
+
+// quadMtl is the material library of quadObj.
+const quadMtl = `newmtl white
+Kd 0.5 0.25 1
+Ks 0 0 0
+newmtl glass
+Kd 0 0 0
+Ks 1 1 1
+Ns 0
+Ni 1.5
+d 0.25
+illum 5
+`
+
+// TestController_ParseObjectsFromObj tests parsing the objects of an OBJ file.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParseObjectsFromObj(t *testing.T) {
+	controller := Controller{}
+	objects, err := controller.ParseObjectsFromObj(quadObj, quadMtl)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 2, len(objects))
+
+	floor := objects[0]
+	test_helpers.AssertEqual(t, "floor", floor.GetName())
+	test_helpers.AssertEqual(t, 2, len(floor.GetTriangles()))
+	test_helpers.AssertEqual(t, 4, floor.GetRepository().NumberOfPoints())
+	test_helpers.AssertEqual(t, 1, len(floor.GetNormals()))
+	secondVertexIndex, _ := floor.GetTriangles()[1].GetVertexIndex(1)
+	test_helpers.AssertEqual(t, 2, secondVertexIndex)
+	floorCharacteristics := floor.GetLightCharacteristics()
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0.5, 0.25, 1}, floorCharacteristics.GetColor()))
+	test_helpers.AssertEqual(t, 1.0, floorCharacteristics.GetDiffuseReflection())
+
+	wall := objects[1]
+	test_helpers.AssertEqual(t, "wall", wall.GetName())
+	test_helpers.AssertEqual(t, 1, len(wall.GetTriangles()))
+	test_helpers.AssertEqual(t, 3, wall.GetRepository().NumberOfPoints())
+	test_helpers.AssertEqual(t, 1, len(wall.GetNormals()))
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0, 0, 1},
+		wall.GetNormals()[0].CopyAllCoordinates()))
+	wallCharacteristics := wall.GetLightCharacteristics()
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{1, 1, 1}, wallCharacteristics.GetColor()))
+	test_helpers.AssertEqual(t, 0.75, wallCharacteristics.GetTransmissionReflection())
+	test_helpers.AssertEqual(t, 0.25, wallCharacteristics.GetSpecularReflection())
+	test_helpers.AssertEqual(t, 0.0, wallCharacteristics.GetDiffuseReflection())
+	test_helpers.AssertEqual(t, 0.0, wallCharacteristics.GetRoughNess())
+	test_helpers.AssertEqual(t, 1.5, wallCharacteristics.GetRefractiveIndex())
+}
+
+// TestController_ParseObjectsFromObj_DefaultMaterial tests parsing an OBJ file without materials.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParseObjectsFromObj_DefaultMaterial(t *testing.T) {
+	controller := Controller{}
+	objects, err := controller.ParseObjectsFromObj("v 0 0 0\nv 1 0 0\nv 0 1 0\nv 2 0 0\nf 1 2 3\nf 1 2 4\n", "")
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 1, len(objects))
+	test_helpers.AssertEqual(t, defaultObjGroupName, objects[0].GetName())
+	test_helpers.AssertEqual(t, 1, len(objects[0].GetTriangles()))
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0.8, 0.8, 0.8},
+		objects[0].GetLightCharacteristics().GetColor()))
+}
+
+// TestController_ParseObjectsFromObj_MultipleMaterials tests parsing a group with more than one material.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParseObjectsFromObj_MultipleMaterials(t *testing.T) {
+	controller := Controller{}
+	objects, err := controller.ParseObjectsFromObj(
+		"v 0 0 0\nv 1 0 0\nv 0 1 0\ng box\nusemtl white\nf 1 2 3\nusemtl glass\nf 3 2 1\n", quadMtl)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 2, len(objects))
+	test_helpers.AssertEqual(t, "box/white", objects[0].GetName())
+	test_helpers.AssertEqual(t, "box/glass", objects[1].GetName())
+}
+
+// TestController_ParseObjectsFromObj_UnknownMaterial tests parsing an OBJ file with a material out of the library.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParseObjectsFromObj_UnknownMaterial(t *testing.T) {
+	controller := Controller{}
+	_, err := controller.ParseObjectsFromObj("v 0 0 0\nv 1 0 0\nv 0 1 0\nusemtl metal\nf 1 2 3\n", quadMtl)
+	test_helpers.AssertNotNilError(t, err)
+}
+
+// TestController_ParseObjectsFromObj_InvalidLine tests parsing OBJ and MTL files with invalid lines.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParseObjectsFromObj_InvalidLine(t *testing.T) {
+	controller := Controller{}
+	_, err := controller.ParseObjectsFromObj("v 0 0 0\nv 1 0 0\nv 0 1 0\nf 1 2 4\n", "")
+	test_helpers.AssertNotNilError(t, err)
+	_, err = controller.ParseObjectsFromObj("v 0 0\n", "")
+	test_helpers.AssertNotNilError(t, err)
+	_, err = controller.ParseObjectsFromObj("v 0 0 0\n", "newmtl white\nKd 1 a 1\n")
+	test_helpers.AssertNotNilError(t, err)
+}
+
+// TestController_ParseObjectsFromDTO_Mesh tests parsing inline meshes along with the other objects.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParseObjectsFromDTO_Mesh(t *testing.T) {
+	controller := Controller{}
+	mesh, materialLibrary, meshEncoding := quadObj, quadMtl, "text"
+	encodedMesh := base64.StdEncoding.EncodeToString([]byte(quadObj))
+	encodedMaterialLibrary := base64.StdEncoding.EncodeToString([]byte(quadMtl))
+	base64Encoding := "base64"
+	objectDTOs := []SceneObjectDTO{
+		{Mesh: &mesh, MaterialLibrary: &materialLibrary, MeshEncoding: &meshEncoding},
+		{Mesh: &encodedMesh, MaterialLibrary: &encodedMaterialLibrary, MeshEncoding: &base64Encoding},
+	}
+	objects, err := controller.parseObjectsFromDTO(objectDTOs)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 4, len(objects))
+	test_helpers.AssertEqual(t, true, objects[0].IsEqual(objects[2]))
+	test_helpers.AssertEqual(t, true, objects[1].IsEqual(objects[3]))
+}
+
+// TestController_ParseObjectsFromDTO_InvalidMesh tests parsing an inline mesh that is not valid base64.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ParseObjectsFromDTO_InvalidMesh(t *testing.T) {
+	controller := Controller{}
+	mesh, meshEncoding := quadObj, "base64"
+	_, err := controller.parseObjectsFromDTO([]SceneObjectDTO{{Mesh: &mesh, MeshEncoding: &meshEncoding}})
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, "objects[0].mesh", err.(*SchemaError).GetFieldErrors()[0].GetPath())
+}
+
+// TestController_ObjectsToJson tests that the JSON of the objects is parsed back to the same objects.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ObjectsToJson(t *testing.T) {
+	controller := Controller{}
+	objects, err := controller.ParseObjectsFromObj(quadObj, quadMtl)
+	test_helpers.AssertNilError(t, err)
+
+	objectsJson, err := controller.ObjectsToJson(objects)
+	test_helpers.AssertNilError(t, err)
+	var pathTracingDTO PathTracingDTO
+	test_helpers.AssertNilError(t, json.Unmarshal(objectsJson, &pathTracingDTO))
+	parsedObjects, err := controller.parseObjectsFromDTO(pathTracingDTO.Objects)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, len(objects), len(parsedObjects))
+	for objectIndex := range objects {
+		test_helpers.AssertEqual(t, true, objects[objectIndex].IsEqual(parsedObjects[objectIndex]))
+	}
+}
@@ -1,96 +1,62 @@
 package marshaller
 
 import (
-	"errors"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/point_repository"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/scene_validation"
 )
 
-// parseRepositoryFromMap parses repository from map.
+// parseRepositoryFromDTO parses a repository from its DTO.
 //
 // Parameters:
-//  objectData - The object data.
+//  path          - The JSON path of the repository.
+//  repositoryDTO - The repository as a DTO.
 //
 // Returns:
 // 	The point repository.
 // 	An error.
 //
-func (controller *Controller) parseRepositoryFromMap(objectData map[string]interface{}) (
+func (controller *Controller) parseRepositoryFromDTO(path string, repositoryDTO *RepositoryDTO) (
 	*point_repository.PointRepository, error) {
-	errorMessage := "unable to parse repository"
-
-	repositoryInterface, found := objectData["repository"]
-	if !found {
-		return nil, errors.New(errorMessage)
-	}
-	repositoryMap, parsed := repositoryInterface.(map[string]interface{})
-	if !parsed {
-		return nil, errors.New(errorMessage)
-	}
-
-	pointsInterface, found := repositoryMap["points"]
-	if !found {
-		return nil, errors.New(errorMessage)
-	}
-	pointsInterfaceList, parsed := pointsInterface.([]interface{})
-	if !parsed {
-		return nil, errors.New(errorMessage)
-	}
-
-	points := make([]*point.Point, len(pointsInterfaceList))
-	for pointIndex := 0; pointIndex < len(pointsInterfaceList); pointIndex++ {
-		pointMap, parsed := pointsInterfaceList[pointIndex].(map[string]interface{})
-		if !parsed {
-			return nil, errors.New(errorMessage)
-		}
-		currentPoint, err := controller.parsePointFromMap(pointMap)
+	pointsPath := fieldPath(path, "points")
+	points := make([]*point.Point, len(repositoryDTO.Points))
+	for pointIndex := range repositoryDTO.Points {
+		currentPoint, err := controller.parsePointFromDTO(&repositoryDTO.Points[pointIndex])
 		if err != nil {
-			return nil, errors.New(errorMessage)
+			return nil, invalidFieldError(indexPath(pointsPath, pointIndex), err)
 		}
 		points[pointIndex] = currentPoint
 	}
 
 	repository, err := point_repository.Init(points, 3)
 	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, invalidFieldError(pointsPath, err)
 	}
 
 	return repository, nil
 }
 
-// parseTrianglesFromMap parses triangles from map.
+// parseTrianglesFromDTO parses triangles from their DTOs.
 //
 // Parameters:
-//  objectData - The object data.
+//  path         - The JSON path of the triangles.
+//  triangleDTOs - The triangles as DTOs.
 //
 // Returns:
 // 	The list of triangles.
 // 	An error.
 //
-func (controller *Controller) parseTrianglesFromMap(objectData map[string]interface{}) ([]*triangle.Triangle, error) {
-	errorMessage := "unable to parse triangles"
-
-	trianglesInterface, found := objectData["triangles"]
-	if !found {
-		return nil, errors.New(errorMessage)
-	}
-	trianglesInterfaceList, parsed := trianglesInterface.([]interface{})
-	if !parsed {
-		return nil, errors.New(errorMessage)
-	}
-
-	triangles := make([]*triangle.Triangle, len(trianglesInterfaceList))
-	for triangleIndex := 0; triangleIndex < len(trianglesInterfaceList); triangleIndex++ {
-		triangleMap, parsed := trianglesInterfaceList[triangleIndex].(map[string]interface{})
-		if !parsed {
-			return nil, errors.New(errorMessage)
-		}
-		currentTriangle, err := controller.parseTriangleFromMap(triangleMap)
+func (controller *Controller) parseTrianglesFromDTO(path string, triangleDTOs []TriangleDTO) (
+	[]*triangle.Triangle, error) {
+	triangles := make([]*triangle.Triangle, len(triangleDTOs))
+	for triangleIndex := range triangleDTOs {
+		currentTriangle, err := controller.parseTriangleFromDTO(&triangleDTOs[triangleIndex])
 		if err != nil {
-			return nil, errors.New(errorMessage)
+			return nil, invalidFieldError(indexPath(path, triangleIndex), err)
 		}
 		triangles[triangleIndex] = currentTriangle
 	}
@@ -98,36 +64,40 @@ func (controller *Controller) parseTrianglesFromMap(objectData map[string]interf
 	return triangles, nil
 }
 
-// parseNormalsFromMap parses normals from map.
+// parseSpheresFromDTO parses spheres from their DTOs, which are optional.
 //
 // Parameters:
-//  objectData - The object data.
+//  sphereDTOs - The spheres as DTOs.
 //
 // Returns:
-// 	The list of normal vectors.
-// 	An error.
+// 	The list of spheres.
 //
-func (controller *Controller) parseNormalsFromMap(objectData map[string]interface{}) ([]*vector.Vector, error) {
-	errorMessage := "unable to parse normals"
-
-	normalsInterface, found := objectData["normals"]
-	if !found {
-		return nil, errors.New(errorMessage)
-	}
-	normalsInterfaceList, parsed := normalsInterface.([]interface{})
-	if !parsed {
-		return nil, errors.New(errorMessage)
+func (controller *Controller) parseSpheresFromDTO(sphereDTOs []SceneSphereDTO) []*sphere.Sphere {
+	spheres := make([]*sphere.Sphere, len(sphereDTOs))
+	for sphereIndex := range sphereDTOs {
+		spheres[sphereIndex] = controller.parseSphereFromDTO(&sphereDTOs[sphereIndex])
 	}
 
-	normals := make([]*vector.Vector, len(normalsInterfaceList))
-	for normalIndex := 0; normalIndex < len(normalsInterfaceList); normalIndex++ {
-		normalMap, parsed := normalsInterfaceList[normalIndex].(map[string]interface{})
-		if !parsed {
-			return nil, errors.New(errorMessage)
-		}
-		currentNormal, err := controller.parseVectorFromMap(normalMap)
+	return spheres
+}
+
+// parseNormalsFromDTO parses normals from their DTOs.
+//
+// Parameters:
+//  path       - The JSON path of the normals.
+//  normalDTOs - The normals as DTOs.
+//
+// Returns:
+// 	The list of normal vectors.
+// 	An error.
+//
+func (controller *Controller) parseNormalsFromDTO(path string, normalDTOs []CoordinatesDTO) ([]*vector.Vector,
+	error) {
+	normals := make([]*vector.Vector, len(normalDTOs))
+	for normalIndex := range normalDTOs {
+		currentNormal, err := controller.parseVectorFromDTO(&normalDTOs[normalIndex])
 		if err != nil {
-			return nil, errors.New(errorMessage)
+			return nil, invalidFieldError(indexPath(path, normalIndex), err)
 		}
 		normals[normalIndex] = currentNormal
 	}
@@ -135,135 +105,131 @@ func (controller *Controller) parseNormalsFromMap(objectData map[string]interfac
 	return normals, nil
 }
 
-// parseLightCharacteristicsFromMap parses an object is light characteristics from a map.
+// initObjectFromDTO initializes an object from its geometry and the DTO of its light characteristics.
 //
 // Parameters:
-//  objectData - The object data.
+//  path               - The JSON path of the light characteristics.
+//  name               - The name of the object.
+//  repository         - The point repository.
+//  triangles          - The triangles.
+//  spheres            - The spheres.
+//  normals            - The normals of the vertices.
+//  characteristicsDTO - The light characteristics as a DTO.
 //
 // Returns:
-//  RGB for the color of the object.
-//  Percentage of specular rays.
-//  How much reflections rays get distorted.
-//  Percentage of transmission rays.
-//  Percentage of diffuse rays.
+// 	An object.
 // 	An error.
 //
-func (controller *Controller) parseLightCharacteristicsFromMap(objectData map[string]interface{}) (
-	[]float64, float64, float64, float64, float64, error) {
-	errorMessage := "unable to parse light characteristics"
-
-	lightCharacteristicsInterface, found := objectData["lightCharacteristics"]
-	if !found {
-		return nil, 0, 0, 0, 0, errors.New(errorMessage)
-	}
-	lightsInterfaceListMap, parsed := lightCharacteristicsInterface.(map[string]interface{})
-	if !parsed {
-		return nil, 0, 0, 0, 0, errors.New(errorMessage)
-	}
-
-	color, err := controller.parseFloatListFromMap(lightsInterfaceListMap, "color")
+func (controller *Controller) initObjectFromDTO(path, name string, repository *point_repository.PointRepository,
+	triangles []*triangle.Triangle, spheres []*sphere.Sphere, normals []*vector.Vector,
+	characteristicsDTO *SceneLightCharacteristicsDTO) (*object.Object, error) {
+	refractiveIndex := optionalFloat(characteristicsDTO.RefractiveIndex, 1)
+	surfaceMaterial, err := controller.parseMaterialFromDTO(fieldPath(path, "material"), characteristicsDTO.Material,
+		characteristicsDTO.Color, refractiveIndex)
 	if err != nil {
-		return nil, 0, 0, 0, 0, errors.New(errorMessage)
+		return nil, err
 	}
 
-	specularReflection, err := controller.parseFloatFromMap(lightsInterfaceListMap, "specularReflection")
-	if err != nil {
-		return nil, 0, 0, 0, 0, errors.New(errorMessage)
-	}
-	roughNess, err := controller.parseFloatFromMap(lightsInterfaceListMap, "roughNess")
-	if err != nil {
-		return nil, 0, 0, 0, 0, errors.New(errorMessage)
-	}
-	transmissionReflection, err := controller.parseFloatFromMap(lightsInterfaceListMap, "transmissionReflection")
-	if err != nil {
-		return nil, 0, 0, 0, 0, errors.New(errorMessage)
-	}
-	diffuseReflection, err := controller.parseFloatFromMap(lightsInterfaceListMap, "diffuseReflection")
+	parsedObject, err := object.Init(name, repository, triangles, spheres, normals, characteristicsDTO.Color,
+		*characteristicsDTO.SpecularReflection, *characteristicsDTO.RoughNess,
+		*characteristicsDTO.TransmissionReflection, *characteristicsDTO.DiffuseReflection, refractiveIndex,
+		surfaceMaterial)
 	if err != nil {
-		return nil, 0, 0, 0, 0, errors.New(errorMessage)
+		return nil, invalidFieldError(path, err)
 	}
-
-	return color, specularReflection, roughNess, transmissionReflection, diffuseReflection, nil
+	return parsedObject, nil
 }
 
-// parseObjectFromMap parses an object from a map.
+// parseObjectFromDTO parses an object from its DTO, placing it by its transformation and checking that it can be
+// rendered.
 //
 // Parameters:
-//  objectData - The object data.
+//  path      - The JSON path of the object.
+//  objectDTO - The object as a DTO.
 //
 // Returns:
 // 	An object.
 // 	An error.
 //
-func (controller *Controller) parseObjectFromMap(objectData map[string]interface{}) (*object.Object, error) {
-	errorMessage := "unable to parse object"
-
-	name, err := controller.parseStringFromMap(objectData, "name")
+func (controller *Controller) parseObjectFromDTO(path string, objectDTO *SceneObjectDTO) (*object.Object, error) {
+	normals, err := controller.parseNormalsFromDTO(fieldPath(path, "normals"), objectDTO.Normals)
 	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, err
 	}
 
-	color, specularReflection, roughness, transmissionReflection, diffuseReflection, err :=
-		controller.parseLightCharacteristicsFromMap(objectData)
+	repository, err := controller.parseRepositoryFromDTO(fieldPath(path, "repository"), objectDTO.Repository)
 	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, err
 	}
 
-	normals, err := controller.parseNormalsFromMap(objectData)
+	triangles, err := controller.parseTrianglesFromDTO(fieldPath(path, "triangles"), objectDTO.Triangles)
 	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, err
 	}
 
-	repository, err := controller.parseRepositoryFromMap(objectData)
+	spheres := controller.parseSpheresFromDTO(objectDTO.Spheres)
+
+	parsedObject, err := controller.initObjectFromDTO(fieldPath(path, "lightCharacteristics"), *objectDTO.Name,
+		repository, triangles, spheres, normals, objectDTO.LightCharacteristics)
 	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, err
 	}
-
-	triangles, err := controller.parseTrianglesFromMap(objectData)
+	transformedObjects, err := controller.transformObjectsFromDTO(path, objectDTO.Transform,
+		[]*object.Object{parsedObject})
 	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, err
 	}
+	parsedObject = transformedObjects[0]
 
-	parsedObject, err := object.Init(name, repository, triangles, normals, color, specularReflection, roughness,
-		transmissionReflection, diffuseReflection)
-	if err != nil {
-		return nil, errors.New(errorMessage)
+	sceneValidationController := scene_validation.Controller{}
+	issues := sceneValidationController.ValidateObject(parsedObject)
+	if len(issues) > 0 {
+		return nil, sceneIssuesError(path, issues)
 	}
 	return parsedObject, nil
 }
 
-// parseObjectsFromMap parses objects from map.
+// parseSceneObjectFromDTO parses an object of the scene from its DTO.
+// An object with a mesh is a Wavefront OBJ file and may become more than one object.
 //
 // Parameters:
-//  pathTracingData - The path tracing data.
+//  path      - The JSON path of the object.
+//  objectDTO - The object as a DTO.
 //
 // Returns:
 // 	The list of objects.
 // 	An error.
 //
-func (controller *Controller) parseObjectsFromMap(pathTracingData map[string]interface{}) ([]*object.Object, error) {
-	errorMessage := "unable to parse objects"
-
-	objectsInterface, found := pathTracingData["objects"]
-	if !found {
-		return nil, errors.New(errorMessage)
+func (controller *Controller) parseSceneObjectFromDTO(path string, objectDTO *SceneObjectDTO) ([]*object.Object,
+	error) {
+	if objectDTO.Mesh != nil {
+		return controller.parseMeshFromDTO(path, objectDTO)
 	}
-	objectsInterfaceList, parsed := objectsInterface.([]interface{})
-	if !parsed {
-		return nil, errors.New(errorMessage)
+	currentObject, err := controller.parseObjectFromDTO(path, objectDTO)
+	if err != nil {
+		return nil, err
 	}
+	return []*object.Object{currentObject}, nil
+}
 
-	objects := make([]*object.Object, len(objectsInterfaceList))
-	for objectIndex := 0; objectIndex < len(objectsInterfaceList); objectIndex++ {
-		objectMap, parsed := objectsInterfaceList[objectIndex].(map[string]interface{})
-		if !parsed {
-			return nil, errors.New(errorMessage)
-		}
-		currentObject, err := controller.parseObjectFromMap(objectMap)
+// parseObjectsFromDTO parses objects from their DTOs.
+//
+// Parameters:
+//  objectDTOs - The objects as DTOs.
+//
+// Returns:
+// 	The list of objects.
+// 	An error.
+//
+func (controller *Controller) parseObjectsFromDTO(objectDTOs []SceneObjectDTO) ([]*object.Object, error) {
+	objects := make([]*object.Object, 0, len(objectDTOs))
+	for objectIndex := range objectDTOs {
+		currentObjects, err := controller.parseSceneObjectFromDTO(indexPath("objects", objectIndex),
+			&objectDTOs[objectIndex])
 		if err != nil {
-			return nil, errors.New(errorMessage)
+			return nil, err
 		}
-		objects[objectIndex] = currentObject
+		objects = append(objects, currentObjects...)
 	}
 
 	return objects, nil
@@ -0,0 +1,88 @@
+package marshaller
+
+// CoordinatesDTO is a class for sending a Point or a Vector.
+//
+// Members:
+// 	Coordinates - The coordinates.
+//
+type CoordinatesDTO struct {
+	Coordinates []float64 `json:"coordinates"`
+}
+
+// TriangleDTO is a class for sending a Triangle.
+//
+// Members:
+// 	VerticesIndices        - The indexes of the vertices in the point repository.
+// 	VerticesNormalsIndices - The indexes of the normals of the vertices.
+//
+type TriangleDTO struct {
+	VerticesIndices        []int `json:"verticesIndices"`
+	VerticesNormalsIndices []int `json:"verticesNormalsIndices"`
+}
+
+// SphereDTO is a class for sending a Sphere.
+//
+// Members:
+// 	CenterPointIndex - The index of the center in the point repository.
+// 	Radius           - The radius.
+//
+type SphereDTO struct {
+	CenterPointIndex int     `json:"centerPointIndex"`
+	Radius           float64 `json:"radius"`
+}
+
+// RepositoryDTO is a class for sending a PointRepository.
+//
+// Members:
+// 	Points - The points.
+//
+type RepositoryDTO struct {
+	Points []CoordinatesDTO `json:"points"`
+}
+
+// LightCharacteristicsDTO is a class for sending the light characteristics of an Object.
+//
+// Members:
+// 	Color                  - RGB for the color of the object.
+// 	SpecularReflection     - Percentage of specular rays.
+// 	RoughNess              - How much reflections rays get distorted.
+// 	TransmissionReflection - Percentage of transmission rays.
+// 	DiffuseReflection      - Percentage of diffuse rays.
+// 	RefractiveIndex        - The index of refraction of the material of the object.
+//
+type LightCharacteristicsDTO struct {
+	Color                  []float64 `json:"color"`
+	SpecularReflection     float64   `json:"specularReflection"`
+	RoughNess              float64   `json:"roughNess"`
+	TransmissionReflection float64   `json:"transmissionReflection"`
+	DiffuseReflection      float64   `json:"diffuseReflection"`
+	RefractiveIndex        float64   `json:"refractiveIndex"`
+}
+
+// ObjectDTO is a class for sending an Object.
+//
+// Members:
+// 	Name                 - The name of the Object.
+// 	Repository           - The point repository.
+// 	Triangles            - The triangles.
+// 	Spheres              - The spheres.
+// 	Normals              - The normals of the vertices.
+// 	LightCharacteristics - The light characteristics.
+//
+type ObjectDTO struct {
+	Name                 string                  `json:"name"`
+	Repository           RepositoryDTO           `json:"repository"`
+	Triangles            []TriangleDTO           `json:"triangles"`
+	Spheres              []SphereDTO             `json:"spheres,omitempty"`
+	Normals              []CoordinatesDTO        `json:"normals"`
+	LightCharacteristics LightCharacteristicsDTO `json:"lightCharacteristics"`
+}
+
+// ObjectsDTO is a class for sending the objects of a scene.
+//
+// Members:
+// 	Objects - The objects.
+//
+type ObjectsDTO struct {
+	Objects []ObjectDTO `json:"objects"`
+}
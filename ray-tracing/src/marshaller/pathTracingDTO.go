@@ -0,0 +1,243 @@
+package marshaller
+
+// PathTracingDTO is a class for receiving the inputs of a path tracing run.
+// Required members are pointers or lists, nil when they are missing from the JSON.
+//
+// Members:
+// 	PathTracingParameters - The parameters of the path tracing.
+// 	PixelScreen           - The screen of pixels.
+// 	SceneCamera           - The camera of the scene.
+// 	Lights                - The lights of the scene.
+// 	Objects               - The objects of the scene.
+// 	Meshes                - The optional meshes, which are only rendered by their instances.
+// 	Instances             - The optional instances of the meshes.
+//
+type PathTracingDTO struct {
+	PathTracingParameters *PathTracingParametersDTO `json:"pathTracingParameters"`
+	PixelScreen           *PixelScreenDTO           `json:"pixelScreen"`
+	SceneCamera           *CameraDTO                `json:"sceneCamera"`
+	Lights                []LightDTO                `json:"lights"`
+	Objects               []SceneObjectDTO          `json:"objects"`
+	Meshes                []SceneObjectDTO          `json:"meshes"`
+	Instances             []InstanceDTO             `json:"instances"`
+}
+
+// PathTracingParametersDTO is a class for receiving the Parameters of a path tracing.
+//
+// Members:
+// 	RaysPerPixel         - The number of rays per pixel.
+// 	Recursions           - The number of recursions of each ray.
+// 	WindowStartLine      - The starting line index of the window of the screen.
+// 	WindowStartColumn    - The starting column index of the window of the screen.
+// 	WindowEndLine        - The ending line index of the window of the screen.
+// 	WindowEndColumn      - The ending column index of the window of the screen.
+// 	Estimator            - The optional estimator of the color of the rays.
+// 	ToneMapping          - The optional tone mapping.
+// 	TileSize             - The optional size of the tiles of pixels.
+// 	Seed                 - The optional seed of the random streams.
+// 	Sampler              - The optional sampler type.
+// 	RussianRouletteDepth - The optional number of bounces before Russian roulette.
+// 	AdaptiveSampling     - The optional adaptive sampling.
+// 	Passes               - The optional number of passes.
+//
+type PathTracingParametersDTO struct {
+	RaysPerPixel         *int                 `json:"raysPerPixel"`
+	Recursions           *int                 `json:"recursions"`
+	WindowStartLine      *int                 `json:"windowStartLine"`
+	WindowStartColumn    *int                 `json:"windowStartColumn"`
+	WindowEndLine        *int                 `json:"windowEndLine"`
+	WindowEndColumn      *int                 `json:"windowEndColumn"`
+	Estimator            *string              `json:"estimator"`
+	ToneMapping          *ToneMappingDTO      `json:"toneMapping"`
+	TileSize             *int                 `json:"tileSize"`
+	Seed                 *int64               `json:"seed"`
+	Sampler              *string              `json:"sampler"`
+	RussianRouletteDepth *int                 `json:"russianRouletteDepth"`
+	AdaptiveSampling     *AdaptiveSamplingDTO `json:"adaptiveSampling"`
+	Passes               *int                 `json:"passes"`
+}
+
+// ToneMappingDTO is a class for receiving a ToneMapping, whose members are all optional.
+//
+// Members:
+// 	Exposure         - The exposure in stops.
+// 	Operator         - The tone mapping operator.
+// 	TransferFunction - The transfer function.
+//
+type ToneMappingDTO struct {
+	Exposure         *float64 `json:"exposure"`
+	Operator         *string  `json:"operator"`
+	TransferFunction *string  `json:"transferFunction"`
+}
+
+// AdaptiveSamplingDTO is a class for receiving an AdaptiveSampling.
+//
+// Members:
+// 	MinimumSamples - The number of rays every pixel traces before checking if it converged.
+// 	MaximumSamples - The most rays a pixel traces.
+// 	NoiseThreshold - The standard error of the luminance under which a pixel converged.
+//
+type AdaptiveSamplingDTO struct {
+	MinimumSamples *int     `json:"minimumSamples"`
+	MaximumSamples *int     `json:"maximumSamples"`
+	NoiseThreshold *float64 `json:"noiseThreshold"`
+}
+
+// PixelScreenDTO is a class for receiving a Screen.
+//
+// Members:
+// 	Width  - The number of columns of pixels.
+// 	Height - The number of lines of pixels.
+//
+type PixelScreenDTO struct {
+	Width  *int `json:"width"`
+	Height *int `json:"height"`
+}
+
+// CameraDTO is a class for receiving a Camera.
+//
+// Members:
+// 	Position         - The position of the Camera.
+// 	Look             - Vector to were the Camera is looking.
+// 	Up               - Vector head of the Camera.
+// 	Right            - Side vector of the Camera.
+// 	FieldOfView      - The Camera is field of view in degrees.
+// 	DistanceToScreen - Distance to the screen.
+//
+type CameraDTO struct {
+	Position         *CoordinatesDTO `json:"position"`
+	Look             *CoordinatesDTO `json:"look"`
+	Up               *CoordinatesDTO `json:"up"`
+	Right            *CoordinatesDTO `json:"right"`
+	FieldOfView      *float64        `json:"fieldOfView"`
+	DistanceToScreen *float64        `json:"distanceToScreen"`
+}
+
+// LightDTO is a class for receiving a Light.
+//
+// Members:
+// 	LightIntensity - The intensity of the light.
+// 	Color          - The RGB of the light.
+// 	LightObject    - The object that defines the light.
+//
+type LightDTO struct {
+	LightIntensity *float64        `json:"lightIntensity"`
+	Color          []float64       `json:"color"`
+	LightObject    *SceneObjectDTO `json:"lightObject"`
+}
+
+// SceneObjectDTO is a class for receiving an Object, or an inline Wavefront OBJ mesh when Mesh is set.
+//
+// Members:
+// 	Name                 - The name of the Object.
+// 	Repository           - The point repository.
+// 	Triangles            - The triangles.
+// 	Spheres              - The optional spheres.
+// 	Normals              - The normals of the vertices.
+// 	LightCharacteristics - The light characteristics.
+// 	Mesh                 - The text of the OBJ file of a mesh.
+// 	MeshEncoding         - The optional encoding of the mesh and its material library, text or base64.
+// 	MaterialLibrary      - The optional text of the MTL file of a mesh.
+// 	Transform            - The optional transformation from the coordinates of the Object to the scene.
+//
+type SceneObjectDTO struct {
+	Name                 *string                       `json:"name"`
+	Repository           *RepositoryDTO                `json:"repository"`
+	Triangles            []TriangleDTO                 `json:"triangles"`
+	Spheres              []SceneSphereDTO              `json:"spheres"`
+	Normals              []CoordinatesDTO              `json:"normals"`
+	LightCharacteristics *SceneLightCharacteristicsDTO `json:"lightCharacteristics"`
+	Mesh                 *string                       `json:"mesh"`
+	MeshEncoding         *string                       `json:"meshEncoding"`
+	MaterialLibrary      *string                       `json:"materialLibrary"`
+	Transform            *TransformDTO                 `json:"transform"`
+}
+
+// InstanceDTO is a class for receiving an Instance, which places a mesh on the scene without copying its geometry.
+//
+// Members:
+// 	Name                 - The optional name of the Instance, the name of the mesh by default.
+// 	MeshName             - The name of the mesh.
+// 	Transform            - The optional transformation from the coordinates of the mesh to the scene.
+// 	LightCharacteristics - The optional light characteristics, the ones of the mesh by default.
+//
+type InstanceDTO struct {
+	Name                 *string                       `json:"name"`
+	MeshName             *string                       `json:"meshName"`
+	Transform            *TransformDTO                 `json:"transform"`
+	LightCharacteristics *SceneLightCharacteristicsDTO `json:"lightCharacteristics"`
+}
+
+// TransformDTO is a class for receiving an affine transformation, either as a scale, a rotation and a translation
+// applied in this order, or as a raw matrix.
+//
+// Members:
+// 	Translation - The optional offset along the x, y and z axes.
+// 	Rotation    - The optional rotation.
+// 	Scale       - The optional factors along the x, y and z axes.
+// 	Matrix      - The 4x4 matrix in homogeneous coordinates, as lines, instead of the other members.
+//
+type TransformDTO struct {
+	Translation []float64    `json:"translation"`
+	Rotation    *RotationDTO `json:"rotation"`
+	Scale       []float64    `json:"scale"`
+	Matrix      [][]float64  `json:"matrix"`
+}
+
+// RotationDTO is a class for receiving a rotation, either around an axis or by Euler angles.
+//
+// Members:
+// 	Axis  - The direction of the axis through the origin.
+// 	Angle - The angle around the axis in degrees.
+// 	Euler - The angles around the x, y and z axes in degrees, applied in this order, instead of the axis.
+//
+type RotationDTO struct {
+	Axis  []float64 `json:"axis"`
+	Angle *float64  `json:"angle"`
+	Euler []float64 `json:"euler"`
+}
+
+// SceneSphereDTO is a class for receiving a Sphere.
+//
+// Members:
+// 	CenterPointIndex - The index of the center in the point repository.
+// 	Radius           - The radius.
+//
+type SceneSphereDTO struct {
+	CenterPointIndex *int     `json:"centerPointIndex"`
+	Radius           *float64 `json:"radius"`
+}
+
+// SceneLightCharacteristicsDTO is a class for receiving the light characteristics of an Object.
+//
+// Members:
+// 	Color                  - RGB for the color of the object.
+// 	SpecularReflection     - Percentage of specular rays.
+// 	RoughNess              - How much reflections rays get distorted.
+// 	TransmissionReflection - Percentage of transmission rays.
+// 	DiffuseReflection      - Percentage of diffuse rays.
+// 	RefractiveIndex        - The optional index of refraction of the material of the object.
+// 	Material               - The optional material of the object.
+//
+type SceneLightCharacteristicsDTO struct {
+	Color                  []float64    `json:"color"`
+	SpecularReflection     *float64     `json:"specularReflection"`
+	RoughNess              *float64     `json:"roughNess"`
+	TransmissionReflection *float64     `json:"transmissionReflection"`
+	DiffuseReflection      *float64     `json:"diffuseReflection"`
+	RefractiveIndex        *float64     `json:"refractiveIndex"`
+	Material               *MaterialDTO `json:"material"`
+}
+
+// MaterialDTO is a class for receiving a Material.
+//
+// Members:
+// 	Type      - The type of the material, lambertian, phong, ggx, mirror or dielectric.
+// 	Exponent  - The exponent of a phong material.
+// 	Roughness - The roughness of a ggx material.
+//
+type MaterialDTO struct {
+	Type      *string  `json:"type"`
+	Exponent  *float64 `json:"exponent"`
+	Roughness *float64 `json:"roughness"`
+}
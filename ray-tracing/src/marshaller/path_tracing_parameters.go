@@ -1,77 +1,46 @@
 package marshaller
 
 import (
-	"errors"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/path_tracing"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/sampler"
 )
 
-// pathTracingParameters is a class for lines.
-//
-// Members:
-// 	raysPerPixel      - The number of rays per pixel.
-// 	recursions        - The number recursions of each ray.
-// 	windowStartLine   - The starting line index of the window of the screen to use the path tracing.
-// 	windowStartColumn - The starting column index of the window of the screen to use the path tracing.
-// 	windowEndLine     - The ending line index of the window of the screen to use the path tracing.
-// 	windowEndColumn   - The ending column index of the window of the screen to use the path tracing.
-//
-type pathTracingParameters struct {
-	raysPerPixel int
-	recursions int
-	windowStartLine int
-	windowStartColumn int
-	windowEndLine int
-	windowEndColumn int
-}
-
-// parsePathTracingParametersFromMap parses a point from a map.
+// parsePathTracingParametersFromDTO parses the path tracing parameters from their DTO.
 //
 // Parameters:
-//  pathTracingData - The path tracing data.
+//  path          - The JSON path of the parameters.
+//  parametersDTO - The path tracing parameters as a DTO.
 //
 // Returns:
 // 	The path tracing parameters.
 // 	An error.
 //
-func (controller *Controller) parsePathTracingParametersFromMap(pathTracingData map[string]interface{}) (
-	*pathTracingParameters, error) {
-	errorMessage := "invalid path tracing parameters"
-
-	pathTracingParametersInterface, found := pathTracingData["pathTracingParameters"]
-	if !found {
-		return nil, errors.New(errorMessage)
-	}
-	pathTracingParametersMap, parsed := pathTracingParametersInterface.(map[string]interface{})
-	if !parsed {
-		return nil, errors.New(errorMessage)
-	}
-
-	raysPerPixel, err := controller.parseFloatFromMap(pathTracingParametersMap, "raysPerPixel")
+func (controller *Controller) parsePathTracingParametersFromDTO(path string,
+	parametersDTO *PathTracingParametersDTO) (*path_tracing.Parameters, error) {
+	toneMapping, err := controller.parseToneMappingFromDTO(fieldPath(path, "toneMapping"),
+		parametersDTO.ToneMapping)
 	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, err
 	}
-	recursions, err := controller.parseFloatFromMap(pathTracingParametersMap, "recursions")
+	adaptiveSampling, err := controller.parseAdaptiveSamplingFromDTO(fieldPath(path, "adaptiveSampling"),
+		parametersDTO.AdaptiveSampling)
 	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, err
 	}
-	windowStartLine, err := controller.parseFloatFromMap(pathTracingParametersMap, "windowStartLine")
-	if err != nil {
-		return nil, errors.New(errorMessage)
-	}
-	windowStartColumn, err := controller.parseFloatFromMap(pathTracingParametersMap, "windowStartColumn")
-	if err != nil {
-		return nil, errors.New(errorMessage)
+	var seed int64
+	if parametersDTO.Seed != nil {
+		seed = *parametersDTO.Seed
 	}
-	windowEndLine, err := controller.parseFloatFromMap(pathTracingParametersMap, "windowEndLine")
-	if err != nil {
-		return nil, errors.New(errorMessage)
-	}
-	windowEndColumn, err := controller.parseFloatFromMap(pathTracingParametersMap, "windowEndColumn")
+
+	parameters, err := path_tracing.InitParameters(*parametersDTO.RaysPerPixel, *parametersDTO.Recursions,
+		*parametersDTO.WindowStartLine, *parametersDTO.WindowStartColumn, *parametersDTO.WindowEndLine,
+		*parametersDTO.WindowEndColumn, optionalString(parametersDTO.Estimator, path_tracing.LegacyEstimator),
+		toneMapping, optionalInt(parametersDTO.TileSize, path_tracing.DefaultTileSize), seed,
+		optionalString(parametersDTO.Sampler, sampler.IndependentSamplerType),
+		optionalInt(parametersDTO.RussianRouletteDepth, path_tracing.DefaultRussianRouletteDepth), adaptiveSampling,
+		optionalInt(parametersDTO.Passes, path_tracing.DefaultPasses))
 	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, invalidParameterError(path, err)
 	}
-	return &pathTracingParameters{
-		raysPerPixel: int(raysPerPixel), recursions: int(recursions), windowStartLine: int(windowStartLine),
-		windowStartColumn: int(windowStartColumn), windowEndLine: int(windowEndLine),
-		windowEndColumn: int(windowEndColumn)}, nil
+	return parameters, nil
 }
-
@@ -1,44 +1,28 @@
 package marshaller
 
 import (
-	"errors"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
 )
 
-// parsePointFromMap parses a point from a map.
+// parsePointFromDTO parses a point from its DTO.
 //
 // Parameters:
-//  pointAsMap - The point as a map.
+//  coordinatesDTO - The point as a DTO.
 //
 // Returns:
 // 	The point.
 // 	An error.
 //
-func (*Controller) parsePointFromMap(pointAsMap map[string]interface{}) (*point.Point, error) {
-	errorMessage := "invalid point"
-
-	coordinatesAsInterface, found := pointAsMap["coordinates"]
-	if !found {
-		return nil, errors.New(errorMessage)
-	}
-	coordinateAsInterfaceList, parsed := coordinatesAsInterface.([]interface{})
-	if !parsed {
-		return nil, errors.New(errorMessage)
-	}
-
-	parsedPoint, err := point.Init(len(coordinateAsInterfaceList))
+func (*Controller) parsePointFromDTO(coordinatesDTO *CoordinatesDTO) (*point.Point, error) {
+	parsedPoint, err := point.Init(len(coordinatesDTO.Coordinates))
 	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, err
 	}
 
-	for coordinateIndex := 0; coordinateIndex < parsedPoint.Dimension(); coordinateIndex++ {
-		coordinate, parsed := coordinateAsInterfaceList[coordinateIndex].(float64)
-		if !parsed {
-			return nil, errors.New(errorMessage)
-		}
+	for coordinateIndex, coordinate := range coordinatesDTO.Coordinates {
 		err = parsedPoint.SetCoordinate(coordinateIndex, coordinate)
 		if err != nil {
-			return nil, errors.New(errorMessage)
+			return nil, err
 		}
 	}
 
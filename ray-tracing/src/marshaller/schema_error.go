@@ -0,0 +1,181 @@
+package marshaller
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FieldError is a class for an invalid field of the JSON of a path tracing run.
+//
+// Members:
+// 	path    - The JSON path of the field, like objects[3].triangles[12].verticesIndices[1], empty for the whole JSON.
+// 	message - What is wrong with the field.
+//
+type FieldError struct {
+	path    string
+	message string
+}
+
+// GetPath gets the JSON path of the field.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The path.
+//
+func (fieldError *FieldError) GetPath() string {
+	return fieldError.path
+}
+
+// GetMessage gets what is wrong with the field.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The message.
+//
+func (fieldError *FieldError) GetMessage() string {
+	return fieldError.message
+}
+
+// Error describes the FieldError.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The path and the message.
+//
+func (fieldError *FieldError) Error() string {
+	if fieldError.path == "" {
+		return fieldError.message
+	}
+	return fmt.Sprintf("%s: %s", fieldError.path, fieldError.message)
+}
+
+// initFieldError initializes a FieldError.
+//
+// Parameters:
+// 	path    - The JSON path of the field.
+// 	message - What is wrong with the field.
+//
+// Returns:
+// 	The FieldError.
+//
+func initFieldError(path, message string) *FieldError {
+	return &FieldError{path: path, message: message}
+}
+
+// SchemaError is a class for the error where the JSON of a path tracing run does not describe a valid scene.
+//
+// Members:
+// 	fieldErrors - The invalid fields.
+//
+type SchemaError struct {
+	fieldErrors []*FieldError
+}
+
+// GetFieldErrors gets the invalid fields.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The FieldErrors.
+//
+func (schemaError *SchemaError) GetFieldErrors() []*FieldError {
+	return schemaError.fieldErrors
+}
+
+// Error describes the SchemaError.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The descriptions of the invalid fields.
+//
+func (schemaError *SchemaError) Error() string {
+	return "Invalid scene: " + describeFieldErrors(schemaError.fieldErrors)
+}
+
+// ParametersError is a class for the error where the JSON of a path tracing run describes a valid scene, but its
+// parameters can not run the path tracing.
+//
+// Members:
+// 	fieldErrors - The invalid parameters.
+//
+type ParametersError struct {
+	fieldErrors []*FieldError
+}
+
+// GetFieldErrors gets the invalid parameters.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The FieldErrors.
+//
+func (parametersError *ParametersError) GetFieldErrors() []*FieldError {
+	return parametersError.fieldErrors
+}
+
+// Error describes the ParametersError.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The descriptions of the invalid parameters.
+//
+func (parametersError *ParametersError) Error() string {
+	return "Invalid parameters: " + describeFieldErrors(parametersError.fieldErrors)
+}
+
+// describeFieldErrors joins the descriptions of invalid fields.
+//
+// Parameters:
+// 	fieldErrors - The invalid fields.
+//
+// Returns:
+// 	The descriptions.
+//
+func describeFieldErrors(fieldErrors []*FieldError) string {
+	descriptions := make([]string, len(fieldErrors))
+	for fieldErrorIndex, fieldError := range fieldErrors {
+		descriptions[fieldErrorIndex] = fieldError.Error()
+	}
+	return strings.Join(descriptions, "; ")
+}
+
+// fieldPath builds the JSON path of a field of an object.
+//
+// Parameters:
+// 	objectPath - The JSON path of the object, empty for the whole JSON.
+// 	fieldName  - The name of the field.
+//
+// Returns:
+// 	The path.
+//
+func fieldPath(objectPath, fieldName string) string {
+	if objectPath == "" {
+		return fieldName
+	}
+	return objectPath + "." + fieldName
+}
+
+// indexPath builds the JSON path of an element of a list.
+//
+// Parameters:
+// 	listPath - The JSON path of the list.
+// 	index    - The index of the element.
+//
+// Returns:
+// 	The path.
+//
+func indexPath(listPath string, index int) string {
+	return fmt.Sprintf("%s[%d]", listPath, index)
+}
@@ -0,0 +1,34 @@
+package marshaller
+
+import (
+	"errors"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestSchemaError_Error tests the description of a SchemaError.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestSchemaError_Error(t *testing.T) {
+	err := schemaError([]*FieldError{initFieldError("", "invalid JSON"),
+		initFieldError(indexPath(fieldPath("objects", "triangles"), 2), "is required")})
+	test_helpers.AssertEqual(t, "Invalid scene: invalid JSON; objects.triangles[2]: is required", err.Error())
+}
+
+// TestParametersError_Error tests the description of a ParametersError.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestParametersError_Error(t *testing.T) {
+	err := invalidParameterError(fieldPath("pathTracingParameters", "passes"), errors.New("expected at least 1"))
+	test_helpers.AssertEqual(t, "Invalid parameters: pathTracingParameters.passes: expected at least 1", err.Error())
+}
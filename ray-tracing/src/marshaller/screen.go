@@ -1,43 +1,23 @@
 package marshaller
 
 import (
-	"errors"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/screen"
 )
 
-// parsePixelScreenFromMap parses the pixel screen from a map.
+// parsePixelScreenFromDTO parses the pixel screen from its DTO.
 //
 // Parameters:
-//  pathTracingData - The path tracing data.
+//  path           - The JSON path of the pixel screen.
+//  pixelScreenDTO - The pixel screen as a DTO.
 //
 // Returns:
 // 	The pixel screen.
 // 	An error.
 //
-func (controller *Controller) parsePixelScreenFromMap(pathTracingData map[string]interface{}) (*screen.Screen, error) {
-	errorMessage := "unable to parse pixel screen"
-
-	pixelScreenMap, found := pathTracingData["pixelScreen"]
-	if !found {
-		return nil, errors.New(errorMessage)
-	}
-	pixelScreenMapParsed, parsed := pixelScreenMap.(map[string]interface{})
-	if !parsed {
-		return nil, errors.New(errorMessage)
-	}
-
-	width, err := controller.parseFloatFromMap(pixelScreenMapParsed, "width")
-	if err != nil {
-		return nil, errors.New(errorMessage)
-	}
-	height, err := controller.parseFloatFromMap(pixelScreenMapParsed, "height")
-	if err != nil {
-		return nil, errors.New(errorMessage)
-	}
-
-	pixelScreen, err := screen.Init(int(width), int(height))
+func (*Controller) parsePixelScreenFromDTO(path string, pixelScreenDTO *PixelScreenDTO) (*screen.Screen, error) {
+	pixelScreen, err := screen.Init(*pixelScreenDTO.Width, *pixelScreenDTO.Height)
 	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, invalidFieldError(path, err)
 	}
 	return pixelScreen, nil
 }
@@ -0,0 +1,17 @@
+package marshaller
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
+)
+
+// parseSphereFromDTO parses a sphere from its DTO.
+//
+// Parameters:
+//  sphereDTO - The sphere as a DTO.
+//
+// Returns:
+// 	The sphere.
+//
+func (*Controller) parseSphereFromDTO(sphereDTO *SceneSphereDTO) *sphere.Sphere {
+	return sphere.Init(*sphereDTO.CenterPointIndex, *sphereDTO.Radius)
+}
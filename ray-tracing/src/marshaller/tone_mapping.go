@@ -0,0 +1,31 @@
+package marshaller
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tone_mapping"
+)
+
+// parseToneMappingFromDTO parses the optional tone mapping of the path tracing parameters from its DTO.
+// Without a tone mapping the radiance is only clamped.
+//
+// Parameters:
+//  path           - The JSON path of the tone mapping.
+//  toneMappingDTO - The tone mapping as a DTO, nil if there is none.
+//
+// Returns:
+// 	The ToneMapping.
+// 	An error.
+//
+func (*Controller) parseToneMappingFromDTO(path string, toneMappingDTO *ToneMappingDTO) (
+	*tone_mapping.ToneMapping, error) {
+	if toneMappingDTO == nil {
+		return tone_mapping.InitDefault(), nil
+	}
+
+	toneMapping, err := tone_mapping.Init(optionalFloat(toneMappingDTO.Exposure, 0),
+		optionalString(toneMappingDTO.Operator, tone_mapping.LinearOperator),
+		optionalString(toneMappingDTO.TransferFunction, tone_mapping.LinearTransferFunction))
+	if err != nil {
+		return nil, invalidParameterError(path, err)
+	}
+	return toneMapping, nil
+}
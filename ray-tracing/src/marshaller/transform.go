@@ -0,0 +1,101 @@
+package marshaller
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
+)
+
+// parseMatrixFromDTO parses a raw 4x4 transformation from its lines.
+//
+// Parameters:
+//  matrixDTO - The lines of the matrix.
+//
+// Returns:
+// 	The Matrix.
+//
+func (*Controller) parseMatrixFromDTO(matrixDTO [][]float64) *matrix.Matrix {
+	transformation, _ := matrix.Init(len(matrixDTO), len(matrixDTO[0]))
+	for lineIndex, line := range matrixDTO {
+		for columnIndex, value := range line {
+			_ = transformation.SetValue(lineIndex, columnIndex, value)
+		}
+	}
+	return transformation
+}
+
+// parseTransformFromDTO parses the transformation of an object from its DTO, composing its scale, rotation and
+// translation in this order when it has no raw matrix.
+//
+// Parameters:
+//  path         - The JSON path of the transformation.
+//  transformDTO - The transformation as a DTO.
+//
+// Returns:
+// 	The 4x4 Matrix of the transformation in homogeneous coordinates.
+// 	An error.
+//
+func (controller *Controller) parseTransformFromDTO(path string, transformDTO *TransformDTO) (*matrix.Matrix,
+	error) {
+	if transformDTO.Matrix != nil {
+		return controller.parseMatrixFromDTO(transformDTO.Matrix), nil
+	}
+
+	matrixController := matrix.Controller{}
+	transformations := make([]*matrix.Matrix, 0, 3)
+	if transformDTO.Scale != nil {
+		transformations = append(transformations, matrixController.BuildScale(transformDTO.Scale[0],
+			transformDTO.Scale[1], transformDTO.Scale[2]))
+	}
+	rotationDTO := transformDTO.Rotation
+	if rotationDTO != nil && rotationDTO.Euler != nil {
+		transformations = append(transformations, matrixController.BuildEulerRotation(rotationDTO.Euler[0],
+			rotationDTO.Euler[1], rotationDTO.Euler[2]))
+	} else if rotationDTO != nil {
+		rotation, err := matrixController.BuildAxisAngleRotation(rotationDTO.Axis, *rotationDTO.Angle)
+		if err != nil {
+			return nil, invalidFieldError(fieldPath(fieldPath(path, "rotation"), "axis"), err)
+		}
+		transformations = append(transformations, rotation)
+	}
+	if transformDTO.Translation != nil {
+		transformations = append(transformations, matrixController.BuildTranslation(transformDTO.Translation[0],
+			transformDTO.Translation[1], transformDTO.Translation[2]))
+	}
+	if len(transformations) == 0 {
+		return matrixController.BuildHomogeneousCoordinates(3)
+	}
+	return matrixController.Compose(transformations...)
+}
+
+// transformObjectsFromDTO places the objects parsed from an object DTO by its optional transformation.
+//
+// Parameters:
+//  path         - The JSON path of the object.
+//  transformDTO - The transformation as a DTO, nil if there is none.
+//  objects      - The objects in their own coordinates.
+//
+// Returns:
+// 	The objects in the coordinates of the scene.
+// 	An error.
+//
+func (controller *Controller) transformObjectsFromDTO(path string, transformDTO *TransformDTO,
+	objects []*object.Object) ([]*object.Object, error) {
+	if transformDTO == nil {
+		return objects, nil
+	}
+	transformPath := fieldPath(path, "transform")
+	transformation, err := controller.parseTransformFromDTO(transformPath, transformDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	objectController := object.Controller{}
+	transformedObjects := make([]*object.Object, len(objects))
+	for objectIndex, currentObject := range objects {
+		transformedObjects[objectIndex], err = objectController.Transform(currentObject, transformation)
+		if err != nil {
+			return nil, invalidFieldError(transformPath, err)
+		}
+	}
+	return transformedObjects, nil
+}
@@ -0,0 +1,641 @@
+package marshaller
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/path_tracing"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/sampler"
+)
+
+// materialTypes are the types of the materials of the objects.
+var materialTypes = []string{"lambertian", "phong", "ggx", "mirror", "dielectric"}
+
+// meshEncodings are the encodings of the inline meshes.
+var meshEncodings = []string{"text", "base64"}
+
+// estimators are the estimators of the path tracing.
+var estimators = []string{path_tracing.LegacyEstimator, path_tracing.UnbiasedEstimator}
+
+// samplerTypes are the types of the samplers of the path tracing.
+var samplerTypes = []string{sampler.IndependentSamplerType, sampler.StratifiedSamplerType, sampler.HaltonSamplerType,
+	sampler.SobolSamplerType}
+
+// validator is a class for checking the structure of the JSON of a path tracing run before it is parsed.
+//
+// Members:
+// 	fieldErrors     - The invalid fields found so far.
+// 	parameterErrors - The parameters found so far that are well formed but can not run the path tracing.
+//
+type validator struct {
+	fieldErrors     []*FieldError
+	parameterErrors []*FieldError
+}
+
+// addError adds an invalid field.
+//
+// Parameters:
+// 	path    - The JSON path of the field.
+// 	message - What is wrong with the field, formatted with the arguments.
+// 	args    - The arguments of the message.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) addError(path, message string, args ...interface{}) {
+	validator.fieldErrors = append(validator.fieldErrors, initFieldError(path, fmt.Sprintf(message, args...)))
+}
+
+// checkRequired checks that a required field is present.
+//
+// Parameters:
+// 	path      - The JSON path of the field.
+// 	isPresent - If the field is present.
+//
+// Returns:
+// 	If the field is present.
+//
+func (validator *validator) checkRequired(path string, isPresent bool) bool {
+	if !isPresent {
+		validator.addError(path, "is required")
+	}
+	return isPresent
+}
+
+// checkOneOf checks that an optional string field has one of the expected values.
+//
+// Parameters:
+// 	path           - The JSON path of the field.
+// 	value          - The value of the field, nil when it is missing.
+// 	expectedValues - The expected values.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) checkOneOf(path string, value *string, expectedValues []string) {
+	if value == nil {
+		return
+	}
+	for _, expectedValue := range expectedValues {
+		if *value == expectedValue {
+			return
+		}
+	}
+	validator.addError(path, "unknown value %q, expected one of %v", *value, expectedValues)
+}
+
+// checkPositive checks that a required integer field is at least 1.
+//
+// Parameters:
+// 	path  - The JSON path of the field.
+// 	value - The value of the field, nil when it is missing.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) checkPositive(path string, value *int) {
+	if validator.checkRequired(path, value != nil) && *value < 1 {
+		validator.addError(path, "expected at least 1, got %d", *value)
+	}
+}
+
+// checkMinimum checks that an optional integer field is at least a minimum.
+//
+// Parameters:
+// 	path    - The JSON path of the field.
+// 	value   - The value of the field, nil when it is missing.
+// 	minimum - The minimum of the field.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) checkMinimum(path string, value *int, minimum int) {
+	if value != nil && *value < minimum {
+		validator.addError(path, "expected at least %d, got %d", minimum, *value)
+	}
+}
+
+// checkLength checks that a required list has the expected number of elements.
+//
+// Parameters:
+// 	path           - The JSON path of the list.
+// 	length         - The number of elements of the list, -1 when it is missing.
+// 	expectedLength - The expected number of elements.
+//
+// Returns:
+// 	If the list is present with the expected number of elements.
+//
+func (validator *validator) checkLength(path string, length, expectedLength int) bool {
+	if !validator.checkRequired(path, length != -1) {
+		return false
+	}
+	if length != expectedLength {
+		validator.addError(path, "expected %d elements, got %d", expectedLength, length)
+		return false
+	}
+	return true
+}
+
+// checkIndex checks that an index refers to an element of a list.
+//
+// Parameters:
+// 	path       - The JSON path of the index.
+// 	index      - The index.
+// 	listLength - The number of elements of the list.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) checkIndex(path string, index, listLength int) {
+	if index < 0 || index >= listLength {
+		validator.addError(path, "index %d out of range", index)
+	}
+}
+
+// checkCoordinates checks that a required point or vector has 3 coordinates.
+//
+// Parameters:
+// 	path           - The JSON path of the point or vector.
+// 	coordinatesDTO - The point or vector, nil when it is missing.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) checkCoordinates(path string, coordinatesDTO *CoordinatesDTO) {
+	if !validator.checkRequired(path, coordinatesDTO != nil) {
+		return
+	}
+	validator.checkLength(fieldPath(path, "coordinates"), listLength(coordinatesDTO.Coordinates == nil,
+		len(coordinatesDTO.Coordinates)), 3)
+}
+
+// checkColor checks that a required color has 3 channels.
+//
+// Parameters:
+// 	path  - The JSON path of the color.
+// 	color - The color, nil when it is missing.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) checkColor(path string, color []float64) {
+	validator.checkLength(path, listLength(color == nil, len(color)), 3)
+}
+
+// validateParameters checks the parameters of the path tracing. The values that can not run the path tracing are
+// kept apart from the missing fields, as parameter errors.
+//
+// Parameters:
+// 	path          - The JSON path of the parameters.
+// 	parametersDTO - The parameters.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) validateParameters(path string, parametersDTO *PathTracingParametersDTO) {
+	validator.checkRequired(fieldPath(path, "raysPerPixel"), parametersDTO.RaysPerPixel != nil)
+	validator.checkRequired(fieldPath(path, "recursions"), parametersDTO.Recursions != nil)
+	validator.checkRequired(fieldPath(path, "windowStartLine"), parametersDTO.WindowStartLine != nil)
+	validator.checkRequired(fieldPath(path, "windowStartColumn"), parametersDTO.WindowStartColumn != nil)
+	validator.checkRequired(fieldPath(path, "windowEndLine"), parametersDTO.WindowEndLine != nil)
+	validator.checkRequired(fieldPath(path, "windowEndColumn"), parametersDTO.WindowEndColumn != nil)
+
+	adaptiveSamplingDTO := parametersDTO.AdaptiveSampling
+	if adaptiveSamplingDTO != nil {
+		adaptiveSamplingPath := fieldPath(path, "adaptiveSampling")
+		validator.checkRequired(fieldPath(adaptiveSamplingPath, "minimumSamples"),
+			adaptiveSamplingDTO.MinimumSamples != nil)
+		validator.checkRequired(fieldPath(adaptiveSamplingPath, "maximumSamples"),
+			adaptiveSamplingDTO.MaximumSamples != nil)
+		validator.checkRequired(fieldPath(adaptiveSamplingPath, "noiseThreshold"),
+			adaptiveSamplingDTO.NoiseThreshold != nil)
+	}
+
+	validator.parameterErrors = append(validator.parameterErrors, parameterValueErrors(path, parametersDTO)...)
+}
+
+// parameterValueErrors checks the values of the present parameters of the path tracing.
+//
+// Parameters:
+// 	path          - The JSON path of the parameters.
+// 	parametersDTO - The parameters.
+//
+// Returns:
+// 	The parameters that can not run the path tracing.
+//
+func parameterValueErrors(path string, parametersDTO *PathTracingParametersDTO) []*FieldError {
+	valuesValidator := &validator{}
+	valuesValidator.checkMinimum(fieldPath(path, "passes"), parametersDTO.Passes, 1)
+	valuesValidator.checkMinimum(fieldPath(path, "tileSize"), parametersDTO.TileSize, 1)
+	valuesValidator.checkOneOf(fieldPath(path, "estimator"), parametersDTO.Estimator, estimators)
+	valuesValidator.checkOneOf(fieldPath(path, "sampler"), parametersDTO.Sampler, samplerTypes)
+	valuesValidator.checkMinimum(fieldPath(path, "russianRouletteDepth"), parametersDTO.RussianRouletteDepth, 0)
+
+	adaptiveSamplingDTO := parametersDTO.AdaptiveSampling
+	if adaptiveSamplingDTO != nil {
+		adaptiveSamplingPath := fieldPath(path, "adaptiveSampling")
+		valuesValidator.checkMinimum(fieldPath(adaptiveSamplingPath, "minimumSamples"),
+			adaptiveSamplingDTO.MinimumSamples, 2)
+		if adaptiveSamplingDTO.MinimumSamples != nil {
+			valuesValidator.checkMinimum(fieldPath(adaptiveSamplingPath, "maximumSamples"),
+				adaptiveSamplingDTO.MaximumSamples, *adaptiveSamplingDTO.MinimumSamples)
+		}
+		if adaptiveSamplingDTO.NoiseThreshold != nil && *adaptiveSamplingDTO.NoiseThreshold <= 0 {
+			valuesValidator.addError(fieldPath(adaptiveSamplingPath, "noiseThreshold"),
+				"expected more than 0, got %v", *adaptiveSamplingDTO.NoiseThreshold)
+		}
+	}
+	return valuesValidator.fieldErrors
+}
+
+// validatePixelScreen checks the screen of pixels.
+//
+// Parameters:
+// 	path           - The JSON path of the screen.
+// 	pixelScreenDTO - The screen.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) validatePixelScreen(path string, pixelScreenDTO *PixelScreenDTO) {
+	validator.checkPositive(fieldPath(path, "width"), pixelScreenDTO.Width)
+	validator.checkPositive(fieldPath(path, "height"), pixelScreenDTO.Height)
+}
+
+// validateCamera checks the camera of the scene.
+//
+// Parameters:
+// 	path      - The JSON path of the camera.
+// 	cameraDTO - The camera.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) validateCamera(path string, cameraDTO *CameraDTO) {
+	validator.checkCoordinates(fieldPath(path, "position"), cameraDTO.Position)
+	validator.checkCoordinates(fieldPath(path, "look"), cameraDTO.Look)
+	validator.checkCoordinates(fieldPath(path, "up"), cameraDTO.Up)
+	validator.checkCoordinates(fieldPath(path, "right"), cameraDTO.Right)
+	validator.checkRequired(fieldPath(path, "fieldOfView"), cameraDTO.FieldOfView != nil)
+	validator.checkRequired(fieldPath(path, "distanceToScreen"), cameraDTO.DistanceToScreen != nil)
+}
+
+// validateLight checks a light of the scene, whose object can not be a mesh.
+//
+// Parameters:
+// 	path     - The JSON path of the light.
+// 	lightDTO - The light.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) validateLight(path string, lightDTO *LightDTO) {
+	validator.checkRequired(fieldPath(path, "lightIntensity"), lightDTO.LightIntensity != nil)
+	validator.checkColor(fieldPath(path, "color"), lightDTO.Color)
+
+	lightObjectPath := fieldPath(path, "lightObject")
+	if !validator.checkRequired(lightObjectPath, lightDTO.LightObject != nil) {
+		return
+	}
+	if lightDTO.LightObject.Mesh != nil {
+		validator.addError(fieldPath(lightObjectPath, "mesh"), "is not supported for light objects")
+		return
+	}
+	validator.validateObject(lightObjectPath, lightDTO.LightObject)
+}
+
+// validateMaterial checks the material of an object.
+//
+// Parameters:
+// 	path        - The JSON path of the material.
+// 	materialDTO - The material.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) validateMaterial(path string, materialDTO *MaterialDTO) {
+	typePath := fieldPath(path, "type")
+	if !validator.checkRequired(typePath, materialDTO.Type != nil) {
+		return
+	}
+	validator.checkOneOf(typePath, materialDTO.Type, materialTypes)
+	switch *materialDTO.Type {
+	case "phong":
+		validator.checkRequired(fieldPath(path, "exponent"), materialDTO.Exponent != nil)
+	case "ggx":
+		validator.checkRequired(fieldPath(path, "roughness"), materialDTO.Roughness != nil)
+	}
+}
+
+// validateLightCharacteristics checks the light characteristics of an object.
+//
+// Parameters:
+// 	path                    - The JSON path of the light characteristics.
+// 	lightCharacteristicsDTO - The light characteristics.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) validateLightCharacteristics(path string,
+	lightCharacteristicsDTO *SceneLightCharacteristicsDTO) {
+	validator.checkColor(fieldPath(path, "color"), lightCharacteristicsDTO.Color)
+	validator.checkRequired(fieldPath(path, "specularReflection"),
+		lightCharacteristicsDTO.SpecularReflection != nil)
+	validator.checkRequired(fieldPath(path, "roughNess"), lightCharacteristicsDTO.RoughNess != nil)
+	validator.checkRequired(fieldPath(path, "transmissionReflection"),
+		lightCharacteristicsDTO.TransmissionReflection != nil)
+	validator.checkRequired(fieldPath(path, "diffuseReflection"), lightCharacteristicsDTO.DiffuseReflection != nil)
+	if lightCharacteristicsDTO.Material != nil {
+		validator.validateMaterial(fieldPath(path, "material"), lightCharacteristicsDTO.Material)
+	}
+}
+
+// validateTriangle checks a triangle of an object and that its indexes refer to points and normals of the object.
+//
+// Parameters:
+// 	path            - The JSON path of the triangle.
+// 	triangleDTO     - The triangle.
+// 	numberOfPoints  - The number of points of the object.
+// 	numberOfNormals - The number of normals of the object.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) validateTriangle(path string, triangleDTO *TriangleDTO, numberOfPoints,
+	numberOfNormals int) {
+	verticesPath := fieldPath(path, "verticesIndices")
+	if validator.checkLength(verticesPath, listLength(triangleDTO.VerticesIndices == nil,
+		len(triangleDTO.VerticesIndices)), 3) {
+		for vertexIndex, pointIndex := range triangleDTO.VerticesIndices {
+			validator.checkIndex(indexPath(verticesPath, vertexIndex), pointIndex, numberOfPoints)
+		}
+	}
+	normalsPath := fieldPath(path, "verticesNormalsIndices")
+	if validator.checkLength(normalsPath, listLength(triangleDTO.VerticesNormalsIndices == nil,
+		len(triangleDTO.VerticesNormalsIndices)), 3) {
+		for vertexIndex, normalIndex := range triangleDTO.VerticesNormalsIndices {
+			validator.checkIndex(indexPath(normalsPath, vertexIndex), normalIndex, numberOfNormals)
+		}
+	}
+}
+
+// validateSphere checks a sphere of an object and that its center is a point of the object.
+//
+// Parameters:
+// 	path           - The JSON path of the sphere.
+// 	sphereDTO      - The sphere.
+// 	numberOfPoints - The number of points of the object.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) validateSphere(path string, sphereDTO *SceneSphereDTO, numberOfPoints int) {
+	centerPath := fieldPath(path, "centerPointIndex")
+	if validator.checkRequired(centerPath, sphereDTO.CenterPointIndex != nil) {
+		validator.checkIndex(centerPath, *sphereDTO.CenterPointIndex, numberOfPoints)
+	}
+	radiusPath := fieldPath(path, "radius")
+	if validator.checkRequired(radiusPath, sphereDTO.Radius != nil) && *sphereDTO.Radius <= 0 {
+		validator.addError(radiusPath, "expected more than 0, got %v", *sphereDTO.Radius)
+	}
+}
+
+// validateMesh checks an inline Wavefront OBJ mesh, whose content is only checked when it is parsed.
+//
+// Parameters:
+// 	path      - The JSON path of the object of the mesh.
+// 	objectDTO - The object of the mesh.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) validateMesh(path string, objectDTO *SceneObjectDTO) {
+	validator.checkOneOf(fieldPath(path, "meshEncoding"), objectDTO.MeshEncoding, meshEncodings)
+}
+
+// validateRotation checks the rotation of a transformation, around an axis or by Euler angles.
+//
+// Parameters:
+// 	path        - The JSON path of the rotation.
+// 	rotationDTO - The rotation.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) validateRotation(path string, rotationDTO *RotationDTO) {
+	if rotationDTO.Euler != nil {
+		if rotationDTO.Axis != nil || rotationDTO.Angle != nil {
+			validator.addError(path, "euler can not be combined with axis and angle")
+		}
+		validator.checkLength(fieldPath(path, "euler"), len(rotationDTO.Euler), 3)
+		return
+	}
+	validator.checkLength(fieldPath(path, "axis"), listLength(rotationDTO.Axis == nil, len(rotationDTO.Axis)), 3)
+	validator.checkRequired(fieldPath(path, "angle"), rotationDTO.Angle != nil)
+}
+
+// validateTransform checks the transformation of an object, a raw affine matrix or a scale, a rotation and a
+// translation.
+//
+// Parameters:
+// 	path         - The JSON path of the transformation.
+// 	transformDTO - The transformation.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) validateTransform(path string, transformDTO *TransformDTO) {
+	if transformDTO.Translation != nil {
+		validator.checkLength(fieldPath(path, "translation"), len(transformDTO.Translation), 3)
+	}
+	if transformDTO.Rotation != nil {
+		validator.validateRotation(fieldPath(path, "rotation"), transformDTO.Rotation)
+	}
+	if transformDTO.Scale != nil {
+		validator.checkLength(fieldPath(path, "scale"), len(transformDTO.Scale), 3)
+	}
+	if transformDTO.Matrix == nil {
+		return
+	}
+
+	matrixPath := fieldPath(path, "matrix")
+	if transformDTO.Translation != nil || transformDTO.Rotation != nil || transformDTO.Scale != nil {
+		validator.addError(matrixPath, "can not be combined with translation, rotation or scale")
+	}
+	if !validator.checkLength(matrixPath, len(transformDTO.Matrix), 4) {
+		return
+	}
+	for lineIndex, line := range transformDTO.Matrix {
+		validator.checkLength(indexPath(matrixPath, lineIndex), listLength(line == nil, len(line)), 4)
+	}
+	lastLine := transformDTO.Matrix[3]
+	if len(lastLine) == 4 && (lastLine[0] != 0 || lastLine[1] != 0 || lastLine[2] != 0 || lastLine[3] != 1) {
+		validator.addError(indexPath(matrixPath, 3), "expected [0 0 0 1] for an affine transformation, got %v",
+			lastLine)
+	}
+}
+
+// validateObject checks an object of the scene, or its mesh when it has one.
+//
+// Parameters:
+// 	path      - The JSON path of the object.
+// 	objectDTO - The object.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) validateObject(path string, objectDTO *SceneObjectDTO) {
+	if objectDTO.Transform != nil {
+		validator.validateTransform(fieldPath(path, "transform"), objectDTO.Transform)
+	}
+	if objectDTO.Mesh != nil {
+		validator.validateMesh(path, objectDTO)
+		return
+	}
+	validator.checkRequired(fieldPath(path, "name"), objectDTO.Name != nil)
+
+	numberOfPoints := 0
+	repositoryPath := fieldPath(path, "repository")
+	if validator.checkRequired(repositoryPath, objectDTO.Repository != nil) {
+		pointsPath := fieldPath(repositoryPath, "points")
+		points := objectDTO.Repository.Points
+		if validator.checkRequired(pointsPath, points != nil) && len(points) == 0 {
+			validator.addError(pointsPath, "expected at least 1 point")
+		}
+		for pointIndex := range points {
+			validator.checkCoordinates(indexPath(pointsPath, pointIndex), &points[pointIndex])
+		}
+		numberOfPoints = len(points)
+	}
+
+	normalsPath := fieldPath(path, "normals")
+	validator.checkRequired(normalsPath, objectDTO.Normals != nil)
+	for normalIndex := range objectDTO.Normals {
+		validator.checkCoordinates(indexPath(normalsPath, normalIndex), &objectDTO.Normals[normalIndex])
+	}
+
+	trianglesPath := fieldPath(path, "triangles")
+	validator.checkRequired(trianglesPath, objectDTO.Triangles != nil)
+	for triangleIndex := range objectDTO.Triangles {
+		validator.validateTriangle(indexPath(trianglesPath, triangleIndex), &objectDTO.Triangles[triangleIndex],
+			numberOfPoints, len(objectDTO.Normals))
+	}
+
+	spheresPath := fieldPath(path, "spheres")
+	for sphereIndex := range objectDTO.Spheres {
+		validator.validateSphere(indexPath(spheresPath, sphereIndex), &objectDTO.Spheres[sphereIndex],
+			numberOfPoints)
+	}
+
+	lightCharacteristicsPath := fieldPath(path, "lightCharacteristics")
+	if validator.checkRequired(lightCharacteristicsPath, objectDTO.LightCharacteristics != nil) {
+		validator.validateLightCharacteristics(lightCharacteristicsPath, objectDTO.LightCharacteristics)
+	}
+}
+
+// validateMeshes checks the meshes of the scene, which need unique names so instances can refer to them.
+//
+// Parameters:
+// 	meshDTOs - The meshes.
+//
+// Returns:
+// 	The names of the meshes.
+//
+func (validator *validator) validateMeshes(meshDTOs []SceneObjectDTO) map[string]bool {
+	meshNames := make(map[string]bool, len(meshDTOs))
+	for meshIndex := range meshDTOs {
+		meshPath := indexPath("meshes", meshIndex)
+		meshDTO := &meshDTOs[meshIndex]
+		validator.validateObject(meshPath, meshDTO)
+		if meshDTO.Name == nil {
+			if meshDTO.Mesh != nil {
+				validator.checkRequired(fieldPath(meshPath, "name"), false)
+			}
+			continue
+		}
+		if meshNames[*meshDTO.Name] {
+			validator.addError(fieldPath(meshPath, "name"), "duplicate mesh name %q", *meshDTO.Name)
+		}
+		meshNames[*meshDTO.Name] = true
+	}
+	return meshNames
+}
+
+// validateInstance checks an instance of a mesh.
+//
+// Parameters:
+// 	path        - The JSON path of the instance.
+// 	instanceDTO - The instance.
+// 	meshNames   - The names of the meshes.
+//
+// Returns:
+// 	none
+//
+func (validator *validator) validateInstance(path string, instanceDTO *InstanceDTO, meshNames map[string]bool) {
+	meshNamePath := fieldPath(path, "meshName")
+	if validator.checkRequired(meshNamePath, instanceDTO.MeshName != nil) && !meshNames[*instanceDTO.MeshName] {
+		validator.addError(meshNamePath, "unknown mesh %q", *instanceDTO.MeshName)
+	}
+	if instanceDTO.Transform != nil {
+		validator.validateTransform(fieldPath(path, "transform"), instanceDTO.Transform)
+	}
+	if instanceDTO.LightCharacteristics != nil {
+		validator.validateLightCharacteristics(fieldPath(path, "lightCharacteristics"),
+			instanceDTO.LightCharacteristics)
+	}
+}
+
+// validatePathTracing checks the structure of the JSON of a path tracing run, from the presence of the fields to the
+// indexes of the triangles and spheres, and then the values of its parameters.
+//
+// Parameters:
+// 	pathTracingDTO - The path tracing data.
+//
+// Returns:
+// 	A SchemaError with the invalid fields, a ParametersError with the invalid parameters, or nil.
+//
+func (validator *validator) validatePathTracing(pathTracingDTO *PathTracingDTO) error {
+	if validator.checkRequired("pathTracingParameters", pathTracingDTO.PathTracingParameters != nil) {
+		validator.validateParameters("pathTracingParameters", pathTracingDTO.PathTracingParameters)
+	}
+	if validator.checkRequired("pixelScreen", pathTracingDTO.PixelScreen != nil) {
+		validator.validatePixelScreen("pixelScreen", pathTracingDTO.PixelScreen)
+	}
+	if validator.checkRequired("sceneCamera", pathTracingDTO.SceneCamera != nil) {
+		validator.validateCamera("sceneCamera", pathTracingDTO.SceneCamera)
+	}
+	validator.checkRequired("lights", pathTracingDTO.Lights != nil)
+	for lightIndex := range pathTracingDTO.Lights {
+		validator.validateLight(indexPath("lights", lightIndex), &pathTracingDTO.Lights[lightIndex])
+	}
+	validator.checkRequired("objects", pathTracingDTO.Objects != nil)
+	for objectIndex := range pathTracingDTO.Objects {
+		validator.validateObject(indexPath("objects", objectIndex), &pathTracingDTO.Objects[objectIndex])
+	}
+	meshNames := validator.validateMeshes(pathTracingDTO.Meshes)
+	for instanceIndex := range pathTracingDTO.Instances {
+		validator.validateInstance(indexPath("instances", instanceIndex), &pathTracingDTO.Instances[instanceIndex],
+			meshNames)
+	}
+	if len(validator.fieldErrors) != 0 {
+		return schemaError(validator.fieldErrors)
+	}
+	if len(validator.parameterErrors) != 0 {
+		return parametersError(validator.parameterErrors)
+	}
+	return nil
+}
+
+// listLength gets the number of elements of a list that may be missing.
+//
+// Parameters:
+// 	isMissing - If the list is missing.
+// 	length    - The number of elements of the list.
+//
+// Returns:
+// 	The number of elements, -1 when the list is missing.
+//
+func listLength(isMissing bool, length int) int {
+	if isMissing {
+		return -1
+	}
+	return length
+}
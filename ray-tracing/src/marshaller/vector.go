@@ -1,44 +1,28 @@
 package marshaller
 
 import (
-	"errors"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
 )
 
-// parseVectorFromMap parses a vector from a map.
+// parseVectorFromDTO parses a vector from its DTO.
 //
 // Parameters:
-//  vectorAsMap - The vector as a map.
+//  coordinatesDTO - The vector as a DTO.
 //
 // Returns:
 // 	The vector.
 // 	An error.
 //
-func (*Controller) parseVectorFromMap(vectorAsMap map[string]interface{}) (*vector.Vector, error) {
-	errorMessage := "invalid vector"
-
-	coordinatesAsInterface, found := vectorAsMap["coordinates"]
-	if !found {
-		return nil, errors.New(errorMessage)
-	}
-	coordinateAsInterfaceList, parsed := coordinatesAsInterface.([]interface{})
-	if !parsed {
-		return nil, errors.New(errorMessage)
-	}
-
-	parsedVector, err := vector.Init(len(coordinateAsInterfaceList))
+func (*Controller) parseVectorFromDTO(coordinatesDTO *CoordinatesDTO) (*vector.Vector, error) {
+	parsedVector, err := vector.Init(len(coordinatesDTO.Coordinates))
 	if err != nil {
-		return nil, errors.New(errorMessage)
+		return nil, err
 	}
 
-	for coordinateIndex := 0; coordinateIndex < parsedVector.Dimension(); coordinateIndex++ {
-		coordinate, parsed := coordinateAsInterfaceList[coordinateIndex].(float64)
-		if !parsed {
-			return nil, errors.New(errorMessage)
-		}
+	for coordinateIndex, coordinate := range coordinatesDTO.Coordinates {
 		err = parsedVector.SetCoordinate(coordinateIndex, coordinate)
 		if err != nil {
-			return nil, errors.New(errorMessage)
+			return nil, err
 		}
 	}
 
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/marshaller"
+	"io/ioutil"
+	"log"
+	"os"
+)
+
+// main converts a Wavefront OBJ file and its MTL material library to the objects JSON of a path tracing run.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	none
+//
+func main() {
+	objPath := flag.String("obj", "", "Path to the OBJ file.")
+	mtlPath := flag.String("mtl", "", "Path to the MTL material library, optional.")
+	outputPath := flag.String("output", "", "Path to the output JSON, the standard output when empty.")
+	flag.Parse()
+
+	if *objPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	objContent, err := ioutil.ReadFile(*objPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	mtlContent := []byte{}
+	if *mtlPath != "" {
+		mtlContent, err = ioutil.ReadFile(*mtlPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	marshallerController := marshaller.Controller{}
+	objects, err := marshallerController.ParseObjectsFromObj(string(objContent), string(mtlContent))
+	if err != nil {
+		log.Fatal(err)
+	}
+	objectsJson, err := marshallerController.ObjectsToJson(objects)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *outputPath == "" {
+		_, err = os.Stdout.Write(objectsJson)
+	} else {
+		err = ioutil.WriteFile(*outputPath, objectsJson, 0644)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,165 @@
+package bounding_volume_hierarchy
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"math"
+)
+
+// boundingBoxPadding is how much each surface bounding box is enlarged to avoid missing hits on its borders.
+const boundingBoxPadding = 0.0000001
+
+// BoundingVolumeHierarchy is a class for accelerating the intersection of rays with the triangles and spheres of
+// objects.
+//
+// Members:
+// 	objects    - The objects on the hierarchy.
+// 	primitives - The triangles and spheres of the objects, ordered so every leaf has a contiguous range.
+// 	nodes      - The nodes of the hierarchy, the root is the first one.
+//
+type BoundingVolumeHierarchy struct {
+	objects    []*object.Object
+	primitives []*primitive
+	nodes      []*node
+}
+
+// GetObjects gets the objects of the BoundingVolumeHierarchy.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The objects of the BoundingVolumeHierarchy.
+//
+func (hierarchy *BoundingVolumeHierarchy) GetObjects() []*object.Object {
+	return hierarchy.objects
+}
+
+// NumberOfPrimitives gets the number of triangles and spheres on the BoundingVolumeHierarchy.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of triangles and spheres.
+//
+func (hierarchy *BoundingVolumeHierarchy) NumberOfPrimitives() int {
+	return len(hierarchy.primitives)
+}
+
+// NumberOfNodes gets the number of nodes of the BoundingVolumeHierarchy.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of nodes.
+//
+func (hierarchy *BoundingVolumeHierarchy) NumberOfNodes() int {
+	return len(hierarchy.nodes)
+}
+
+// IsEmpty checks if the BoundingVolumeHierarchy has no triangles nor spheres.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	If the BoundingVolumeHierarchy is empty.
+//
+func (hierarchy *BoundingVolumeHierarchy) IsEmpty() bool {
+	return len(hierarchy.nodes) == 0
+}
+
+// buildTriangleBoundingBox builds the padded bounding box of a triangle of an object.
+//
+// Parameters:
+// 	currentObject   - The object that has the triangle.
+// 	currentTriangle - The triangle.
+//
+// Returns:
+// 	[minX, minY, minZ, maxX, maxY, maxZ]
+// 	An error.
+//
+func buildTriangleBoundingBox(currentObject *object.Object, currentTriangle *triangle.Triangle) ([]float64, error) {
+	triangleController := triangle.Controller{}
+	points, err := triangleController.GetActualPoints(currentTriangle, currentObject.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+	boundingBox := []float64{math.MaxFloat64, math.MaxFloat64, math.MaxFloat64,
+		-math.MaxFloat64, -math.MaxFloat64, -math.MaxFloat64}
+	for _, currentPoint := range points {
+		for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+			coordinate, _ := currentPoint.GetCoordinate(coordinateIndex)
+			boundingBox[coordinateIndex] = math.Min(boundingBox[coordinateIndex], coordinate-boundingBoxPadding)
+			boundingBox[coordinateIndex+3] = math.Max(boundingBox[coordinateIndex+3], coordinate+boundingBoxPadding)
+		}
+	}
+	return boundingBox, nil
+}
+
+// buildSphereBoundingBox builds the padded bounding box of a sphere of an object.
+//
+// Parameters:
+// 	currentObject - The object that has the sphere.
+// 	currentSphere - The sphere.
+//
+// Returns:
+// 	[minX, minY, minZ, maxX, maxY, maxZ]
+// 	An error.
+//
+func buildSphereBoundingBox(currentObject *object.Object, currentSphere *sphere.Sphere) ([]float64, error) {
+	center, err := currentObject.GetRepository().GetPoint(currentSphere.GetCenterPointIndex())
+	if err != nil {
+		return nil, err
+	}
+	boundingBox := make([]float64, 6)
+	for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+		coordinate, _ := center.GetCoordinate(coordinateIndex)
+		boundingBox[coordinateIndex] = coordinate - currentSphere.GetRadius() - boundingBoxPadding
+		boundingBox[coordinateIndex+3] = coordinate + currentSphere.GetRadius() + boundingBoxPadding
+	}
+	return boundingBox, nil
+}
+
+// Init initializes a BoundingVolumeHierarchy, building it with the surface area heuristic.
+//
+// Parameters:
+// 	objects - The objects to be placed on the hierarchy.
+//
+// Returns:
+// 	A BoundingVolumeHierarchy.
+// 	An error.
+//
+func Init(objects []*object.Object) (*BoundingVolumeHierarchy, error) {
+	primitives := make([]*primitive, 0)
+	for objectIndex, currentObject := range objects {
+		if currentObject.GetRepository().PointsDimension() != 3 {
+			return nil, non3DObjectError(currentObject)
+		}
+		for triangleIndex, currentTriangle := range currentObject.GetTriangles() {
+			boundingBox, err := buildTriangleBoundingBox(currentObject, currentTriangle)
+			if err != nil {
+				return nil, invalidTriangleError(currentObject, triangleIndex)
+			}
+			primitives = append(primitives, initPrimitive(objectIndex, triangleIndex, boundingBox))
+		}
+		for sphereIndex, currentSphere := range currentObject.GetSpheres() {
+			boundingBox, err := buildSphereBoundingBox(currentObject, currentSphere)
+			if err != nil {
+				return nil, invalidSphereError(currentObject, sphereIndex)
+			}
+			primitives = append(primitives, initPrimitive(objectIndex, len(currentObject.GetTriangles())+sphereIndex,
+				boundingBox))
+		}
+	}
+
+	hierarchy := &BoundingVolumeHierarchy{objects: objects, primitives: primitives, nodes: make([]*node, 0)}
+	if len(primitives) > 0 {
+		controller := Controller{}
+		controller.buildNode(hierarchy, 0, len(primitives))
+	}
+	return hierarchy, nil
+}
@@ -0,0 +1,204 @@
+package bounding_volume_hierarchy
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/point_repository"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"math/rand"
+	"testing"
+)
+
+// buildRandomObject builds an object with random triangles inside a cube of side 10 centered on the origin.
+//
+// Parameters:
+//  t                 - Test instance.
+//  randomGenerator   - The random generator.
+//  numberOfTriangles - The number of triangles of the object.
+//
+// Returns:
+//  An object.
+//
+func buildRandomObject(t *testing.T, randomGenerator *rand.Rand, numberOfTriangles int) *object.Object {
+	points := make([]*point.Point, 3*numberOfTriangles)
+	triangles := make([]*triangle.Triangle, numberOfTriangles)
+	for triangleIndex := 0; triangleIndex < numberOfTriangles; triangleIndex++ {
+		center := []float64{randomGenerator.Float64()*10 - 5, randomGenerator.Float64()*10 - 5,
+			randomGenerator.Float64()*10 - 5}
+		for vertexIndex := 0; vertexIndex < 3; vertexIndex++ {
+			currentPoint, err := point.Init(3)
+			test_helpers.AssertNilError(t, err)
+			for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+				err = currentPoint.SetCoordinate(coordinateIndex,
+					center[coordinateIndex]+randomGenerator.Float64()-0.5)
+				test_helpers.AssertNilError(t, err)
+			}
+			points[3*triangleIndex+vertexIndex] = currentPoint
+		}
+		currentTriangle, err := triangle.Init(
+			[]int{3 * triangleIndex, 3*triangleIndex + 1, 3*triangleIndex + 2}, []int{0, 0, 0})
+		test_helpers.AssertNilError(t, err)
+		triangles[triangleIndex] = currentTriangle
+	}
+
+	repository, err := point_repository.Init(points, 3)
+	test_helpers.AssertNilError(t, err)
+	normal, err := vector.Init(3)
+	test_helpers.AssertNilError(t, err)
+
+	randomObject, err := object.Init("random", repository, triangles, nil, []*vector.Vector{normal},
+		[]float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+	return randomObject
+}
+
+// buildRandomSpheresObject builds an object with random spheres inside a cube of side 10 centered on the origin.
+//
+// Parameters:
+//  t               - Test instance.
+//  randomGenerator - The random generator.
+//  numberOfSpheres - The number of spheres of the object.
+//
+// Returns:
+//  An object.
+//
+func buildRandomSpheresObject(t *testing.T, randomGenerator *rand.Rand, numberOfSpheres int) *object.Object {
+	points := make([]*point.Point, numberOfSpheres)
+	spheres := make([]*sphere.Sphere, numberOfSpheres)
+	for sphereIndex := 0; sphereIndex < numberOfSpheres; sphereIndex++ {
+		center, err := point.Init(3)
+		test_helpers.AssertNilError(t, err)
+		for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+			err = center.SetCoordinate(coordinateIndex, randomGenerator.Float64()*10-5)
+			test_helpers.AssertNilError(t, err)
+		}
+		points[sphereIndex] = center
+		spheres[sphereIndex] = sphere.Init(sphereIndex, 0.2+randomGenerator.Float64()*0.8)
+	}
+
+	repository, err := point_repository.Init(points, 3)
+	test_helpers.AssertNilError(t, err)
+
+	randomObject, err := object.Init("random spheres", repository, []*triangle.Triangle{}, spheres,
+		[]*vector.Vector{}, []float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+	return randomObject
+}
+
+// TestBoundingVolumeHierarchy_Init tests the instantiation of a BoundingVolumeHierarchy.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestBoundingVolumeHierarchy_Init(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(1))
+	objects := []*object.Object{
+		buildRandomObject(t, randomGenerator, 30), buildRandomObject(t, randomGenerator, 20)}
+
+	hierarchy, err := Init(objects)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 50, hierarchy.NumberOfPrimitives())
+	test_helpers.AssertEqual(t, false, hierarchy.IsEmpty())
+	test_helpers.AssertEqual(t, true, hierarchy.NumberOfNodes() > 1)
+
+	storedPrimitives := 0
+	for _, currentNode := range hierarchy.nodes {
+		if currentNode.IsLeaf() {
+			storedPrimitives += currentNode.GetNumberOfPrimitives()
+		}
+	}
+	test_helpers.AssertEqual(t, hierarchy.NumberOfPrimitives(), storedPrimitives)
+}
+
+// TestBoundingVolumeHierarchy_Init_Empty tests the instantiation of a BoundingVolumeHierarchy without objects.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestBoundingVolumeHierarchy_Init_Empty(t *testing.T) {
+	hierarchy, err := Init([]*object.Object{})
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 0, hierarchy.NumberOfPrimitives())
+	test_helpers.AssertEqual(t, true, hierarchy.IsEmpty())
+}
+
+// TestBoundingVolumeHierarchy_Init_Non3DObject tests the instantiation of a BoundingVolumeHierarchy with an object
+// that is not on the third dimension.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestBoundingVolumeHierarchy_Init_Non3DObject(t *testing.T) {
+	firstPoint, err := point.Init(2)
+	test_helpers.AssertNilError(t, err)
+	repository, err := point_repository.Init([]*point.Point{firstPoint}, 2)
+	test_helpers.AssertNilError(t, err)
+	flatObject, err := object.Init("flat", repository, []*triangle.Triangle{}, nil, []*vector.Vector{},
+		[]float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+
+	_, err = Init([]*object.Object{flatObject})
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, non3DObjectError(flatObject).Error(), err.Error())
+}
+
+// TestBoundingVolumeHierarchy_Init_InvalidTriangle tests the instantiation of a BoundingVolumeHierarchy with a
+// triangle that refers to a point out of its object.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestBoundingVolumeHierarchy_Init_InvalidTriangle(t *testing.T) {
+	firstPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	repository, err := point_repository.Init([]*point.Point{firstPoint}, 3)
+	test_helpers.AssertNilError(t, err)
+	invalidTriangle, err := triangle.Init([]int{0, 0, 5}, []int{0, 0, 0})
+	test_helpers.AssertNilError(t, err)
+	invalidObject, err := object.Init("invalid", repository, []*triangle.Triangle{invalidTriangle}, nil,
+		[]*vector.Vector{}, []float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+
+	_, err = Init([]*object.Object{invalidObject})
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, invalidTriangleError(invalidObject, 0).Error(), err.Error())
+}
+
+// TestBoundingVolumeHierarchy_Init_InvalidSphere tests the instantiation of a BoundingVolumeHierarchy with a sphere
+// whose center is out of its object.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestBoundingVolumeHierarchy_Init_InvalidSphere(t *testing.T) {
+	firstPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	repository, err := point_repository.Init([]*point.Point{firstPoint}, 3)
+	test_helpers.AssertNilError(t, err)
+	invalidObject, err := object.Init("invalid", repository, []*triangle.Triangle{},
+		[]*sphere.Sphere{sphere.Init(0, 1), sphere.Init(3, 1)}, []*vector.Vector{}, []float64{1, 1, 1}, 0, 0, 0, 1,
+		1, nil)
+	test_helpers.AssertNilError(t, err)
+
+	_, err = Init([]*object.Object{invalidObject})
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, invalidSphereError(invalidObject, 1).Error(), err.Error())
+}
@@ -0,0 +1,474 @@
+package bounding_volume_hierarchy
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/line"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/instance"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/ray"
+	"math"
+)
+
+const (
+	// numberOfBins is the number of buckets used to evaluate the surface area heuristic on each axis.
+	numberOfBins = 12
+	// maximumPrimitivesPerLeaf is the number of primitives above which a node is always split.
+	maximumPrimitivesPerLeaf = 8
+	// minimumPrimitivesToSplit is the number of primitives below which a node is never split.
+	minimumPrimitivesToSplit = 2
+	// traversalCost is the cost of visiting a node relative to intersecting a triangle.
+	traversalCost = 1.0
+)
+
+// Controller is a class for controlling bounding volume hierarchies.
+//
+// Members:
+// 	none
+//
+type Controller struct{}
+
+// mergeBoundingBoxes builds the bounding box that contains two bounding boxes.
+//
+// Parameters:
+// 	firstBoundingBox  - The first bounding box.
+// 	secondBoundingBox - The second bounding box.
+//
+// Returns:
+// 	[minX, minY, minZ, maxX, maxY, maxZ]
+//
+func (*Controller) mergeBoundingBoxes(firstBoundingBox, secondBoundingBox []float64) []float64 {
+	mergedBoundingBox := make([]float64, 6)
+	for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+		mergedBoundingBox[coordinateIndex] = math.Min(
+			firstBoundingBox[coordinateIndex], secondBoundingBox[coordinateIndex])
+		mergedBoundingBox[coordinateIndex+3] = math.Max(
+			firstBoundingBox[coordinateIndex+3], secondBoundingBox[coordinateIndex+3])
+	}
+	return mergedBoundingBox
+}
+
+// emptyBoundingBox builds a bounding box that contains nothing, so any merge with it results on the other box.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	[minX, minY, minZ, maxX, maxY, maxZ]
+//
+func (*Controller) emptyBoundingBox() []float64 {
+	return []float64{math.MaxFloat64, math.MaxFloat64, math.MaxFloat64,
+		-math.MaxFloat64, -math.MaxFloat64, -math.MaxFloat64}
+}
+
+// surfaceArea calculates the surface area of a bounding box.
+//
+// Parameters:
+// 	boundingBox - The bounding box.
+//
+// Returns:
+// 	The surface area.
+//
+func (*Controller) surfaceArea(boundingBox []float64) float64 {
+	xLength := boundingBox[3] - boundingBox[0]
+	yLength := boundingBox[4] - boundingBox[1]
+	zLength := boundingBox[5] - boundingBox[2]
+	if xLength < 0 || yLength < 0 || zLength < 0 {
+		return 0
+	}
+	return 2 * (xLength*yLength + yLength*zLength + zLength*xLength)
+}
+
+// findBin finds the bin of a centroid on an axis.
+//
+// Parameters:
+// 	centroid            - The centroid of a primitive.
+// 	axis                - The axis.
+// 	centroidBoundingBox - The bounding box of the centroids.
+//
+// Returns:
+// 	The bin index.
+//
+func (*Controller) findBin(centroid []float64, axis int, centroidBoundingBox []float64) int {
+	extent := centroidBoundingBox[axis+3] - centroidBoundingBox[axis]
+	binIndex := int(numberOfBins * (centroid[axis] - centroidBoundingBox[axis]) / extent)
+	if binIndex >= numberOfBins {
+		binIndex = numberOfBins - 1
+	} else if binIndex < 0 {
+		binIndex = 0
+	}
+	return binIndex
+}
+
+// findBestSplit finds the split with the smallest surface area heuristic cost.
+//
+// Parameters:
+// 	hierarchy           - The BoundingVolumeHierarchy.
+// 	start               - The index of the first primitive of the node.
+// 	end                 - The index after the last primitive of the node.
+// 	boundingBox         - The bounding box of the node.
+// 	centroidBoundingBox - The bounding box of the centroids of the primitives of the node.
+//
+// Returns:
+// 	The best axis, -1 if no split is possible.
+// 	The last bin that goes to the left child.
+// 	The cost of the split.
+//
+func (controller *Controller) findBestSplit(hierarchy *BoundingVolumeHierarchy, start, end int, boundingBox,
+	centroidBoundingBox []float64) (int, int, float64) {
+	bestAxis := -1
+	bestBin := -1
+	bestCost := math.MaxFloat64
+	parentArea := controller.surfaceArea(boundingBox)
+
+	for axis := 0; axis < 3; axis++ {
+		if centroidBoundingBox[axis+3]-centroidBoundingBox[axis] <= 0 {
+			continue
+		}
+
+		binsCounts := make([]int, numberOfBins)
+		binsBoundingBoxes := make([][]float64, numberOfBins)
+		for binIndex := 0; binIndex < numberOfBins; binIndex++ {
+			binsBoundingBoxes[binIndex] = controller.emptyBoundingBox()
+		}
+		for primitiveIndex := start; primitiveIndex < end; primitiveIndex++ {
+			currentPrimitive := hierarchy.primitives[primitiveIndex]
+			binIndex := controller.findBin(currentPrimitive.GetCentroid(), axis, centroidBoundingBox)
+			binsCounts[binIndex]++
+			binsBoundingBoxes[binIndex] = controller.mergeBoundingBoxes(binsBoundingBoxes[binIndex],
+				currentPrimitive.GetBoundingBox())
+		}
+
+		for splitBin := 0; splitBin < numberOfBins-1; splitBin++ {
+			leftBoundingBox := controller.emptyBoundingBox()
+			rightBoundingBox := controller.emptyBoundingBox()
+			leftCount := 0
+			rightCount := 0
+			for binIndex := 0; binIndex < numberOfBins; binIndex++ {
+				if binIndex <= splitBin {
+					leftCount += binsCounts[binIndex]
+					leftBoundingBox = controller.mergeBoundingBoxes(leftBoundingBox, binsBoundingBoxes[binIndex])
+				} else {
+					rightCount += binsCounts[binIndex]
+					rightBoundingBox = controller.mergeBoundingBoxes(rightBoundingBox, binsBoundingBoxes[binIndex])
+				}
+			}
+			if leftCount == 0 || rightCount == 0 {
+				continue
+			}
+			cost := traversalCost + (controller.surfaceArea(leftBoundingBox)*float64(leftCount)+
+				controller.surfaceArea(rightBoundingBox)*float64(rightCount))/parentArea
+			if cost < bestCost {
+				bestCost = cost
+				bestAxis = axis
+				bestBin = splitBin
+			}
+		}
+	}
+	return bestAxis, bestBin, bestCost
+}
+
+// buildNode recursively builds the node that holds a range of primitives.
+//
+// Parameters:
+// 	hierarchy - The BoundingVolumeHierarchy.
+// 	start     - The index of the first primitive of the node.
+// 	end       - The index after the last primitive of the node.
+//
+// Returns:
+// 	The index of the node on the nodes list.
+//
+func (controller *Controller) buildNode(hierarchy *BoundingVolumeHierarchy, start, end int) int {
+	nodeIndex := len(hierarchy.nodes)
+	hierarchy.nodes = append(hierarchy.nodes, nil)
+
+	boundingBox := controller.emptyBoundingBox()
+	centroidBoundingBox := controller.emptyBoundingBox()
+	for primitiveIndex := start; primitiveIndex < end; primitiveIndex++ {
+		currentPrimitive := hierarchy.primitives[primitiveIndex]
+		boundingBox = controller.mergeBoundingBoxes(boundingBox, currentPrimitive.GetBoundingBox())
+		centroid := currentPrimitive.GetCentroid()
+		centroidAsBoundingBox := []float64{
+			centroid[0], centroid[1], centroid[2], centroid[0], centroid[1], centroid[2]}
+		centroidBoundingBox = controller.mergeBoundingBoxes(centroidBoundingBox, centroidAsBoundingBox)
+	}
+
+	numberOfPrimitives := end - start
+	if numberOfPrimitives <= minimumPrimitivesToSplit {
+		hierarchy.nodes[nodeIndex] = initLeafNode(boundingBox, start, numberOfPrimitives)
+		return nodeIndex
+	}
+
+	bestAxis, bestBin, bestCost := controller.findBestSplit(hierarchy, start, end, boundingBox,
+		centroidBoundingBox)
+	if bestAxis == -1 || (bestCost >= float64(numberOfPrimitives) &&
+		numberOfPrimitives <= maximumPrimitivesPerLeaf) {
+		hierarchy.nodes[nodeIndex] = initLeafNode(boundingBox, start, numberOfPrimitives)
+		return nodeIndex
+	}
+
+	middle := start
+	for primitiveIndex := start; primitiveIndex < end; primitiveIndex++ {
+		currentPrimitive := hierarchy.primitives[primitiveIndex]
+		if controller.findBin(currentPrimitive.GetCentroid(), bestAxis, centroidBoundingBox) <= bestBin {
+			hierarchy.primitives[primitiveIndex] = hierarchy.primitives[middle]
+			hierarchy.primitives[middle] = currentPrimitive
+			middle++
+		}
+	}
+
+	leftChildIndex := controller.buildNode(hierarchy, start, middle)
+	rightChildIndex := controller.buildNode(hierarchy, middle, end)
+	hierarchy.nodes[nodeIndex] = initInnerNode(boundingBox, leftChildIndex, rightChildIndex)
+	return nodeIndex
+}
+
+// intersectBoundingBox checks if a ray crosses a bounding box inside an interval of its parametric parameter.
+//
+// Parameters:
+// 	boundingBox         - The bounding box.
+// 	origin              - The coordinates of the starting point of the ray.
+// 	direction           - The coordinates of the vector director of the ray.
+// 	minimumRayParameter - The minimum value for the ray parametric is parameter.
+// 	maximumRayParameter - The maximum value for the ray parametric is parameter.
+//
+// Returns:
+// 	If the ray crosses the bounding box.
+//
+func (*Controller) intersectBoundingBox(boundingBox, origin, direction []float64, minimumRayParameter,
+	maximumRayParameter float64) bool {
+	nearParameter := minimumRayParameter
+	farParameter := maximumRayParameter
+	for axis := 0; axis < 3; axis++ {
+		if direction[axis] == 0 {
+			if origin[axis] < boundingBox[axis] || origin[axis] > boundingBox[axis+3] {
+				return false
+			}
+			continue
+		}
+		firstParameter := (boundingBox[axis] - origin[axis]) / direction[axis]
+		secondParameter := (boundingBox[axis+3] - origin[axis]) / direction[axis]
+		if firstParameter > secondParameter {
+			firstParameter, secondParameter = secondParameter, firstParameter
+		}
+		nearParameter = math.Max(nearParameter, firstParameter)
+		farParameter = math.Min(farParameter, secondParameter)
+		if nearParameter > farParameter {
+			return false
+		}
+	}
+	return true
+}
+
+// intersectSurface intersects a ray with a triangle or a sphere of an object.
+//
+// Parameters:
+// 	currentObject       - The object.
+// 	surfaceIndex        - The index of the surface on the object, counting the triangles and then the spheres.
+// 	currentRay          - The ray.
+// 	minimumRayParameter - The minimum value for the ray parametric is parameter.
+//
+// Returns:
+// 	The line parameter.
+// 	The barycentric coordinates for triangles, nil for spheres.
+// 	If there is an intersection.
+//
+func (*Controller) intersectSurface(currentObject *object.Object, surfaceIndex int, currentRay *line.Line,
+	minimumRayParameter float64) (float64, []float64, bool) {
+	rayController := ray.Controller{}
+	if surfaceIndex < len(currentObject.GetTriangles()) {
+		lineParameter, barycentricCoordinates, hasIntersection, _ := rayController.IntersectRayTriangle(
+			currentRay, currentObject.GetTriangles()[surfaceIndex], currentObject.GetRepository())
+		return lineParameter, barycentricCoordinates, hasIntersection && lineParameter >= minimumRayParameter
+	}
+
+	lineParameters, hasIntersection, _ := rayController.IntersectRaySphere(currentRay,
+		currentObject.GetSpheres()[surfaceIndex-len(currentObject.GetTriangles())], currentObject.GetRepository())
+	if hasIntersection {
+		for _, lineParameter := range lineParameters {
+			if lineParameter >= minimumRayParameter {
+				return lineParameter, nil, true
+			}
+		}
+	}
+	return 0, nil, false
+}
+
+// primitiveIntersector intersects a ray with a primitive of a BoundingVolumeHierarchy.
+//
+// Parameters:
+// 	currentPrimitive    - The primitive.
+// 	currentRay          - The ray.
+// 	minimumRayParameter - The minimum value for the ray parametric is parameter.
+// 	maximumRayParameter - The maximum value for the ray parametric is parameter, the closest intersection so far.
+//
+// Returns:
+// 	The line parameter.
+// 	The index of the intersected surface, counting the triangles of its object and then its spheres.
+// 	The barycentric coordinates for triangles, nil for spheres.
+// 	If there is an intersection.
+//
+type primitiveIntersector func(currentPrimitive *primitive, currentRay *line.Line, minimumRayParameter,
+	maximumRayParameter float64) (float64, int, []float64, bool)
+
+// traverse uses a ray to find the closest intersection with the primitives of a BoundingVolumeHierarchy up to a
+// maximum line parameter, skipping the nodes whose bounding boxes the ray does not cross before the closest
+// intersection found so far.
+// Ties are broken in favour of the first object and surface, as a sequential search over the objects would do.
+//
+// Parameters:
+// 	hierarchy           - The BoundingVolumeHierarchy.
+// 	currentRay          - The ray.
+// 	minimumRayParameter - The minimum value for the ray parametric is parameter.
+// 	maximumRayParameter - The maximum value for the ray parametric is parameter, included.
+// 	intersectPrimitive  - The intersection of the ray with a primitive.
+//
+// Returns:
+// 	If there is intersections with the primitives.
+// 	The closest line parameter.
+// 	The closest object index.
+// 	The closest surface index, counting the triangles of the object and then its spheres.
+// 	The closest triangle is barycentric coordinates, nil for spheres.
+//
+func (controller *Controller) traverse(hierarchy *BoundingVolumeHierarchy, currentRay *line.Line,
+	minimumRayParameter, maximumRayParameter float64, intersectPrimitive primitiveIntersector) (bool, float64, int,
+	int, []float64) {
+	closestLineParameter := math.MaxFloat64
+	closestObjectIndex := -1
+	closestTriangleIndex := -1
+	closestTriangleBarycentricCoordinates := make([]float64, 3)
+	hasIntersection := false
+
+	if hierarchy.IsEmpty() || currentRay.Dimension() != 3 {
+		return hasIntersection, closestLineParameter, closestObjectIndex, closestTriangleIndex,
+			closestTriangleBarycentricCoordinates
+	}
+
+	origin := make([]float64, 3)
+	for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+		origin[coordinateIndex], _ = currentRay.GetStartingPoint().GetCoordinate(coordinateIndex)
+	}
+	direction := currentRay.GetVectorDirector().CopyAllCoordinates()
+
+	nodesToVisit := []int{0}
+	for len(nodesToVisit) > 0 {
+		currentNode := hierarchy.nodes[nodesToVisit[len(nodesToVisit)-1]]
+		nodesToVisit = nodesToVisit[:len(nodesToVisit)-1]
+
+		rayParameterLimit := math.Min(closestLineParameter, maximumRayParameter)
+		if !controller.intersectBoundingBox(currentNode.GetBoundingBox(), origin, direction, minimumRayParameter,
+			rayParameterLimit) {
+			continue
+		}
+
+		if !currentNode.IsLeaf() {
+			nodesToVisit = append(nodesToVisit, currentNode.GetRightChildIndex(), currentNode.GetLeftChildIndex())
+			continue
+		}
+
+		lastPrimitiveIndex := currentNode.GetFirstPrimitiveIndex() + currentNode.GetNumberOfPrimitives()
+		for primitiveIndex := currentNode.GetFirstPrimitiveIndex(); primitiveIndex < lastPrimitiveIndex;
+		primitiveIndex++ {
+			currentPrimitive := hierarchy.primitives[primitiveIndex]
+			lineParameter, surfaceIndex, barycentricCoordinates, hasSurfaceIntersection := intersectPrimitive(
+				currentPrimitive, currentRay, minimumRayParameter, math.Min(closestLineParameter, maximumRayParameter))
+
+			if !hasSurfaceIntersection || lineParameter > maximumRayParameter {
+				continue
+			}
+			isCloser := lineParameter < closestLineParameter
+			isTiedAndFirst := lineParameter == closestLineParameter &&
+				(currentPrimitive.GetObjectIndex() < closestObjectIndex ||
+					(currentPrimitive.GetObjectIndex() == closestObjectIndex && surfaceIndex < closestTriangleIndex))
+			if isCloser || isTiedAndFirst {
+				hasIntersection = true
+				closestLineParameter = lineParameter
+				closestObjectIndex = currentPrimitive.GetObjectIndex()
+				closestTriangleIndex = surfaceIndex
+				closestTriangleBarycentricCoordinates = barycentricCoordinates
+			}
+		}
+	}
+
+	return hasIntersection, closestLineParameter, closestObjectIndex, closestTriangleIndex,
+		closestTriangleBarycentricCoordinates
+}
+
+// intersectUpTo uses a ray to find the closest triangle or sphere of the BoundingVolumeHierarchy up to a maximum line
+// parameter, so that the nodes farther than it are skipped.
+// Ties are broken in favour of the first object and surface, as a sequential search over the objects would do.
+//
+// Parameters:
+// 	hierarchy           - The BoundingVolumeHierarchy.
+// 	currentRay          - The ray.
+// 	minimumRayParameter - The minimum value for the ray parametric is parameter.
+// 	maximumRayParameter - The maximum value for the ray parametric is parameter, included.
+//
+// Returns:
+// 	If there is intersections with the objects.
+// 	The closest line parameter.
+// 	The closest object index.
+// 	The closest surface index, counting the triangles of the object and then its spheres.
+// 	The closest triangle is barycentric coordinates, nil for spheres.
+//
+func (controller *Controller) intersectUpTo(hierarchy *BoundingVolumeHierarchy, currentRay *line.Line,
+	minimumRayParameter, maximumRayParameter float64) (bool, float64, int, int, []float64) {
+	return controller.traverse(hierarchy, currentRay, minimumRayParameter, maximumRayParameter,
+		func(currentPrimitive *primitive, currentRay *line.Line, minimumRayParameter, _ float64) (float64, int,
+			[]float64, bool) {
+			lineParameter, barycentricCoordinates, hasIntersection := controller.intersectSurface(
+				hierarchy.objects[currentPrimitive.GetObjectIndex()], currentPrimitive.GetSurfaceIndex(), currentRay,
+				minimumRayParameter)
+			return lineParameter, currentPrimitive.GetSurfaceIndex(), barycentricCoordinates, hasIntersection
+		})
+}
+
+// Intersect uses a ray to find the closest triangle or sphere of the BoundingVolumeHierarchy.
+// Ties are broken in favour of the first object and surface, as a sequential search over the objects would do.
+//
+// Parameters:
+// 	hierarchy           - The BoundingVolumeHierarchy.
+// 	currentRay          - The ray.
+// 	minimumRayParameter - The minimum value for the ray parametric is parameter.
+//
+// Returns:
+// 	If there is intersections with the objects.
+// 	The closest line parameter.
+// 	The closest object index.
+// 	The closest surface index, counting the triangles of the object and then its spheres.
+// 	The closest triangle is barycentric coordinates, nil for spheres.
+//
+func (controller *Controller) Intersect(hierarchy *BoundingVolumeHierarchy, currentRay *line.Line,
+	minimumRayParameter float64) (bool, float64, int, int, []float64) {
+	return controller.intersectUpTo(hierarchy, currentRay, minimumRayParameter, math.MaxFloat64)
+}
+
+// IntersectInstances uses a ray to find the closest triangle or sphere of the instances of an InstanceHierarchy.
+// The ray is taken to the coordinates of the object of every instance whose bounding box it crosses and intersected
+// with the hierarchy of its geometry, which keeps the line parameter of the ray on the scene, so the closest
+// intersection found so far also bounds the traversal of the geometry.
+// Ties are broken in favour of the first instance and surface.
+//
+// Parameters:
+// 	hierarchy           - The InstanceHierarchy.
+// 	currentRay          - The ray on the coordinates of the scene.
+// 	minimumRayParameter - The minimum value for the ray parametric is parameter.
+//
+// Returns:
+// 	If there is intersections with the instances.
+// 	The closest line parameter.
+// 	The closest instance index.
+// 	The closest surface index, counting the triangles of the object of the instance and then its spheres.
+// 	The closest triangle is barycentric coordinates, nil for spheres.
+//
+func (controller *Controller) IntersectInstances(hierarchy *InstanceHierarchy, currentRay *line.Line,
+	minimumRayParameter float64) (bool, float64, int, int, []float64) {
+	instanceController := instance.Controller{}
+	return controller.traverse(hierarchy.topLevel, currentRay, minimumRayParameter, math.MaxFloat64,
+		func(currentPrimitive *primitive, currentRay *line.Line, minimumRayParameter, maximumRayParameter float64) (
+			float64, int, []float64, bool) {
+			instanceIndex := currentPrimitive.GetObjectIndex()
+			objectRay := instanceController.ToObjectRay(hierarchy.instances[instanceIndex], currentRay)
+			hasIntersection, lineParameter, _, surfaceIndex, barycentricCoordinates := controller.intersectUpTo(
+				hierarchy.geometryHierarchies[instanceIndex], objectRay, minimumRayParameter, maximumRayParameter)
+			return lineParameter, surfaceIndex, barycentricCoordinates, hasIntersection
+		})
+}
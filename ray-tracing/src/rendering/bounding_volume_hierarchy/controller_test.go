@@ -0,0 +1,437 @@
+package bounding_volume_hierarchy
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/line"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/instance"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/ray"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// intersectBruteForce intersects a ray with every triangle and sphere of every object, as the path tracing used to do.
+//
+// Parameters:
+//  objects             - The objects.
+//  currentRay          - The ray.
+//  minimumRayParameter - The minimum value for the ray parametric is parameter.
+//
+// Returns:
+// 	If there is intersections with the objects.
+// 	The closest line parameter.
+// 	The closest object index.
+// 	The closest surface index.
+// 	The closest triangle is barycentric coordinates.
+//
+func intersectBruteForce(objects []*object.Object, currentRay *line.Line, minimumRayParameter float64) (bool,
+	float64, int, int, []float64) {
+	closestLineParameter := math.MaxFloat64
+	closestObjectIndex := -1
+	closestTriangleIndex := -1
+	closestTriangleBarycentricCoordinates := make([]float64, 3)
+	hasObjectIntersections := false
+	rayController := ray.Controller{}
+
+	for objectIndex, currentObject := range objects {
+		for triangleIndex, currentTriangle := range currentObject.GetTriangles() {
+			lineParameter, barycentricCoordinates, hasIntersection, _ := rayController.IntersectRayTriangle(
+				currentRay, currentTriangle, currentObject.GetRepository())
+
+			if hasIntersection && lineParameter >= minimumRayParameter {
+				hasObjectIntersections = true
+				if lineParameter < closestLineParameter {
+					closestLineParameter = lineParameter
+					closestObjectIndex = objectIndex
+					closestTriangleIndex = triangleIndex
+					closestTriangleBarycentricCoordinates = barycentricCoordinates
+				}
+			}
+		}
+		for sphereIndex, currentSphere := range currentObject.GetSpheres() {
+			lineParameters, hasIntersection, _ := rayController.IntersectRaySphere(currentRay, currentSphere,
+				currentObject.GetRepository())
+			if !hasIntersection {
+				continue
+			}
+			for _, lineParameter := range lineParameters {
+				if lineParameter >= minimumRayParameter {
+					hasObjectIntersections = true
+					if lineParameter < closestLineParameter {
+						closestLineParameter = lineParameter
+						closestObjectIndex = objectIndex
+						closestTriangleIndex = len(currentObject.GetTriangles()) + sphereIndex
+						closestTriangleBarycentricCoordinates = nil
+					}
+					break
+				}
+			}
+		}
+	}
+	return hasObjectIntersections, closestLineParameter, closestObjectIndex, closestTriangleIndex,
+		closestTriangleBarycentricCoordinates
+}
+
+// buildRay builds a ray.
+//
+// Parameters:
+//  t         - Test instance.
+//  origin    - The coordinates of the starting point.
+//  direction - The coordinates of the vector director.
+//
+// Returns:
+//  A ray.
+//
+func buildRay(t *testing.T, origin, direction []float64) *line.Line {
+	startingPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	vectorDirector, err := vector.Init(3)
+	test_helpers.AssertNilError(t, err)
+	for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+		err = startingPoint.SetCoordinate(coordinateIndex, origin[coordinateIndex])
+		test_helpers.AssertNilError(t, err)
+		err = vectorDirector.SetCoordinate(coordinateIndex, direction[coordinateIndex])
+		test_helpers.AssertNilError(t, err)
+	}
+	currentRay, err := line.Init(startingPoint, vectorDirector)
+	test_helpers.AssertNilError(t, err)
+	return currentRay
+}
+
+// assertSameIntersection asserts the hierarchy finds the same intersection as the brute force search.
+//
+// Parameters:
+//  t                   - Test instance.
+//  hierarchy           - The BoundingVolumeHierarchy.
+//  currentRay          - The ray.
+//  minimumRayParameter - The minimum value for the ray parametric is parameter.
+//
+// Returns:
+//  If there was an intersection.
+//
+func assertSameIntersection(t *testing.T, hierarchy *BoundingVolumeHierarchy, currentRay *line.Line,
+	minimumRayParameter float64) bool {
+	controller := Controller{}
+	hasIntersection, lineParameter, objectIndex, triangleIndex, barycentricCoordinates := controller.Intersect(
+		hierarchy, currentRay, minimumRayParameter)
+	expectedHasIntersection, expectedLineParameter, expectedObjectIndex, expectedTriangleIndex,
+		expectedBarycentricCoordinates := intersectBruteForce(hierarchy.GetObjects(), currentRay, minimumRayParameter)
+
+	test_helpers.AssertEqual(t, expectedHasIntersection, hasIntersection)
+	test_helpers.AssertEqual(t, expectedLineParameter, lineParameter)
+	test_helpers.AssertEqual(t, expectedObjectIndex, objectIndex)
+	test_helpers.AssertEqual(t, expectedTriangleIndex, triangleIndex)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(expectedBarycentricCoordinates, barycentricCoordinates))
+	return hasIntersection
+}
+
+// TestController_Intersect tests the intersection of random rays against the brute force search.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Intersect(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(7))
+	objects := []*object.Object{buildRandomObject(t, randomGenerator, 200),
+		buildRandomObject(t, randomGenerator, 50), buildRandomObject(t, randomGenerator, 3)}
+	hierarchy, err := Init(objects)
+	test_helpers.AssertNilError(t, err)
+
+	numberOfHits := 0
+	for rayIndex := 0; rayIndex < 2000; rayIndex++ {
+		origin := []float64{randomGenerator.Float64()*16 - 8, randomGenerator.Float64()*16 - 8,
+			randomGenerator.Float64()*16 - 8}
+		target := []float64{randomGenerator.Float64()*10 - 5, randomGenerator.Float64()*10 - 5,
+			randomGenerator.Float64()*10 - 5}
+		direction := []float64{target[0] - origin[0], target[1] - origin[1], target[2] - origin[2]}
+		minimumRayParameter := float64(rayIndex%2) / 2
+		if assertSameIntersection(t, hierarchy, buildRay(t, origin, direction), minimumRayParameter) {
+			numberOfHits++
+		}
+	}
+	test_helpers.AssertEqual(t, true, numberOfHits > 100)
+}
+
+// TestController_IntersectUpTo tests that the intersections beyond the maximum line parameter are left out, and that
+// the ones at it are kept.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_IntersectUpTo(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(11))
+	hierarchy, err := Init([]*object.Object{buildRandomObject(t, randomGenerator, 200),
+		buildRandomObject(t, randomGenerator, 50)})
+	test_helpers.AssertNilError(t, err)
+	controller := Controller{}
+
+	numberOfHits := 0
+	for rayIndex := 0; rayIndex < 2000; rayIndex++ {
+		origin := []float64{randomGenerator.Float64()*16 - 8, randomGenerator.Float64()*16 - 8,
+			randomGenerator.Float64()*16 - 8}
+		target := []float64{randomGenerator.Float64()*10 - 5, randomGenerator.Float64()*10 - 5,
+			randomGenerator.Float64()*10 - 5}
+		currentRay := buildRay(t, origin, []float64{target[0] - origin[0], target[1] - origin[1],
+			target[2] - origin[2]})
+		hasIntersection, lineParameter, objectIndex, triangleIndex, _ := controller.Intersect(hierarchy, currentRay, 0)
+		if !hasIntersection {
+			continue
+		}
+		numberOfHits++
+
+		hasBoundedIntersection, boundedLineParameter, boundedObjectIndex, boundedTriangleIndex, _ :=
+			controller.intersectUpTo(hierarchy, currentRay, 0, lineParameter)
+		test_helpers.AssertEqual(t, true, hasBoundedIntersection)
+		test_helpers.AssertEqual(t, lineParameter, boundedLineParameter)
+		test_helpers.AssertEqual(t, objectIndex, boundedObjectIndex)
+		test_helpers.AssertEqual(t, triangleIndex, boundedTriangleIndex)
+		hasBoundedIntersection, _, _, _, _ = controller.intersectUpTo(hierarchy, currentRay, 0, lineParameter/2)
+		test_helpers.AssertEqual(t, false, hasBoundedIntersection)
+	}
+	test_helpers.AssertEqual(t, true, numberOfHits > 100)
+}
+
+// TestController_Intersect_Spheres tests the intersection of random rays with objects that have spheres against the
+// brute force search.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Intersect_Spheres(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(13))
+	objects := []*object.Object{buildRandomObject(t, randomGenerator, 40),
+		buildRandomSpheresObject(t, randomGenerator, 30)}
+	hierarchy, err := Init(objects)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 70, hierarchy.NumberOfPrimitives())
+
+	controller := Controller{}
+	numberOfSphereHits := 0
+	for rayIndex := 0; rayIndex < 2000; rayIndex++ {
+		origin := []float64{randomGenerator.Float64()*16 - 8, randomGenerator.Float64()*16 - 8,
+			randomGenerator.Float64()*16 - 8}
+		target := []float64{randomGenerator.Float64()*10 - 5, randomGenerator.Float64()*10 - 5,
+			randomGenerator.Float64()*10 - 5}
+		direction := []float64{target[0] - origin[0], target[1] - origin[1], target[2] - origin[2]}
+		minimumRayParameter := float64(rayIndex%2) / 2
+		currentRay := buildRay(t, origin, direction)
+		if assertSameIntersection(t, hierarchy, currentRay, minimumRayParameter) {
+			_, _, objectIndex, _, _ := controller.Intersect(hierarchy, currentRay, minimumRayParameter)
+			if objectIndex == 1 {
+				numberOfSphereHits++
+			}
+		}
+	}
+	test_helpers.AssertEqual(t, true, numberOfSphereHits > 100)
+}
+
+// TestController_Intersect_AxisAlignedRays tests the intersection of rays with null coordinates against the brute
+// force search.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Intersect_AxisAlignedRays(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(11))
+	objects := []*object.Object{buildRandomObject(t, randomGenerator, 100)}
+	hierarchy, err := Init(objects)
+	test_helpers.AssertNilError(t, err)
+
+	for rayIndex := 0; rayIndex < 300; rayIndex++ {
+		origin := []float64{randomGenerator.Float64()*10 - 5, randomGenerator.Float64()*10 - 5, -10}
+		direction := []float64{0, 0, 1}
+		assertSameIntersection(t, hierarchy, buildRay(t, origin, direction), 0)
+	}
+}
+
+// TestController_Intersect_Empty tests the intersection with an empty hierarchy.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Intersect_Empty(t *testing.T) {
+	hierarchy, err := Init([]*object.Object{})
+	test_helpers.AssertNilError(t, err)
+	hasIntersection := assertSameIntersection(t, hierarchy, buildRay(t, []float64{0, 0, 0}, []float64{1, 0, 0}), 0)
+	test_helpers.AssertEqual(t, false, hasIntersection)
+}
+
+// TestController_IntersectInstances tests the intersection of random rays with transformed instances against the
+// brute force search over copies of their objects placed on the scene.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_IntersectInstances(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(17))
+	meshes := []*object.Object{buildRandomObject(t, randomGenerator, 40),
+		buildRandomSpheresObject(t, randomGenerator, 10)}
+
+	matrixController := matrix.Controller{}
+	objectController := object.Controller{}
+	instances := make([]*instance.Instance, 0)
+	placedObjects := make([]*object.Object, 0)
+	for instanceIndex := 0; instanceIndex < 12; instanceIndex++ {
+		mesh := meshes[instanceIndex%len(meshes)]
+		scale := 0.1 + randomGenerator.Float64()*0.3
+		transformation, err := matrixController.Compose(matrixController.BuildScale(scale, scale, scale),
+			matrixController.BuildEulerRotation(randomGenerator.Float64()*360, randomGenerator.Float64()*360,
+				randomGenerator.Float64()*360),
+			matrixController.BuildTranslation(randomGenerator.Float64()*10-5, randomGenerator.Float64()*10-5,
+				randomGenerator.Float64()*10-5))
+		test_helpers.AssertNilError(t, err)
+		instances = append(instances, buildInstance(t, mesh, transformation))
+		placedObject, err := objectController.Transform(mesh, transformation)
+		test_helpers.AssertNilError(t, err)
+		placedObjects = append(placedObjects, placedObject)
+	}
+	hierarchy, err := InitInstanceHierarchy(instances)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 2, hierarchy.NumberOfGeometries())
+
+	controller := Controller{}
+	numberOfHits := 0
+	for rayIndex := 0; rayIndex < 2000; rayIndex++ {
+		origin := []float64{randomGenerator.Float64()*16 - 8, randomGenerator.Float64()*16 - 8,
+			randomGenerator.Float64()*16 - 8}
+		target := []float64{randomGenerator.Float64()*10 - 5, randomGenerator.Float64()*10 - 5,
+			randomGenerator.Float64()*10 - 5}
+		direction := []float64{target[0] - origin[0], target[1] - origin[1], target[2] - origin[2]}
+		currentRay := buildRay(t, origin, direction)
+
+		hasIntersection, lineParameter, instanceIndex, surfaceIndex, _ := controller.IntersectInstances(hierarchy,
+			currentRay, 0)
+		expectedHasIntersection, expectedLineParameter, expectedInstanceIndex, expectedSurfaceIndex, _ :=
+			intersectBruteForce(placedObjects, currentRay, 0)
+		test_helpers.AssertEqual(t, expectedHasIntersection, hasIntersection)
+		if !hasIntersection {
+			continue
+		}
+		numberOfHits++
+		test_helpers.AssertEqual(t, true, math.Abs(expectedLineParameter-lineParameter) < 1e-9)
+		test_helpers.AssertEqual(t, expectedInstanceIndex, instanceIndex)
+		test_helpers.AssertEqual(t, expectedSurfaceIndex, surfaceIndex)
+	}
+	test_helpers.AssertEqual(t, true, numberOfHits > 100)
+}
+
+// TestController_IntersectInstances_Untransformed tests that instances on the coordinates of the scene find the same
+// intersections as a single hierarchy of their objects.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_IntersectInstances_Untransformed(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(19))
+	objects := []*object.Object{buildRandomObject(t, randomGenerator, 60),
+		buildRandomSpheresObject(t, randomGenerator, 20), buildRandomObject(t, randomGenerator, 5)}
+	instances := make([]*instance.Instance, len(objects))
+	for objectIndex, currentObject := range objects {
+		instances[objectIndex] = buildInstance(t, currentObject, nil)
+	}
+	hierarchy, err := InitInstanceHierarchy(instances)
+	test_helpers.AssertNilError(t, err)
+
+	controller := Controller{}
+	for rayIndex := 0; rayIndex < 1000; rayIndex++ {
+		origin := []float64{randomGenerator.Float64()*16 - 8, randomGenerator.Float64()*16 - 8,
+			randomGenerator.Float64()*16 - 8}
+		target := []float64{randomGenerator.Float64()*10 - 5, randomGenerator.Float64()*10 - 5,
+			randomGenerator.Float64()*10 - 5}
+		direction := []float64{target[0] - origin[0], target[1] - origin[1], target[2] - origin[2]}
+		currentRay := buildRay(t, origin, direction)
+		minimumRayParameter := float64(rayIndex%2) / 2
+
+		hasIntersection, lineParameter, instanceIndex, surfaceIndex, barycentricCoordinates :=
+			controller.IntersectInstances(hierarchy, currentRay, minimumRayParameter)
+		expectedHasIntersection, expectedLineParameter, expectedObjectIndex, expectedSurfaceIndex,
+			expectedBarycentricCoordinates := intersectBruteForce(objects, currentRay, minimumRayParameter)
+		test_helpers.AssertEqual(t, expectedHasIntersection, hasIntersection)
+		test_helpers.AssertEqual(t, expectedLineParameter, lineParameter)
+		test_helpers.AssertEqual(t, expectedObjectIndex, instanceIndex)
+		test_helpers.AssertEqual(t, expectedSurfaceIndex, surfaceIndex)
+		test_helpers.AssertEqual(t, true, reflect.DeepEqual(expectedBarycentricCoordinates, barycentricCoordinates))
+	}
+}
+
+// TestController_IntersectInstances_Empty tests the intersection with an InstanceHierarchy without instances.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_IntersectInstances_Empty(t *testing.T) {
+	hierarchy, err := InitInstanceHierarchy([]*instance.Instance{})
+	test_helpers.AssertNilError(t, err)
+	controller := Controller{}
+	hasIntersection, _, instanceIndex, _, _ := controller.IntersectInstances(hierarchy,
+		buildRay(t, []float64{0, 0, 0}, []float64{1, 0, 0}), 0)
+	test_helpers.AssertEqual(t, false, hasIntersection)
+	test_helpers.AssertEqual(t, -1, instanceIndex)
+}
+
+// TestController_IntersectBoundingBox tests the intersection of a ray with a bounding box.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_IntersectBoundingBox(t *testing.T) {
+	controller := Controller{}
+	boundingBox := []float64{0, 0, 0, 1, 1, 1}
+
+	test_helpers.AssertEqual(t, true, controller.intersectBoundingBox(boundingBox, []float64{-1, 0.5, 0.5},
+		[]float64{1, 0, 0}, 0, math.MaxFloat64))
+	test_helpers.AssertEqual(t, false, controller.intersectBoundingBox(boundingBox, []float64{-1, 0.5, 0.5},
+		[]float64{-1, 0, 0}, 0, math.MaxFloat64))
+	test_helpers.AssertEqual(t, false, controller.intersectBoundingBox(boundingBox, []float64{-1, 2, 0.5},
+		[]float64{1, 0, 0}, 0, math.MaxFloat64))
+	test_helpers.AssertEqual(t, false, controller.intersectBoundingBox(boundingBox, []float64{-1, 0.5, 0.5},
+		[]float64{1, 0, 0}, 0, 0.5))
+	test_helpers.AssertEqual(t, true, controller.intersectBoundingBox(boundingBox, []float64{0.5, 0.5, 0.5},
+		[]float64{1, 1, 1}, 0, math.MaxFloat64))
+}
+
+// TestController_SurfaceArea tests the surface area of a bounding box.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_SurfaceArea(t *testing.T) {
+	controller := Controller{}
+	test_helpers.AssertEqual(t, 22.0, controller.surfaceArea([]float64{0, 0, 0, 1, 2, 3}))
+	test_helpers.AssertEqual(t, 0.0, controller.surfaceArea(controller.emptyBoundingBox()))
+}
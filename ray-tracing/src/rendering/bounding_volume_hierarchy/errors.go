@@ -0,0 +1,51 @@
+package bounding_volume_hierarchy
+
+import (
+	"errors"
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+)
+
+// non3DObjectError is the error where an object of the hierarchy is not on the third dimension.
+//
+// Parameters:
+//	nonTridimensionalObject - The object.
+//
+// Returns:
+//  An Error.
+//
+func non3DObjectError(nonTridimensionalObject *object.Object) error {
+	errorMessage := fmt.Sprintf("Non 3D object %s. Point repository dimension: %d.",
+		nonTridimensionalObject.GetName(), nonTridimensionalObject.GetRepository().PointsDimension())
+	return errors.New(errorMessage)
+}
+
+// invalidTriangleError is the error where a triangle of an object refers to points it does not have.
+//
+// Parameters:
+//	invalidObject - The object.
+//	triangleIndex - The index of the triangle on the object.
+//
+// Returns:
+//  An Error.
+//
+func invalidTriangleError(invalidObject *object.Object, triangleIndex int) error {
+	errorMessage := fmt.Sprintf("Triangle %d of object %s has vertices out of its point repository.",
+		triangleIndex, invalidObject.GetName())
+	return errors.New(errorMessage)
+}
+
+// invalidSphereError is the error where the center of a sphere of an object is not on its point repository.
+//
+// Parameters:
+//	invalidObject - The object.
+//	sphereIndex   - The index of the sphere on the object.
+//
+// Returns:
+//  An Error.
+//
+func invalidSphereError(invalidObject *object.Object, sphereIndex int) error {
+	errorMessage := fmt.Sprintf("Sphere %d of object %s has its center out of its point repository.",
+		sphereIndex, invalidObject.GetName())
+	return errors.New(errorMessage)
+}
@@ -0,0 +1,61 @@
+package bounding_volume_hierarchy
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"math/rand"
+	"testing"
+)
+
+// TestBoundingVolumeHierarchy_Non3DObjectError tests the error where an object of the hierarchy is not on the third
+// dimension.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestBoundingVolumeHierarchy_Non3DObjectError(t *testing.T) {
+	randomObject := buildRandomObject(t, rand.New(rand.NewSource(1)), 1)
+	expectedErrorMessage := fmt.Sprintf("Non 3D object %s. Point repository dimension: %d.", "random", 3)
+	err := non3DObjectError(randomObject)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestBoundingVolumeHierarchy_InvalidTriangleError tests the error where a triangle of an object refers to points it
+// does not have.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestBoundingVolumeHierarchy_InvalidTriangleError(t *testing.T) {
+	randomObject := buildRandomObject(t, rand.New(rand.NewSource(1)), 1)
+	expectedErrorMessage := fmt.Sprintf(
+		"Triangle %d of object %s has vertices out of its point repository.", 3, "random")
+	err := invalidTriangleError(randomObject, 3)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestBoundingVolumeHierarchy_InvalidSphereError tests the error where the center of a sphere of an object is not on
+// its point repository.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestBoundingVolumeHierarchy_InvalidSphereError(t *testing.T) {
+	randomObject := buildRandomObject(t, rand.New(rand.NewSource(1)), 1)
+	expectedErrorMessage := fmt.Sprintf(
+		"Sphere %d of object %s has its center out of its point repository.", 2, "random")
+	err := invalidSphereError(randomObject, 2)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
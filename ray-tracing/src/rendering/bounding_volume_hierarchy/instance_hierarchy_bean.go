@@ -0,0 +1,108 @@
+package bounding_volume_hierarchy
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/instance"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+)
+
+// InstanceHierarchy is a class for accelerating the intersection of rays with instances, as a two level hierarchy.
+// Every mesh has a BoundingVolumeHierarchy on its own coordinates, built once for all its instances, and the top level
+// hierarchy holds the bounding boxes of the instances on the scene.
+//
+// Members:
+// 	instances           - The instances on the hierarchy.
+// 	geometryHierarchies - The BoundingVolumeHierarchy of the mesh of every instance, shared by the instances of the
+// 	                      same mesh.
+// 	topLevel            - The hierarchy whose primitives are the instances, where the object index is the instance
+// 	                      index.
+//
+type InstanceHierarchy struct {
+	instances           []*instance.Instance
+	geometryHierarchies []*BoundingVolumeHierarchy
+	topLevel            *BoundingVolumeHierarchy
+}
+
+// GetInstances gets the instances of the InstanceHierarchy.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The instances of the InstanceHierarchy.
+//
+func (hierarchy *InstanceHierarchy) GetInstances() []*instance.Instance {
+	return hierarchy.instances
+}
+
+// NumberOfGeometries gets the number of distinct geometries of the InstanceHierarchy, each with its own
+// BoundingVolumeHierarchy.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of geometries.
+//
+func (hierarchy *InstanceHierarchy) NumberOfGeometries() int {
+	geometries := make(map[*BoundingVolumeHierarchy]bool)
+	for _, geometryHierarchy := range hierarchy.geometryHierarchies {
+		geometries[geometryHierarchy] = true
+	}
+	return len(geometries)
+}
+
+// IsEmpty checks if the InstanceHierarchy has no triangles nor spheres.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	If the InstanceHierarchy is empty.
+//
+func (hierarchy *InstanceHierarchy) IsEmpty() bool {
+	return hierarchy.topLevel.IsEmpty()
+}
+
+// InitInstanceHierarchy initializes an InstanceHierarchy, building the hierarchy of every mesh and the top level
+// hierarchy over the instances with the surface area heuristic.
+//
+// Parameters:
+// 	instances - The instances to be placed on the hierarchy.
+//
+// Returns:
+// 	An InstanceHierarchy.
+// 	An error.
+//
+func InitInstanceHierarchy(instances []*instance.Instance) (*InstanceHierarchy, error) {
+	instanceController := instance.Controller{}
+	geometryHierarchiesByMesh := make(map[*object.Object]*BoundingVolumeHierarchy)
+	geometryHierarchies := make([]*BoundingVolumeHierarchy, len(instances))
+	primitives := make([]*primitive, 0, len(instances))
+	for instanceIndex, currentInstance := range instances {
+		mesh := currentInstance.GetMesh()
+		geometryHierarchy, isBuilt := geometryHierarchiesByMesh[mesh]
+		if !isBuilt {
+			var err error
+			geometryHierarchy, err = Init([]*object.Object{mesh})
+			if err != nil {
+				return nil, err
+			}
+			geometryHierarchiesByMesh[mesh] = geometryHierarchy
+		}
+		geometryHierarchies[instanceIndex] = geometryHierarchy
+		if geometryHierarchy.IsEmpty() {
+			continue
+		}
+		boundingBox := instanceController.ToSceneBoundingBox(currentInstance,
+			geometryHierarchy.nodes[0].GetBoundingBox())
+		primitives = append(primitives, initPrimitive(instanceIndex, 0, boundingBox))
+	}
+
+	topLevel := &BoundingVolumeHierarchy{objects: nil, primitives: primitives, nodes: make([]*node, 0)}
+	if len(primitives) > 0 {
+		controller := Controller{}
+		controller.buildNode(topLevel, 0, len(primitives))
+	}
+	return &InstanceHierarchy{instances: instances, geometryHierarchies: geometryHierarchies, topLevel: topLevel},
+		nil
+}
@@ -0,0 +1,94 @@
+package bounding_volume_hierarchy
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/instance"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
+	"math/rand"
+	"testing"
+)
+
+// buildInstance builds an instance of an object.
+//
+// Parameters:
+//  t              - Test instance.
+//  currentObject  - The object.
+//  transformation - The transformation of the instance, nil for none.
+//
+// Returns:
+//  An instance.
+//
+func buildInstance(t *testing.T, currentObject *object.Object, transformation *matrix.Matrix) *instance.Instance {
+	currentInstance, err := instance.Init(currentObject, transformation)
+	test_helpers.AssertNilError(t, err)
+	return currentInstance
+}
+
+// TestInstanceHierarchy_Init tests the instantiation of an InstanceHierarchy, which builds the hierarchy of every
+// mesh once.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestInstanceHierarchy_Init(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(1))
+	chair := buildRandomObject(t, randomGenerator, 30)
+	table := buildRandomObject(t, randomGenerator, 20)
+	redChair, err := object.Init("red chair", chair.GetRepository(), chair.GetTriangles(), chair.GetSpheres(),
+		chair.GetNormals(), []float64{1, 0, 0}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+
+	matrixController := matrix.Controller{}
+	redChairInstance, err := instance.InitOfMesh(chair, redChair, matrixController.BuildTranslation(40, 0, 0))
+	test_helpers.AssertNilError(t, err)
+	instances := []*instance.Instance{buildInstance(t, chair, nil),
+		buildInstance(t, chair, matrixController.BuildTranslation(20, 0, 0)), redChairInstance,
+		buildInstance(t, table, nil)}
+
+	hierarchy, err := InitInstanceHierarchy(instances)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 4, len(hierarchy.GetInstances()))
+	test_helpers.AssertEqual(t, 2, hierarchy.NumberOfGeometries())
+	test_helpers.AssertEqual(t, false, hierarchy.IsEmpty())
+	test_helpers.AssertEqual(t, 4, hierarchy.topLevel.NumberOfPrimitives())
+	test_helpers.AssertEqual(t, hierarchy.geometryHierarchies[0], hierarchy.geometryHierarchies[2])
+}
+
+// TestInstanceHierarchy_Init_Empty tests the instantiation of an InstanceHierarchy without instances.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestInstanceHierarchy_Init_Empty(t *testing.T) {
+	hierarchy, err := InitInstanceHierarchy([]*instance.Instance{})
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, hierarchy.IsEmpty())
+	test_helpers.AssertEqual(t, 0, hierarchy.NumberOfGeometries())
+}
+
+// TestInstanceHierarchy_Init_InvalidTriangle tests the instantiation of an InstanceHierarchy with an instance whose
+// geometry is invalid.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestInstanceHierarchy_Init_InvalidTriangle(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(1))
+	validObject := buildRandomObject(t, randomGenerator, 2)
+	invalidObject, err := object.Init("invalid", validObject.GetRepository(), buildRandomObject(t, randomGenerator,
+		3).GetTriangles(), nil, validObject.GetNormals(), []float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+
+	_, err = InitInstanceHierarchy([]*instance.Instance{buildInstance(t, invalidObject, nil)})
+	test_helpers.AssertNotNilError(t, err)
+}
@@ -0,0 +1,120 @@
+package bounding_volume_hierarchy
+
+// node is a class for a node of the BoundingVolumeHierarchy.
+//
+// Members:
+// 	boundingBox         - The bounding box of every primitive under the node as [minX, minY, minZ, maxX, maxY, maxZ].
+// 	leftChildIndex      - The index of the left child on the nodes list, -1 for leaves.
+// 	rightChildIndex     - The index of the right child on the nodes list, -1 for leaves.
+// 	firstPrimitiveIndex - The index of the first primitive of a leaf on the primitives list.
+// 	numberOfPrimitives  - The number of primitives of a leaf, 0 for inner nodes.
+//
+type node struct {
+	boundingBox         []float64
+	leftChildIndex      int
+	rightChildIndex     int
+	firstPrimitiveIndex int
+	numberOfPrimitives  int
+}
+
+// GetBoundingBox gets the bounding box of the node.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	[minX, minY, minZ, maxX, maxY, maxZ]
+//
+func (node *node) GetBoundingBox() []float64 {
+	return node.boundingBox
+}
+
+// GetLeftChildIndex gets the index of the left child of the node.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The index of the left child.
+//
+func (node *node) GetLeftChildIndex() int {
+	return node.leftChildIndex
+}
+
+// GetRightChildIndex gets the index of the right child of the node.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The index of the right child.
+//
+func (node *node) GetRightChildIndex() int {
+	return node.rightChildIndex
+}
+
+// GetFirstPrimitiveIndex gets the index of the first primitive of the node.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The index of the first primitive.
+//
+func (node *node) GetFirstPrimitiveIndex() int {
+	return node.firstPrimitiveIndex
+}
+
+// GetNumberOfPrimitives gets the number of primitives of the node.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of primitives.
+//
+func (node *node) GetNumberOfPrimitives() int {
+	return node.numberOfPrimitives
+}
+
+// IsLeaf checks if the node is a leaf.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	If the node is a leaf.
+//
+func (node *node) IsLeaf() bool {
+	return node.numberOfPrimitives > 0
+}
+
+// initLeafNode initializes a leaf node.
+//
+// Parameters:
+// 	boundingBox         - The bounding box of the primitives of the node.
+// 	firstPrimitiveIndex - The index of the first primitive on the primitives list.
+// 	numberOfPrimitives  - The number of primitives.
+//
+// Returns:
+// 	A node.
+//
+func initLeafNode(boundingBox []float64, firstPrimitiveIndex, numberOfPrimitives int) *node {
+	return &node{boundingBox: boundingBox, leftChildIndex: -1, rightChildIndex: -1,
+		firstPrimitiveIndex: firstPrimitiveIndex, numberOfPrimitives: numberOfPrimitives}
+}
+
+// initInnerNode initializes an inner node.
+//
+// Parameters:
+// 	boundingBox     - The bounding box of the primitives under the node.
+// 	leftChildIndex  - The index of the left child on the nodes list.
+// 	rightChildIndex - The index of the right child on the nodes list.
+//
+// Returns:
+// 	A node.
+//
+func initInnerNode(boundingBox []float64, leftChildIndex, rightChildIndex int) *node {
+	return &node{boundingBox: boundingBox, leftChildIndex: leftChildIndex, rightChildIndex: rightChildIndex,
+		firstPrimitiveIndex: 0, numberOfPrimitives: 0}
+}
@@ -0,0 +1,83 @@
+package bounding_volume_hierarchy
+
+// primitive is a class for a triangle or a sphere stored on the BoundingVolumeHierarchy.
+//
+// Members:
+// 	objectIndex  - The index of the object that has the surface.
+// 	surfaceIndex - The index of the surface on the object, counting the triangles and then the spheres.
+// 	boundingBox  - The bounding box of the surface as [minX, minY, minZ, maxX, maxY, maxZ].
+// 	centroid     - The center of the bounding box of the surface.
+//
+type primitive struct {
+	objectIndex  int
+	surfaceIndex int
+	boundingBox  []float64
+	centroid     []float64
+}
+
+// GetObjectIndex gets the index of the object that has the primitive.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The index of the object.
+//
+func (primitive *primitive) GetObjectIndex() int {
+	return primitive.objectIndex
+}
+
+// GetSurfaceIndex gets the index of the surface of the primitive on its object.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The index of the surface.
+//
+func (primitive *primitive) GetSurfaceIndex() int {
+	return primitive.surfaceIndex
+}
+
+// GetBoundingBox gets the bounding box of the primitive.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	[minX, minY, minZ, maxX, maxY, maxZ]
+//
+func (primitive *primitive) GetBoundingBox() []float64 {
+	return primitive.boundingBox
+}
+
+// GetCentroid gets the center of the bounding box of the primitive.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The centroid coordinates.
+//
+func (primitive *primitive) GetCentroid() []float64 {
+	return primitive.centroid
+}
+
+// initPrimitive initializes a primitive.
+//
+// Parameters:
+// 	objectIndex  - The index of the object that has the surface.
+// 	surfaceIndex - The index of the surface on the object, counting the triangles and then the spheres.
+// 	boundingBox  - The bounding box of the surface as [minX, minY, minZ, maxX, maxY, maxZ].
+//
+// Returns:
+// 	A primitive.
+//
+func initPrimitive(objectIndex, surfaceIndex int, boundingBox []float64) *primitive {
+	centroid := make([]float64, 3)
+	for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+		centroid[coordinateIndex] = (boundingBox[coordinateIndex] + boundingBox[coordinateIndex+3]) / 2
+	}
+	return &primitive{objectIndex: objectIndex, surfaceIndex: surfaceIndex, boundingBox: boundingBox,
+		centroid: centroid}
+}
@@ -0,0 +1,95 @@
+package color_matrix
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+)
+
+// The image formats a ColorMatrix can be encoded to, named by their media types.
+const (
+	PngFormat  = "image/png"
+	JpegFormat = "image/jpeg"
+	PpmFormat  = "image/x-portable-pixmap"
+)
+
+// JpegQuality is the quality of the JPEG images, from 1 to 100.
+const JpegQuality = 90
+
+// Controller is a class for controlling color matrices.
+//
+// Members:
+// 	none
+//
+type Controller struct {}
+
+// ToImage converts a ColorMatrix to an image, the first line of the matrix being the top of the image.
+//
+// Parameters:
+// 	colorMatrix - The ColorMatrix.
+//
+// Returns:
+// 	The image.
+//
+func (*Controller) ToImage(colorMatrix *ColorMatrix) *image.RGBA {
+	rgbaImage := image.NewRGBA(image.Rect(0, 0, colorMatrix.Columns(), colorMatrix.Lines()))
+	for lineIndex, line := range colorMatrix.GetColors() {
+		for columnIndex, pixelColor := range line {
+			rgbaImage.SetRGBA(columnIndex, lineIndex, color.RGBA{R: uint8(pixelColor[0]), G: uint8(pixelColor[1]),
+				B: uint8(pixelColor[2]), A: 255})
+		}
+	}
+	return rgbaImage
+}
+
+// EncodePpm encodes a ColorMatrix as a binary PPM image.
+//
+// Parameters:
+// 	colorMatrix - The ColorMatrix.
+// 	writer      - The writer of the image.
+//
+// Returns:
+// 	An error.
+//
+func (*Controller) EncodePpm(colorMatrix *ColorMatrix, writer io.Writer) error {
+	bufferedWriter := bufio.NewWriter(writer)
+	_, err := fmt.Fprintf(bufferedWriter, "P6\n%d %d\n255\n", colorMatrix.Columns(), colorMatrix.Lines())
+	if err != nil {
+		return err
+	}
+	for _, line := range colorMatrix.GetColors() {
+		for _, pixelColor := range line {
+			_, err = bufferedWriter.Write([]byte{byte(pixelColor[0]), byte(pixelColor[1]), byte(pixelColor[2])})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return bufferedWriter.Flush()
+}
+
+// Encode encodes a ColorMatrix as an image.
+//
+// Parameters:
+// 	colorMatrix - The ColorMatrix.
+// 	format      - The media type of the image format.
+// 	writer      - The writer of the image.
+//
+// Returns:
+// 	An error.
+//
+func (controller *Controller) Encode(colorMatrix *ColorMatrix, format string, writer io.Writer) error {
+	switch format {
+	case PngFormat:
+		return png.Encode(writer, controller.ToImage(colorMatrix))
+	case JpegFormat:
+		return jpeg.Encode(writer, controller.ToImage(colorMatrix), &jpeg.Options{Quality: JpegQuality})
+	case PpmFormat:
+		return controller.EncodePpm(colorMatrix, writer)
+	}
+	return unsupportedImageFormatError(format)
+}
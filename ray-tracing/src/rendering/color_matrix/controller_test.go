@@ -0,0 +1,119 @@
+package color_matrix
+
+import (
+	"bytes"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"reflect"
+	"testing"
+)
+
+// buildTestColorMatrix builds a ColorMatrix with 2 lines and 3 columns for testing.
+//
+// Parameters:
+//  none
+//
+// Returns:
+//  A ColorMatrix.
+//
+func buildTestColorMatrix() *ColorMatrix {
+	return &ColorMatrix{colors: [][][]int{
+		{{255, 0, 0}, {0, 255, 0}, {0, 0, 255}},
+		{{0, 0, 0}, {128, 128, 128}, {255, 255, 255}},
+	}}
+}
+
+// TestController_ToImage tests the conversion of a ColorMatrix to an image.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ToImage(t *testing.T) {
+	controller := Controller{}
+	rgbaImage := controller.ToImage(buildTestColorMatrix())
+	test_helpers.AssertEqual(t, 3, rgbaImage.Bounds().Dx())
+	test_helpers.AssertEqual(t, 2, rgbaImage.Bounds().Dy())
+	test_helpers.AssertEqual(t, color.RGBA{R: 0, G: 0, B: 255, A: 255}, rgbaImage.RGBAAt(2, 0))
+	test_helpers.AssertEqual(t, color.RGBA{R: 128, G: 128, B: 128, A: 255}, rgbaImage.RGBAAt(1, 1))
+}
+
+// TestController_Encode_Png tests encoding a ColorMatrix as a PNG image.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Encode_Png(t *testing.T) {
+	controller := Controller{}
+	colorMatrix := buildTestColorMatrix()
+	var buffer bytes.Buffer
+	test_helpers.AssertNilError(t, controller.Encode(colorMatrix, PngFormat, &buffer))
+
+	decodedImage, err := png.Decode(&buffer)
+	test_helpers.AssertNilError(t, err)
+	for lineIndex, line := range colorMatrix.GetColors() {
+		for columnIndex, pixelColor := range line {
+			red, green, blue, _ := decodedImage.At(columnIndex, lineIndex).RGBA()
+			test_helpers.AssertEqual(t, true, reflect.DeepEqual(pixelColor,
+				[]int{int(red >> 8), int(green >> 8), int(blue >> 8)}))
+		}
+	}
+}
+
+// TestController_Encode_Jpeg tests encoding a ColorMatrix as a JPEG image.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Encode_Jpeg(t *testing.T) {
+	controller := Controller{}
+	var buffer bytes.Buffer
+	test_helpers.AssertNilError(t, controller.Encode(buildTestColorMatrix(), JpegFormat, &buffer))
+
+	decodedImage, err := jpeg.Decode(&buffer)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 3, decodedImage.Bounds().Dx())
+	test_helpers.AssertEqual(t, 2, decodedImage.Bounds().Dy())
+}
+
+// TestController_Encode_Ppm tests encoding a ColorMatrix as a binary PPM image.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Encode_Ppm(t *testing.T) {
+	controller := Controller{}
+	var buffer bytes.Buffer
+	test_helpers.AssertNilError(t, controller.Encode(buildTestColorMatrix(), PpmFormat, &buffer))
+
+	expectedPpm := append([]byte("P6\n3 2\n255\n"), 255, 0, 0, 0, 255, 0, 0, 0, 255, 0, 0, 0, 128, 128, 128, 255,
+		255, 255)
+	test_helpers.AssertEqual(t, true, bytes.Equal(expectedPpm, buffer.Bytes()))
+}
+
+// TestController_Encode_UnsupportedImageFormatError tests encoding a ColorMatrix to an unsupported format.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Encode_UnsupportedImageFormatError(t *testing.T) {
+	controller := Controller{}
+	var buffer bytes.Buffer
+	test_helpers.AssertNotNilError(t, controller.Encode(buildTestColorMatrix(), "image/gif", &buffer))
+}
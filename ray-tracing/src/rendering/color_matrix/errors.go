@@ -33,4 +33,17 @@ func indexError(colorMatrix *ColorMatrix, lineIndex, columnIndex int) error {
 func nonRGBColorError(color []int) error {
 	errorMessage := fmt.Sprintf("Non RGB color: %v.", color)
 	return errors.New(errorMessage)
-}
\ No newline at end of file
+}
+
+// unsupportedImageFormatError is the error where a ColorMatrix can not be encoded to an image format.
+//
+// Parameters:
+//	format - The media type of the image format.
+//
+// Returns:
+//  An Error.
+//
+func unsupportedImageFormatError(format string) error {
+	errorMessage := fmt.Sprintf("Unsupported image format: %s.", format)
+	return errors.New(errorMessage)
+}
@@ -39,3 +39,20 @@ func TestScreen_NonRGBColorError(t *testing.T) {
 	test_helpers.AssertNotNilError(t, err)
 	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
 }
+
+
+// TestColorMatrix_UnsupportedImageFormatError tests the error where a ColorMatrix can not be encoded to an image
+// format.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestColorMatrix_UnsupportedImageFormatError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Unsupported image format: %s.", "image/gif")
+	err := unsupportedImageFormatError("image/gif")
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
@@ -0,0 +1,145 @@
+package instance
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
+)
+
+// Instance is a class for placing an Object on the scene by a transformation, without copying its geometry.
+// Instances of the same mesh share its point repository, triangles, spheres and normals, which stay in the
+// coordinates of the mesh.
+//
+// Members:
+// 	mesh                  - The Object whose geometry the Instance places, shared by the instances of the same mesh.
+// 	object                - The Object in its own coordinates, with the geometry of the mesh and the name and light
+// 	                        characteristics of the Instance.
+// 	transformation        - The transformation from the coordinates of the Object to the scene, nil when the Object
+// 	                        is already on the coordinates of the scene.
+// 	inverseTransformation - The transformation from the scene to the coordinates of the Object.
+// 	normalTransformation  - The inverse transpose of the transformation, which keeps normals perpendicular to the
+// 	                        surfaces.
+//
+type Instance struct {
+	mesh                  *object.Object
+	object                *object.Object
+	transformation        *matrix.Matrix
+	inverseTransformation *matrix.Matrix
+	normalTransformation  *matrix.Matrix
+}
+
+// GetMesh gets the mesh of the Instance, which identifies the geometry shared by the instances of the same mesh.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The Object whose geometry the Instance places.
+//
+func (instance *Instance) GetMesh() *object.Object {
+	return instance.mesh
+}
+
+// GetObject gets the Object of the Instance.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The Object in its own coordinates.
+//
+func (instance *Instance) GetObject() *object.Object {
+	return instance.object
+}
+
+// GetTransformation gets the transformation of the Instance.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The 4x4 Matrix from the coordinates of the Object to the scene, nil when the Instance is not transformed.
+//
+func (instance *Instance) GetTransformation() *matrix.Matrix {
+	return instance.transformation
+}
+
+// GetInverseTransformation gets the inverse of the transformation of the Instance.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The 4x4 Matrix from the scene to the coordinates of the Object, nil when the Instance is not transformed.
+//
+func (instance *Instance) GetInverseTransformation() *matrix.Matrix {
+	return instance.inverseTransformation
+}
+
+// GetNormalTransformation gets the transformation of the normals of the Instance.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The inverse transpose of the transformation, nil when the Instance is not transformed.
+//
+func (instance *Instance) GetNormalTransformation() *matrix.Matrix {
+	return instance.normalTransformation
+}
+
+// IsTransformed checks if the Object of the Instance is not on the coordinates of the scene.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	If the Instance has a transformation.
+//
+func (instance *Instance) IsTransformed() bool {
+	return instance.transformation != nil
+}
+
+// InitOfMesh initializes an Instance of a mesh, inverting its transformation.
+//
+// Parameters:
+// 	mesh           - The Object whose geometry the Instance places.
+// 	object         - The Object of the Instance, which shares the geometry of the mesh and may replace its name and
+// 	                 light characteristics.
+// 	transformation - The 4x4 Matrix from the coordinates of the Object to the scene in homogeneous coordinates, nil
+// 	                 when the Object is already on the coordinates of the scene.
+//
+// Returns:
+// 	An Instance.
+// 	An error.
+//
+func InitOfMesh(mesh, object *object.Object, transformation *matrix.Matrix) (*Instance, error) {
+	if transformation == nil {
+		return &Instance{mesh: mesh, object: object}, nil
+	}
+	if transformation.Lines() != 4 || transformation.Columns() != 4 {
+		return nil, non4x4TransformationError(object, transformation)
+	}
+	matrixController := matrix.Controller{}
+	inverseTransformation, err := matrixController.Invert(transformation)
+	if err != nil {
+		return nil, singularTransformationError(object)
+	}
+	return &Instance{mesh: mesh, object: object, transformation: transformation,
+		inverseTransformation: inverseTransformation,
+		normalTransformation:  matrixController.Transpose(inverseTransformation)}, nil
+}
+
+// Init initializes an Instance of an Object that is its own mesh, inverting its transformation.
+//
+// Parameters:
+// 	object         - The Object in its own coordinates.
+// 	transformation - The 4x4 Matrix from the coordinates of the Object to the scene in homogeneous coordinates, nil
+// 	                 when the Object is already on the coordinates of the scene.
+//
+// Returns:
+// 	An Instance.
+// 	An error.
+//
+func Init(object *object.Object, transformation *matrix.Matrix) (*Instance, error) {
+	return InitOfMesh(object, object, transformation)
+}
@@ -0,0 +1,165 @@
+package instance
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/point_repository"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
+	"math"
+	"testing"
+)
+
+// buildObject builds an object with a triangle on the xy plane and a unit sphere around the origin.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  The Object.
+//
+func buildObject(t *testing.T) *object.Object {
+	points := make([]*point.Point, 4)
+	for pointIndex, coordinates := range [][]float64{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}, {0, 0, 0}} {
+		currentPoint, err := point.Init(3)
+		test_helpers.AssertNilError(t, err)
+		for coordinateIndex, coordinate := range coordinates {
+			err = currentPoint.SetCoordinate(coordinateIndex, coordinate)
+			test_helpers.AssertNilError(t, err)
+		}
+		points[pointIndex] = currentPoint
+	}
+	repository, err := point_repository.Init(points, 3)
+	test_helpers.AssertNilError(t, err)
+	normal, err := vector.Init(3)
+	test_helpers.AssertNilError(t, err)
+	err = normal.SetCoordinate(2, 1)
+	test_helpers.AssertNilError(t, err)
+	currentTriangle, err := triangle.Init([]int{0, 1, 2}, []int{0, 0, 0})
+	test_helpers.AssertNilError(t, err)
+
+	currentObject, err := object.Init("chair", repository, []*triangle.Triangle{currentTriangle},
+		[]*sphere.Sphere{sphere.Init(3, 1)}, []*vector.Vector{normal}, []float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+	return currentObject
+}
+
+// assertCoordinates asserts that coordinates are equal up to rounding errors.
+//
+// Parameters:
+//  t                   - Test instance.
+//  expectedCoordinates - The expected coordinates.
+//  coordinates         - The coordinates.
+//
+// Returns:
+//  none
+//
+func assertCoordinates(t *testing.T, expectedCoordinates, coordinates []float64) {
+	test_helpers.AssertEqual(t, len(expectedCoordinates), len(coordinates))
+	for coordinateIndex := range expectedCoordinates {
+		test_helpers.AssertEqual(t, true,
+			math.Abs(expectedCoordinates[coordinateIndex]-coordinates[coordinateIndex]) < 1e-9)
+	}
+}
+
+// TestInstance_Init tests the initialization of an Instance.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestInstance_Init(t *testing.T) {
+	currentObject := buildObject(t)
+	matrixController := matrix.Controller{}
+	transformation := matrixController.BuildTranslation(1, 2, 3)
+
+	instance, err := Init(currentObject, transformation)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, currentObject, instance.GetObject())
+	test_helpers.AssertEqual(t, currentObject, instance.GetMesh())
+	test_helpers.AssertEqual(t, transformation, instance.GetTransformation())
+	test_helpers.AssertEqual(t, true, instance.IsTransformed())
+
+	expectedInverse := matrixController.BuildTranslation(-1, -2, -3)
+	for lineIndex := 0; lineIndex < 4; lineIndex++ {
+		for columnIndex := 0; columnIndex < 4; columnIndex++ {
+			expectedValue, _ := expectedInverse.GetValue(lineIndex, columnIndex)
+			inverseValue, _ := instance.GetInverseTransformation().GetValue(lineIndex, columnIndex)
+			normalValue, _ := instance.GetNormalTransformation().GetValue(columnIndex, lineIndex)
+			test_helpers.AssertEqual(t, expectedValue, inverseValue)
+			test_helpers.AssertEqual(t, expectedValue, normalValue)
+		}
+	}
+}
+
+// TestInstance_InitOfMesh tests the initialization of an Instance of a mesh with its own light characteristics.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestInstance_InitOfMesh(t *testing.T) {
+	mesh := buildObject(t)
+	redChair, err := object.Init("red chair", mesh.GetRepository(), mesh.GetTriangles(), mesh.GetSpheres(),
+		mesh.GetNormals(), []float64{1, 0, 0}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+	matrixController := matrix.Controller{}
+
+	instance, err := InitOfMesh(mesh, redChair, matrixController.BuildTranslation(1, 2, 3))
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, mesh, instance.GetMesh())
+	test_helpers.AssertEqual(t, redChair, instance.GetObject())
+	test_helpers.AssertEqual(t, true, instance.IsTransformed())
+}
+
+// TestInstance_Init_Untransformed tests the initialization of an Instance already on the coordinates of the scene.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestInstance_Init_Untransformed(t *testing.T) {
+	instance, err := Init(buildObject(t), nil)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, false, instance.IsTransformed())
+	test_helpers.AssertEqual(t, true, instance.GetInverseTransformation() == nil)
+	test_helpers.AssertEqual(t, true, instance.GetNormalTransformation() == nil)
+}
+
+// TestInstance_Init_Non4x4 tests the initialization of an Instance with a transformation of the wrong size.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestInstance_Init_Non4x4(t *testing.T) {
+	transformation, err := matrix.Init(3, 3)
+	test_helpers.AssertNilError(t, err)
+	_, err = Init(buildObject(t), transformation)
+	test_helpers.AssertNotNilError(t, err)
+}
+
+// TestInstance_Init_Singular tests the initialization of an Instance whose transformation can not be inverted.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestInstance_Init_Singular(t *testing.T) {
+	matrixController := matrix.Controller{}
+	_, err := Init(buildObject(t), matrixController.BuildScale(1, 0, 1))
+	test_helpers.AssertNotNilError(t, err)
+}
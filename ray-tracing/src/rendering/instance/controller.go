@@ -0,0 +1,156 @@
+package instance
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/line"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
+	"math"
+)
+
+// Controller is a class for controlling instances.
+//
+// Members:
+// 	none
+//
+type Controller struct{}
+
+// transformCoordinates multiplies 3D coordinates in homogeneous coordinates by a transformation.
+//
+// Parameters:
+// 	transformation        - The 4x4 Matrix of the transformation.
+// 	coordinates           - The x, y and z coordinates.
+// 	homogeneousCoordinate - The fourth coordinate, 1 for points and 0 for vectors.
+//
+// Returns:
+// 	The transformed x, y and z coordinates.
+//
+func (*Controller) transformCoordinates(transformation *matrix.Matrix, coordinates []float64,
+	homogeneousCoordinate float64) []float64 {
+	transformedCoordinates := make([]float64, 3)
+	for lineIndex := 0; lineIndex < 3; lineIndex++ {
+		value, _ := transformation.GetValue(lineIndex, 3)
+		transformedCoordinates[lineIndex] = value * homogeneousCoordinate
+		for columnIndex := 0; columnIndex < 3; columnIndex++ {
+			value, _ = transformation.GetValue(lineIndex, columnIndex)
+			transformedCoordinates[lineIndex] += value * coordinates[columnIndex]
+		}
+	}
+	return transformedCoordinates
+}
+
+// pointCoordinates gets the coordinates of a 3D point.
+//
+// Parameters:
+// 	currentPoint - The point.
+//
+// Returns:
+// 	The x, y and z coordinates.
+//
+func (*Controller) pointCoordinates(currentPoint *point.Point) []float64 {
+	coordinates := make([]float64, 3)
+	for coordinateIndex := range coordinates {
+		coordinates[coordinateIndex], _ = currentPoint.GetCoordinate(coordinateIndex)
+	}
+	return coordinates
+}
+
+// ToObjectPoint takes a point of the scene to the coordinates of the Object of an Instance.
+//
+// Parameters:
+// 	instance   - The Instance.
+// 	scenePoint - The 3D point on the coordinates of the scene.
+//
+// Returns:
+// 	The point on the coordinates of the Object, the same point when the Instance is not transformed.
+//
+func (controller *Controller) ToObjectPoint(instance *Instance, scenePoint *point.Point) *point.Point {
+	if !instance.IsTransformed() {
+		return scenePoint
+	}
+	coordinates := controller.transformCoordinates(instance.GetInverseTransformation(),
+		controller.pointCoordinates(scenePoint), 1)
+	objectPoint, _ := point.Init(3)
+	for coordinateIndex, coordinate := range coordinates {
+		_ = objectPoint.SetCoordinate(coordinateIndex, coordinate)
+	}
+	return objectPoint
+}
+
+// ToObjectRay takes a ray of the scene to the coordinates of the Object of an Instance.
+// The vector director is not normalized, so the line parameter of any intersection is the same on both coordinates.
+//
+// Parameters:
+// 	instance - The Instance.
+// 	sceneRay - The 3D ray on the coordinates of the scene.
+//
+// Returns:
+// 	The ray on the coordinates of the Object, the same ray when the Instance is not transformed.
+//
+func (controller *Controller) ToObjectRay(instance *Instance, sceneRay *line.Line) *line.Line {
+	if !instance.IsTransformed() {
+		return sceneRay
+	}
+	coordinates := controller.transformCoordinates(instance.GetInverseTransformation(),
+		sceneRay.GetVectorDirector().CopyAllCoordinates(), 0)
+	vectorDirector, _ := vector.Init(3)
+	for coordinateIndex, coordinate := range coordinates {
+		_ = vectorDirector.SetCoordinate(coordinateIndex, coordinate)
+	}
+	objectRay, _ := line.Init(controller.ToObjectPoint(instance, sceneRay.GetStartingPoint()), vectorDirector)
+	return objectRay
+}
+
+// ToSceneNormal takes a normal on the coordinates of the Object of an Instance to the scene.
+//
+// Parameters:
+// 	instance     - The Instance.
+// 	objectNormal - The 3D normal on the coordinates of the Object.
+//
+// Returns:
+// 	The normalized normal on the coordinates of the scene, the same normal when the Instance is not transformed.
+//
+func (controller *Controller) ToSceneNormal(instance *Instance, objectNormal *vector.Vector) *vector.Vector {
+	if !instance.IsTransformed() {
+		return objectNormal
+	}
+	coordinates := controller.transformCoordinates(instance.GetNormalTransformation(),
+		objectNormal.CopyAllCoordinates(), 0)
+	sceneNormal, _ := vector.Init(3)
+	for coordinateIndex, coordinate := range coordinates {
+		_ = sceneNormal.SetCoordinate(coordinateIndex, coordinate)
+	}
+	vectorController := vector.Controller{}
+	return vectorController.Normalize(sceneNormal)
+}
+
+// ToSceneBoundingBox finds the box on the coordinates of the scene that contains a bounding box of the Object of an
+// Instance, by transforming its eight corners.
+//
+// Parameters:
+// 	instance          - The Instance.
+// 	objectBoundingBox - The bounding box on the coordinates of the Object as [minX, minY, minZ, maxX, maxY, maxZ].
+//
+// Returns:
+// 	[minX, minY, minZ, maxX, maxY, maxZ]
+//
+func (controller *Controller) ToSceneBoundingBox(instance *Instance, objectBoundingBox []float64) []float64 {
+	if !instance.IsTransformed() {
+		return objectBoundingBox
+	}
+	sceneBoundingBox := []float64{math.MaxFloat64, math.MaxFloat64, math.MaxFloat64,
+		-math.MaxFloat64, -math.MaxFloat64, -math.MaxFloat64}
+	corner := make([]float64, 3)
+	for cornerIndex := 0; cornerIndex < 8; cornerIndex++ {
+		for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+			// Each bit of the index of the corner picks the minimum or the maximum of an axis.
+			corner[coordinateIndex] = objectBoundingBox[coordinateIndex+3*((cornerIndex>>uint(coordinateIndex))&1)]
+		}
+		transformedCorner := controller.transformCoordinates(instance.GetTransformation(), corner, 1)
+		for coordinateIndex, coordinate := range transformedCorner {
+			sceneBoundingBox[coordinateIndex] = math.Min(sceneBoundingBox[coordinateIndex], coordinate)
+			sceneBoundingBox[coordinateIndex+3] = math.Max(sceneBoundingBox[coordinateIndex+3], coordinate)
+		}
+	}
+	return sceneBoundingBox
+}
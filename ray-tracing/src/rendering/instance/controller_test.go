@@ -0,0 +1,178 @@
+package instance
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/line"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
+	"math"
+	"testing"
+)
+
+// buildPoint builds a 3D point.
+//
+// Parameters:
+//  t           - Test instance.
+//  coordinates - The coordinates of the point.
+//
+// Returns:
+//  The point.
+//
+func buildPoint(t *testing.T, coordinates []float64) *point.Point {
+	currentPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	for coordinateIndex, coordinate := range coordinates {
+		err = currentPoint.SetCoordinate(coordinateIndex, coordinate)
+		test_helpers.AssertNilError(t, err)
+	}
+	return currentPoint
+}
+
+// buildVector builds a 3D vector.
+//
+// Parameters:
+//  t           - Test instance.
+//  coordinates - The coordinates of the vector.
+//
+// Returns:
+//  The vector.
+//
+func buildVector(t *testing.T, coordinates []float64) *vector.Vector {
+	currentVector, err := vector.Init(3)
+	test_helpers.AssertNilError(t, err)
+	for coordinateIndex, coordinate := range coordinates {
+		err = currentVector.SetCoordinate(coordinateIndex, coordinate)
+		test_helpers.AssertNilError(t, err)
+	}
+	return currentVector
+}
+
+// pointCoordinates gets the coordinates of a 3D point.
+//
+// Parameters:
+//  currentPoint - The point.
+//
+// Returns:
+//  The coordinates of the point.
+//
+func pointCoordinates(currentPoint *point.Point) []float64 {
+	controller := Controller{}
+	return controller.pointCoordinates(currentPoint)
+}
+
+// TestController_ToObjectPoint tests taking a point of the scene to the coordinates of the object of an Instance.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ToObjectPoint(t *testing.T) {
+	matrixController := matrix.Controller{}
+	transformation, err := matrixController.Compose(matrixController.BuildScale(2, 2, 2),
+		matrixController.BuildTranslation(1, 0, 0))
+	test_helpers.AssertNilError(t, err)
+	instance, err := Init(buildObject(t), transformation)
+	test_helpers.AssertNilError(t, err)
+
+	controller := Controller{}
+	objectPoint := controller.ToObjectPoint(instance, buildPoint(t, []float64{3, 2, -4}))
+	assertCoordinates(t, []float64{1, 1, -2}, pointCoordinates(objectPoint))
+}
+
+// TestController_ToObjectRay tests that a ray taken to the coordinates of the object of an Instance hits it at the
+// same line parameter.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ToObjectRay(t *testing.T) {
+	matrixController := matrix.Controller{}
+	transformation, err := matrixController.Compose(matrixController.BuildScale(1, 1, 3),
+		matrixController.BuildEulerRotation(0, 0, 90), matrixController.BuildTranslation(0, 0, 5))
+	test_helpers.AssertNilError(t, err)
+	instance, err := Init(buildObject(t), transformation)
+	test_helpers.AssertNilError(t, err)
+
+	sceneRay, err := line.Init(buildPoint(t, []float64{-0.25, 0.25, 0}), buildVector(t, []float64{0, 0, 2}))
+	test_helpers.AssertNilError(t, err)
+	controller := Controller{}
+	objectRay := controller.ToObjectRay(instance, sceneRay)
+	assertCoordinates(t, []float64{0.25, 0.25, -5.0 / 3}, pointCoordinates(objectRay.GetStartingPoint()))
+	assertCoordinates(t, []float64{0, 0, 2.0 / 3}, objectRay.GetVectorDirector().CopyAllCoordinates())
+
+	// The ray reaches the plane of the triangle at z = 5 on the scene and at z = 0 on the object at the same time.
+	lineController := line.Controller{}
+	objectPoint, err := lineController.FindPoint(objectRay, 2.5)
+	test_helpers.AssertNilError(t, err)
+	assertCoordinates(t, []float64{0.25, 0.25, 0}, pointCoordinates(objectPoint))
+}
+
+// TestController_ToSceneNormal tests that normals stay perpendicular to the surfaces of a scaled Instance.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ToSceneNormal(t *testing.T) {
+	matrixController := matrix.Controller{}
+	instance, err := Init(buildObject(t), matrixController.BuildScale(2, 1, 1))
+	test_helpers.AssertNilError(t, err)
+
+	controller := Controller{}
+	sceneNormal := controller.ToSceneNormal(instance, buildVector(t, []float64{1, 1, 0}))
+	assertCoordinates(t, []float64{1 / math.Sqrt(5), 2 / math.Sqrt(5), 0}, sceneNormal.CopyAllCoordinates())
+}
+
+// TestController_ToSceneBoundingBox tests the bounding box on the scene of a rotated and translated Instance.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ToSceneBoundingBox(t *testing.T) {
+	matrixController := matrix.Controller{}
+	transformation, err := matrixController.Compose(matrixController.BuildEulerRotation(0, 0, 90),
+		matrixController.BuildTranslation(10, 0, 0))
+	test_helpers.AssertNilError(t, err)
+	instance, err := Init(buildObject(t), transformation)
+	test_helpers.AssertNilError(t, err)
+
+	controller := Controller{}
+	sceneBoundingBox := controller.ToSceneBoundingBox(instance, []float64{0, 0, 0, 2, 1, 3})
+	assertCoordinates(t, []float64{9, 0, 0, 10, 2, 3}, sceneBoundingBox)
+}
+
+// TestController_Untransformed tests that an Instance already on the coordinates of the scene keeps points, rays,
+// normals and bounding boxes.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Untransformed(t *testing.T) {
+	instance, err := Init(buildObject(t), nil)
+	test_helpers.AssertNilError(t, err)
+	controller := Controller{}
+
+	scenePoint := buildPoint(t, []float64{1, 2, 3})
+	test_helpers.AssertEqual(t, scenePoint, controller.ToObjectPoint(instance, scenePoint))
+	sceneRay, err := line.Init(scenePoint, buildVector(t, []float64{0, 0, 1}))
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, sceneRay, controller.ToObjectRay(instance, sceneRay))
+	normal := buildVector(t, []float64{0, 0, 1})
+	test_helpers.AssertEqual(t, normal, controller.ToSceneNormal(instance, normal))
+	boundingBox := []float64{0, 0, 0, 1, 1, 1}
+	assertCoordinates(t, boundingBox, controller.ToSceneBoundingBox(instance, boundingBox))
+}
@@ -0,0 +1,38 @@
+package instance
+
+import (
+	"errors"
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
+)
+
+// non4x4TransformationError is the error where the transformation of an Instance is not in homogeneous coordinates
+// of the third dimension.
+//
+// Parameters:
+//	object         - The Object of the Instance.
+//	transformation - The transformation.
+//
+// Returns:
+//  An Error.
+//
+func non4x4TransformationError(object *object.Object, transformation *matrix.Matrix) error {
+	errorMessage := fmt.Sprintf("The transformation of %s is not a 4x4 matrix: %dx%d.", object.GetName(),
+		transformation.Lines(), transformation.Columns())
+	return errors.New(errorMessage)
+}
+
+// singularTransformationError is the error where the transformation of an Instance can not be inverted, so rays can
+// not be taken to the coordinates of its Object.
+//
+// Parameters:
+//	object - The Object of the Instance.
+//
+// Returns:
+//  An Error.
+//
+func singularTransformationError(object *object.Object) error {
+	errorMessage := fmt.Sprintf("The transformation of %s can not be inverted.", object.GetName())
+	return errors.New(errorMessage)
+}
@@ -0,0 +1,38 @@
+package instance
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
+	"testing"
+)
+
+// TestInstance_Non4x4TransformationError tests the error where the transformation of an Instance is not 4x4.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestInstance_Non4x4TransformationError(t *testing.T) {
+	transformation, err := matrix.Init(3, 4)
+	test_helpers.AssertNilError(t, err)
+	err = non4x4TransformationError(buildObject(t), transformation)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, "The transformation of chair is not a 4x4 matrix: 3x4.", err.Error())
+}
+
+// TestInstance_SingularTransformationError tests the error where the transformation of an Instance can not be
+// inverted.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestInstance_SingularTransformationError(t *testing.T) {
+	err := singularTransformationError(buildObject(t))
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, "The transformation of chair can not be inverted.", err.Error())
+}
@@ -0,0 +1,163 @@
+package job
+
+import (
+	"context"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/path_tracing"
+	"time"
+)
+
+// RunningStatus is the status of a Job whose passes are still running.
+const RunningStatus = "running"
+
+// DoneStatus is the status of a Job that completed all its passes.
+const DoneStatus = "done"
+
+// FailedStatus is the status of a Job stopped by an error.
+const FailedStatus = "failed"
+
+// CanceledStatus is the status of a Job canceled before completing its passes.
+const CanceledStatus = "canceled"
+
+// Job is a class for a path tracing running in the background of the server.
+//
+// Members:
+// 	id                - The id of the Job.
+// 	parameters        - The parameters of the path tracing.
+// 	progressiveRender - The ProgressiveRender with the passes completed by the Job.
+// 	cancel            - The function that cancels the context of the path tracing.
+// 	stopped           - The channel closed when the path tracing stops.
+// 	stoppedAt         - When the path tracing stopped, set before stopped is closed.
+//
+type Job struct {
+	id                string
+	parameters        *path_tracing.Parameters
+	progressiveRender *path_tracing.ProgressiveRender
+	cancel            context.CancelFunc
+	stopped           chan struct{}
+	stoppedAt         time.Time
+}
+
+// GetId gets the id of the Job.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The id.
+//
+func (job *Job) GetId() string {
+	return job.id
+}
+
+// GetParameters gets the parameters of the path tracing.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The parameters.
+//
+func (job *Job) GetParameters() *path_tracing.Parameters {
+	return job.parameters
+}
+
+// GetProgressiveRender gets the ProgressiveRender with the passes completed by the Job.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The ProgressiveRender.
+//
+func (job *Job) GetProgressiveRender() *path_tracing.ProgressiveRender {
+	return job.progressiveRender
+}
+
+// GetStatus gets the status of the Job.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	RunningStatus, DoneStatus, FailedStatus or CanceledStatus.
+//
+func (job *Job) GetStatus() string {
+	err := job.progressiveRender.GetError()
+	if err == context.Canceled {
+		return CanceledStatus
+	}
+	if err != nil {
+		return FailedStatus
+	}
+	if job.progressiveRender.IsDone() {
+		return DoneStatus
+	}
+	return RunningStatus
+}
+
+// GetStoppedAt gets when the path tracing of the Job stopped, after all passes, an error or a cancellation.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	When the path tracing stopped.
+// 	If the path tracing stopped.
+//
+func (job *Job) GetStoppedAt() (time.Time, bool) {
+	select {
+	case <-job.stopped:
+		return job.stoppedAt, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// Cancel cancels the path tracing of the Job. The workers finish the tiles they are tracing before stopping, and a
+// Job that already stopped keeps its status.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	none
+//
+func (job *Job) Cancel() {
+	job.cancel()
+}
+
+// run runs the path tracing of the Job until it completes all passes or its context is canceled.
+//
+// Parameters:
+// 	ctx        - The context of the path tracing.
+// 	pathTracer - The PathTracer.
+//
+// Returns:
+// 	none
+//
+func (job *Job) run(ctx context.Context, pathTracer *path_tracing.PathTracer) {
+	defer job.cancel()
+	pathTracingController := path_tracing.Controller{}
+	_ = pathTracingController.RunProgressive(ctx, pathTracer, job.parameters, job.progressiveRender)
+	job.stoppedAt = time.Now()
+	close(job.stopped)
+}
+
+// Init starts a Job running a path tracing in the background.
+//
+// Parameters:
+// 	id         - The id of the Job.
+// 	pathTracer - The PathTracer.
+// 	parameters - The parameters of the path tracing.
+//
+// Returns:
+// 	The Job.
+//
+func Init(id string, pathTracer *path_tracing.PathTracer, parameters *path_tracing.Parameters) *Job {
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &Job{id: id, parameters: parameters,
+		progressiveRender: path_tracing.InitProgressiveRender(pathTracer, parameters), cancel: cancel,
+		stopped: make(chan struct{})}
+	go job.run(ctx, pathTracer)
+	return job
+}
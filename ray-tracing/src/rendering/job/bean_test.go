@@ -0,0 +1,137 @@
+package job
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/camera"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/light"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/path_tracing"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/sampler"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/screen"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tone_mapping"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+	"time"
+)
+
+// buildVector builds a vector from its coordinates.
+//
+// Parameters:
+//  t           - Test instance.
+//  coordinates - The coordinates of the vector.
+//
+// Returns:
+//  The vector.
+//
+func buildVector(t *testing.T, coordinates []float64) *vector.Vector {
+	builtVector, err := vector.Init(len(coordinates))
+	test_helpers.AssertNilError(t, err)
+	for coordinateIndex, coordinate := range coordinates {
+		test_helpers.AssertNilError(t, builtVector.SetCoordinate(coordinateIndex, coordinate))
+	}
+	return builtVector
+}
+
+// buildEmptyScene builds a PathTracer of a screen of width x height pixels without objects or lights.
+//
+// Parameters:
+//  t      - Test instance.
+//  width  - The width of the screen.
+//  height - The height of the screen.
+//
+// Returns:
+//  The PathTracer.
+//
+func buildEmptyScene(t *testing.T, width, height int) *path_tracing.PathTracer {
+	cameraPosition, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	sceneCamera, err := camera.Init(cameraPosition, buildVector(t, []float64{1, 0, 0}),
+		buildVector(t, []float64{0, 0, 1}), buildVector(t, []float64{0, -1, 0}), 60, 1)
+	test_helpers.AssertNilError(t, err)
+	pixelScreen, err := screen.Init(width, height)
+	test_helpers.AssertNilError(t, err)
+	pathTracer, err := path_tracing.Init([]*object.Object{}, pixelScreen, sceneCamera, []*light.Light{})
+	test_helpers.AssertNilError(t, err)
+	return pathTracer
+}
+
+// buildParameters builds the parameters of a path tracing of the whole screen.
+//
+// Parameters:
+//  t            - Test instance.
+//  width        - The width of the screen.
+//  height       - The height of the screen.
+//  raysPerPixel - The number of rays per pixel.
+//  passes       - The number of passes.
+//
+// Returns:
+//  The parameters.
+//
+func buildParameters(t *testing.T, width, height, raysPerPixel, passes int) *path_tracing.Parameters {
+	parameters, err := path_tracing.InitParameters(raysPerPixel, 1, 0, 0, height, width,
+		path_tracing.UnbiasedEstimator, tone_mapping.InitDefault(), path_tracing.DefaultTileSize, 0,
+		sampler.IndependentSamplerType, path_tracing.DefaultRussianRouletteDepth, nil, passes)
+	test_helpers.AssertNilError(t, err)
+	return parameters
+}
+
+// waitForJob waits for a Job to stop.
+//
+// Parameters:
+//  t   - Test instance.
+//  job - The Job.
+//
+// Returns:
+//  none
+//
+func waitForJob(t *testing.T, job *Job) {
+	for attempt := 0; attempt < 1000; attempt++ {
+		if job.GetProgressiveRender().IsDone() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("The job %s did not stop.", job.GetId())
+}
+
+// TestJob_Init tests that a Job runs all passes of its path tracing.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestJob_Init(t *testing.T) {
+	parameters := buildParameters(t, 7, 5, 1, 2)
+	job := Init("id", buildEmptyScene(t, 7, 5), parameters)
+	test_helpers.AssertEqual(t, "id", job.GetId())
+	test_helpers.AssertEqual(t, parameters, job.GetParameters())
+
+	waitForJob(t, job)
+	test_helpers.AssertEqual(t, DoneStatus, job.GetStatus())
+	test_helpers.AssertEqual(t, 2, job.GetProgressiveRender().GetCompletedPasses())
+	test_helpers.AssertEqual(t, 1.0, job.GetProgressiveRender().GetProgress().GetFraction())
+
+	job.Cancel()
+	test_helpers.AssertEqual(t, DoneStatus, job.GetStatus())
+}
+
+// TestJob_Cancel tests canceling a Job before it completes its passes.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestJob_Cancel(t *testing.T) {
+	job := Init("id", buildEmptyScene(t, 64, 64), buildParameters(t, 64, 64, 64, 10000))
+	test_helpers.AssertEqual(t, RunningStatus, job.GetStatus())
+
+	job.Cancel()
+	waitForJob(t, job)
+	test_helpers.AssertEqual(t, CanceledStatus, job.GetStatus())
+	test_helpers.AssertEqual(t, true, job.GetProgressiveRender().GetCompletedPasses() < 10000)
+}
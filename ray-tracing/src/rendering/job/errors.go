@@ -0,0 +1,19 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+)
+
+// jobNotFoundError is the error where there is no Job with an id.
+//
+// Parameters:
+// 	id - The id of the Job.
+//
+// Returns:
+//  An Error.
+//
+func jobNotFoundError(id string) error {
+	errorMessage := fmt.Sprintf("Job %s not found", id)
+	return errors.New(errorMessage)
+}
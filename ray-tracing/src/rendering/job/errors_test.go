@@ -0,0 +1,22 @@
+package job
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestJob_JobNotFoundError tests the error where there is no Job with an id.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestJob_JobNotFoundError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Job %s not found", "id")
+	err := jobNotFoundError("id")
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
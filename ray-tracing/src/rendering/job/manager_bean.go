@@ -0,0 +1,154 @@
+package job
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/path_tracing"
+	"sync"
+	"time"
+)
+
+// DefaultRetention is the default time a Job is kept after its path tracing stops.
+const DefaultRetention = 30 * time.Minute
+
+// Manager is a class for the Jobs running in the server, safe to use from concurrent requests. A Job is removed once
+// its path tracing stopped for longer than the retention, so the Jobs of a long running server do not pile up.
+//
+// Members:
+// 	jobs      - The Jobs by their ids.
+// 	retention - The time a Job is kept after its path tracing stops.
+// 	mutex     - The lock of the Jobs.
+//
+type Manager struct {
+	jobs      map[string]*Job
+	retention time.Duration
+	mutex     sync.Mutex
+}
+
+// GetRetention gets the time a Job is kept after its path tracing stops.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The retention.
+//
+func (manager *Manager) GetRetention() time.Duration {
+	return manager.retention
+}
+
+// evictExpired removes the Jobs whose path tracing stopped for longer than the retention. The caller holds the lock
+// of the Jobs.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	none
+//
+func (manager *Manager) evictExpired() {
+	now := time.Now()
+	for id, job := range manager.jobs {
+		stoppedAt, isStopped := job.GetStoppedAt()
+		if isStopped && now.Sub(stoppedAt) > manager.retention {
+			delete(manager.jobs, id)
+		}
+	}
+}
+
+// generateId generates a random id for a Job.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The id.
+// 	An error.
+//
+func (*Manager) generateId() (string, error) {
+	idAsBytes := make([]byte, 16)
+	if _, err := rand.Read(idAsBytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(idAsBytes), nil
+}
+
+// Submit checks the path tracing and starts it in a new Job.
+//
+// Parameters:
+// 	pathTracer - The PathTracer.
+// 	parameters - The parameters of the path tracing.
+//
+// Returns:
+// 	The Job.
+// 	An error.
+//
+func (manager *Manager) Submit(pathTracer *path_tracing.PathTracer, parameters *path_tracing.Parameters) (*Job,
+	error) {
+	pathTracingController := path_tracing.Controller{}
+	err := pathTracingController.ValidateParameters(pathTracer, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := manager.generateId()
+	if err != nil {
+		return nil, err
+	}
+	job := Init(id, pathTracer, parameters)
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	manager.evictExpired()
+	manager.jobs[id] = job
+	return job, nil
+}
+
+// Get gets a Job by its id, unless it was removed after the retention.
+//
+// Parameters:
+// 	id - The id of the Job.
+//
+// Returns:
+// 	The Job.
+// 	An error.
+//
+func (manager *Manager) Get(id string) (*Job, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	manager.evictExpired()
+	job, found := manager.jobs[id]
+	if !found {
+		return nil, jobNotFoundError(id)
+	}
+	return job, nil
+}
+
+// Cancel cancels a Job by its id, which keeps the passes it completed.
+//
+// Parameters:
+// 	id - The id of the Job.
+//
+// Returns:
+// 	The Job.
+// 	An error.
+//
+func (manager *Manager) Cancel(id string) (*Job, error) {
+	job, err := manager.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	job.Cancel()
+	return job, nil
+}
+
+// InitManager initializes a Manager without Jobs.
+//
+// Parameters:
+// 	retention - The time a Job is kept after its path tracing stops.
+//
+// Returns:
+// 	The Manager.
+//
+func InitManager(retention time.Duration) *Manager {
+	return &Manager{jobs: map[string]*Job{}, retention: retention}
+}
@@ -0,0 +1,115 @@
+package job
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+	"time"
+)
+
+// TestManager_Submit tests submitting a path tracing and getting its Job.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestManager_Submit(t *testing.T) {
+	manager := InitManager(DefaultRetention)
+	job, err := manager.Submit(buildEmptyScene(t, 7, 5), buildParameters(t, 7, 5, 1, 1))
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 32, len(job.GetId()))
+
+	gottenJob, err := manager.Get(job.GetId())
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, job, gottenJob)
+
+	otherJob, err := manager.Submit(buildEmptyScene(t, 7, 5), buildParameters(t, 7, 5, 1, 1))
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, false, job.GetId() == otherJob.GetId())
+	waitForJob(t, job)
+	waitForJob(t, otherJob)
+}
+
+// TestManager_Submit_WindowError tests submitting a path tracing of a window out of the screen.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestManager_Submit_WindowError(t *testing.T) {
+	manager := InitManager(DefaultRetention)
+	_, err := manager.Submit(buildEmptyScene(t, 7, 5), buildParameters(t, 8, 5, 1, 1))
+	test_helpers.AssertNotNilError(t, err)
+}
+
+// TestManager_Get_NotFoundError tests getting a Job that was not submitted.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestManager_Get_NotFoundError(t *testing.T) {
+	manager := InitManager(DefaultRetention)
+	_, err := manager.Get("unknown")
+	test_helpers.AssertNotNilError(t, err)
+	_, err = manager.Cancel("unknown")
+	test_helpers.AssertNotNilError(t, err)
+}
+
+// TestManager_Cancel tests canceling a Job by its id.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestManager_Cancel(t *testing.T) {
+	manager := InitManager(DefaultRetention)
+	job, err := manager.Submit(buildEmptyScene(t, 64, 64), buildParameters(t, 64, 64, 64, 10000))
+	test_helpers.AssertNilError(t, err)
+
+	canceledJob, err := manager.Cancel(job.GetId())
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, job, canceledJob)
+	waitForJob(t, job)
+	test_helpers.AssertEqual(t, CanceledStatus, job.GetStatus())
+}
+
+// TestManager_Get_Evicted tests that a Job is removed once its path tracing stopped for longer than the retention,
+// while a running Job is kept.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestManager_Get_Evicted(t *testing.T) {
+	manager := InitManager(200 * time.Millisecond)
+	job, err := manager.Submit(buildEmptyScene(t, 7, 5), buildParameters(t, 7, 5, 1, 1))
+	test_helpers.AssertNilError(t, err)
+	runningJob, err := manager.Submit(buildEmptyScene(t, 64, 64), buildParameters(t, 64, 64, 64, 10000))
+	test_helpers.AssertNilError(t, err)
+	defer runningJob.Cancel()
+	waitForJob(t, job)
+	for attempt := 0; attempt < 1000; attempt++ {
+		if _, isStopped := job.GetStoppedAt(); isStopped {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	_, err = manager.Get(job.GetId())
+	test_helpers.AssertNilError(t, err)
+	time.Sleep(400 * time.Millisecond)
+	_, err = manager.Get(job.GetId())
+	test_helpers.AssertNotNilError(t, err)
+	_, err = manager.Get(runningJob.GetId())
+	test_helpers.AssertNilError(t, err)
+}
@@ -84,9 +84,10 @@ func TestLight_Init(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.0
 	diffuseReflection := 0.0
+	refractiveIndex := 1.5
 
-	lightObject, err := object.Init(name, repository, triangles, normals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	lightObject, err := object.Init(name, repository, triangles, nil, normals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
 	lightIntensity := 5.0
@@ -120,9 +121,10 @@ func TestLight_Init_NonRGBColorError(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.0
 	diffuseReflection := 0.0
+	refractiveIndex := 1.5
 
-	lightObject, err := object.Init(name, repository, triangles, normals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	lightObject, err := object.Init(name, repository, triangles, nil, normals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
 	lightIntensity := 5.0
@@ -156,9 +158,10 @@ func TestLight_Init_ColorOutOfBoundsError(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.0
 	diffuseReflection := 0.0
+	refractiveIndex := 1.5
 
-	lightObject, err := object.Init(name, repository, triangles, normals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	lightObject, err := object.Init(name, repository, triangles, nil, normals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
 	lightIntensity := 5.0
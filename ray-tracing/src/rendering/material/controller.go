@@ -0,0 +1,294 @@
+package material
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"math"
+)
+
+// Controller is a class for the geometric operations shared by the materials.
+//
+// Members:
+// 	none
+//
+type Controller struct {}
+
+// buildVector builds a tridimensional vector.
+//
+// Parameters:
+// 	coordinates - The coordinates of the vector.
+//
+// Returns:
+// 	The vector.
+//
+func (*Controller) buildVector(coordinates []float64) *vector.Vector {
+	builtVector, _ := vector.Init(3)
+	for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+		_ = builtVector.SetCoordinate(coordinateIndex, coordinates[coordinateIndex])
+	}
+	return builtVector
+}
+
+// validateColor checks if a color is a valid RGB reflectance.
+//
+// Parameters:
+// 	color - The RGB color.
+//
+// Returns:
+// 	An error.
+//
+func (*Controller) validateColor(color []float64) error {
+	if len(color) != 3 {
+		return nonRGBColorError(color)
+	}
+	for colorIndex := 0; colorIndex < 3; colorIndex++ {
+		if color[colorIndex] < 0 || color[colorIndex] > 1 {
+			return colorOutOfBoundsError(color)
+		}
+	}
+	return nil
+}
+
+// scaleColor multiplies every channel of a color by a scalar.
+//
+// Parameters:
+// 	color  - The RGB color.
+// 	scalar - The scalar.
+//
+// Returns:
+// 	The scaled color.
+//
+func (*Controller) scaleColor(color []float64, scalar float64) []float64 {
+	scaledColor := make([]float64, 3)
+	for colorIndex := 0; colorIndex < 3; colorIndex++ {
+		scaledColor[colorIndex] = color[colorIndex] * scalar
+	}
+	return scaledColor
+}
+
+// buildOrthonormalBasis builds two vectors that form an orthonormal basis with a normal.
+// https://graphics.pixar.com/library/OrthonormalB/paper.pdf
+//
+// Parameters:
+// 	normal - The normalized normal.
+//
+// Returns:
+// 	The tangent.
+// 	The bitangent.
+//
+func (controller *Controller) buildOrthonormalBasis(normal *vector.Vector) (*vector.Vector, *vector.Vector) {
+	coordinates := normal.CopyAllCoordinates()
+	sign := math.Copysign(1, coordinates[2])
+	firstFactor := -1 / (sign + coordinates[2])
+	secondFactor := coordinates[0] * coordinates[1] * firstFactor
+
+	tangent := controller.buildVector([]float64{1 + sign*coordinates[0]*coordinates[0]*firstFactor,
+		sign * secondFactor, -sign * coordinates[0]})
+	bitangent := controller.buildVector([]float64{secondFactor, sign + coordinates[1]*coordinates[1]*firstFactor,
+		-coordinates[1]})
+	return tangent, bitangent
+}
+
+// toWorldCoordinates converts a direction in the local frame of a normal to world coordinates.
+//
+// Parameters:
+// 	localCoordinates - The coordinates in the local frame, where the third axis is the normal.
+// 	normal           - The normalized normal.
+//
+// Returns:
+// 	The direction in world coordinates.
+//
+func (controller *Controller) toWorldCoordinates(localCoordinates []float64, normal *vector.Vector) *vector.Vector {
+	tangent, bitangent := controller.buildOrthonormalBasis(normal)
+	vectorController := &vector.Controller{}
+	tangentPlane, _ := vectorController.Sum(tangent, bitangent, localCoordinates[0], localCoordinates[1])
+	worldVector, _ := vectorController.Sum(tangentPlane, normal, 1, localCoordinates[2])
+	return vectorController.Normalize(worldVector)
+}
+
+// sampleCosineWeightedHemisphere samples a direction in the hemisphere around the third axis with density
+// proportional to the cosine with it.
+//
+// Parameters:
+// 	firstSample  - A random number in [0,1).
+// 	secondSample - A random number in [0,1).
+//
+// Returns:
+// 	The coordinates of the direction in the local frame.
+//
+func (*Controller) sampleCosineWeightedHemisphere(firstSample, secondSample float64) []float64 {
+	radius := math.Sqrt(firstSample)
+	angle := 2 * math.Pi * secondSample
+	return []float64{radius * math.Cos(angle), radius * math.Sin(angle), math.Sqrt(math.Max(0, 1-firstSample))}
+}
+
+// sampleUniformHemisphere samples a direction in the hemisphere around the third axis with the same density for every
+// direction.
+//
+// Parameters:
+// 	firstSample  - A random number in [0,1).
+// 	secondSample - A random number in [0,1).
+//
+// Returns:
+// 	The coordinates of the direction in the local frame.
+//
+func (*Controller) sampleUniformHemisphere(firstSample, secondSample float64) []float64 {
+	cosine := 1 - firstSample
+	sine := math.Sqrt(math.Max(0, 1-cosine*cosine))
+	angle := 2 * math.Pi * secondSample
+	return []float64{sine * math.Cos(angle), sine * math.Sin(angle), cosine}
+}
+
+// SampleCosineWeightedHemisphere samples a direction in the hemisphere around a normal with density proportional to
+// the cosine with the normal.
+//
+// Parameters:
+// 	normal       - The normalized normal.
+// 	firstSample  - A random number in [0,1).
+// 	secondSample - A random number in [0,1).
+//
+// Returns:
+// 	The normalized direction.
+//
+func (controller *Controller) SampleCosineWeightedHemisphere(normal *vector.Vector, firstSample,
+	secondSample float64) *vector.Vector {
+	return controller.toWorldCoordinates(controller.sampleCosineWeightedHemisphere(firstSample, secondSample), normal)
+}
+
+// CosineWeightedHemispherePdf finds the probability density, by solid angle, of sampling a direction with
+// SampleCosineWeightedHemisphere.
+//
+// Parameters:
+// 	normal    - The normalized normal.
+// 	direction - The normalized direction.
+//
+// Returns:
+// 	The probability density, zero below the hemisphere.
+//
+func (*Controller) CosineWeightedHemispherePdf(normal, direction *vector.Vector) float64 {
+	vectorController := &vector.Controller{}
+	cosine, _ := vectorController.DotProduct(normal, direction)
+	if cosine <= 0 {
+		return 0
+	}
+	return cosine / math.Pi
+}
+
+// SampleUniformHemisphere samples a direction in the hemisphere around a normal with the same density for every
+// direction.
+//
+// Parameters:
+// 	normal       - The normalized normal.
+// 	firstSample  - A random number in [0,1).
+// 	secondSample - A random number in [0,1).
+//
+// Returns:
+// 	The normalized direction.
+//
+func (controller *Controller) SampleUniformHemisphere(normal *vector.Vector, firstSample,
+	secondSample float64) *vector.Vector {
+	return controller.toWorldCoordinates(controller.sampleUniformHemisphere(firstSample, secondSample), normal)
+}
+
+// UniformHemispherePdf finds the probability density, by solid angle, of sampling a direction with
+// SampleUniformHemisphere.
+//
+// Parameters:
+// 	normal    - The normalized normal.
+// 	direction - The normalized direction.
+//
+// Returns:
+// 	The probability density, zero below the hemisphere.
+//
+func (*Controller) UniformHemispherePdf(normal, direction *vector.Vector) float64 {
+	vectorController := &vector.Controller{}
+	cosine, _ := vectorController.DotProduct(normal, direction)
+	if cosine <= 0 {
+		return 0
+	}
+	return 1 / (2 * math.Pi)
+}
+
+// FaceForward flips a normal to the side of a direction.
+//
+// Parameters:
+// 	normal    - The normal.
+// 	direction - The direction.
+//
+// Returns:
+// 	The normal on the same side as the direction.
+//
+func (*Controller) FaceForward(normal, direction *vector.Vector) *vector.Vector {
+	vectorController := &vector.Controller{}
+	normalDotProductDirection, _ := vectorController.DotProduct(normal, direction)
+	if normalDotProductDirection < 0 {
+		return vectorController.ScalarMultiplication(normal, -1)
+	}
+	return normal
+}
+
+// FindMirrorReflectionVector finds the perfect mirror reflection of a vector.
+//
+// Parameters:
+//  incidentVector - The normalized vector arriving at the surface.
+//  normalVector   - The normal of the surface.
+//
+// Returns:
+// 	The reflected vector.
+//
+func (*Controller) FindMirrorReflectionVector(incidentVector, normalVector *vector.Vector) *vector.Vector {
+	vectorController := &vector.Controller{}
+	incidentDotProductNormal, _ := vectorController.DotProduct(incidentVector, normalVector)
+
+	// R = I - 2N(N.I)
+	reflectedVector, _ := vectorController.Sum(incidentVector, normalVector, 1, -2*incidentDotProductNormal)
+	return vectorController.Normalize(reflectedVector)
+}
+
+// FindRefractionVector finds the refracted vector using Snell is law.
+//
+// Parameters:
+//  incidentVector          - The normalized vector arriving at the surface.
+//  normalVector            - The normal of the surface on the side of the incident vector.
+//  relativeRefractiveIndex - The index of refraction of the incident medium over the one of the transmitted medium.
+//
+// Returns:
+// 	The refracted vector.
+// 	The cosine between the refracted vector and the opposite of the normal.
+// 	If there is total internal reflection, in which case there is no refracted vector.
+//
+func (*Controller) FindRefractionVector(incidentVector, normalVector *vector.Vector,
+	relativeRefractiveIndex float64) (*vector.Vector, float64, bool) {
+	vectorController := &vector.Controller{}
+	incidentDotProductNormal, _ := vectorController.DotProduct(incidentVector, normalVector)
+	incidentCosine := -incidentDotProductNormal
+
+	transmittedSineSquared := relativeRefractiveIndex * relativeRefractiveIndex *
+		(1 - incidentCosine*incidentCosine)
+	if transmittedSineSquared > 1 {
+		return nil, 0, true
+	}
+	transmittedCosine := math.Sqrt(1 - transmittedSineSquared)
+
+	// T = eta * I + (eta * cos(i) - cos(t)) * N
+	refractedVector, _ := vectorController.Sum(incidentVector, normalVector, relativeRefractiveIndex,
+		relativeRefractiveIndex*incidentCosine-transmittedCosine)
+	return vectorController.Normalize(refractedVector), transmittedCosine, false
+}
+
+// FindFresnelReflectance finds the fraction of light reflected by a dielectric surface for unpolarized light.
+//
+// Parameters:
+//  incidentCosine          - The cosine between the opposite of the incident vector and the normal.
+//  transmittedCosine       - The cosine between the refracted vector and the opposite of the normal.
+//  relativeRefractiveIndex - The index of refraction of the incident medium over the one of the transmitted medium.
+//
+// Returns:
+// 	The reflectance.
+//
+func (*Controller) FindFresnelReflectance(incidentCosine, transmittedCosine, relativeRefractiveIndex float64) float64 {
+	perpendicularReflectance := (relativeRefractiveIndex*incidentCosine - transmittedCosine) /
+		(relativeRefractiveIndex*incidentCosine + transmittedCosine)
+	parallelReflectance := (incidentCosine - relativeRefractiveIndex*transmittedCosine) /
+		(incidentCosine + relativeRefractiveIndex*transmittedCosine)
+	return (perpendicularReflectance*perpendicularReflectance + parallelReflectance*parallelReflectance) / 2
+}
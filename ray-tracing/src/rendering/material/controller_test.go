@@ -0,0 +1,489 @@
+package material
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/math_helper"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// buildTestVector builds a vector.
+//
+// Parameters:
+//  t           - Test instance.
+//  coordinates - The coordinates of the vector.
+//
+// Returns:
+//  A vector.
+//
+func buildTestVector(t *testing.T, coordinates []float64) *vector.Vector {
+	builtVector, err := vector.Init(len(coordinates))
+	test_helpers.AssertNilError(t, err)
+	for coordinateIndex, coordinate := range coordinates {
+		err = builtVector.SetCoordinate(coordinateIndex, coordinate)
+		test_helpers.AssertNilError(t, err)
+	}
+	return builtVector
+}
+
+// assertVectorCoordinates asserts the coordinates of a vector with tolerance.
+//
+// Parameters:
+//  t                   - Test instance.
+//  expectedCoordinates - The expected coordinates.
+//  receivedVector      - The received vector.
+//
+// Returns:
+//  none
+//
+func assertVectorCoordinates(t *testing.T, expectedCoordinates []float64, receivedVector *vector.Vector) {
+	mathHelper := math_helper.Init(0.0000001)
+	for coordinateIndex, expectedCoordinate := range expectedCoordinates {
+		receivedCoordinate, err := receivedVector.GetCoordinate(coordinateIndex)
+		test_helpers.AssertNilError(t, err)
+		test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(expectedCoordinate, receivedCoordinate))
+	}
+}
+
+// buildRandomDirection builds a random normalized direction.
+//
+// Parameters:
+//  t               - Test instance.
+//  randomGenerator - The random generator.
+//
+// Returns:
+//  A normalized vector.
+//
+func buildRandomDirection(t *testing.T, randomGenerator *rand.Rand) *vector.Vector {
+	vectorController := &vector.Controller{}
+	for {
+		direction := buildTestVector(t, []float64{randomGenerator.Float64()*2 - 1, randomGenerator.Float64()*2 - 1,
+			randomGenerator.Float64()*2 - 1})
+		norm := vectorController.Norm(direction)
+		if norm > 0.1 && norm <= 1 {
+			return vectorController.Normalize(direction)
+		}
+	}
+}
+
+// TestController_BuildOrthonormalBasis tests the orthonormal basis built around random normals.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_BuildOrthonormalBasis(t *testing.T) {
+	controller := Controller{}
+	vectorController := &vector.Controller{}
+	mathHelper := math_helper.Init(0.0000001)
+	randomGenerator := rand.New(rand.NewSource(1))
+
+	normals := []*vector.Vector{buildTestVector(t, []float64{0, 0, 1}), buildTestVector(t, []float64{0, 0, -1})}
+	for normalIndex := 0; normalIndex < 100; normalIndex++ {
+		normals = append(normals, buildRandomDirection(t, randomGenerator))
+	}
+
+	for _, normal := range normals {
+		tangent, bitangent := controller.buildOrthonormalBasis(normal)
+		basis := []*vector.Vector{tangent, bitangent, normal}
+		for firstIndex := 0; firstIndex < 3; firstIndex++ {
+			for secondIndex := 0; secondIndex < 3; secondIndex++ {
+				dotProduct, err := vectorController.DotProduct(basis[firstIndex], basis[secondIndex])
+				test_helpers.AssertNilError(t, err)
+				expectedDotProduct := 0.0
+				if firstIndex == secondIndex {
+					expectedDotProduct = 1
+				}
+				test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(expectedDotProduct, dotProduct))
+			}
+		}
+	}
+}
+
+// TestController_ToWorldCoordinates tests the conversion of local directions to world coordinates.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ToWorldCoordinates(t *testing.T) {
+	controller := Controller{}
+	normal := buildTestVector(t, []float64{0, 1, 0})
+	assertVectorCoordinates(t, []float64{0, 1, 0}, controller.toWorldCoordinates([]float64{0, 0, 1}, normal))
+}
+
+// TestController_SampleCosineWeightedHemisphere tests the mean cosine of the cosine weighted hemisphere sampling.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_SampleCosineWeightedHemisphere(t *testing.T) {
+	controller := Controller{}
+	mathHelper := math_helper.Init(0.01)
+	randomGenerator := rand.New(rand.NewSource(1))
+
+	numberOfSamples := 100000
+	sumOfCosines := 0.0
+	for sampleIndex := 0; sampleIndex < numberOfSamples; sampleIndex++ {
+		localCoordinates := controller.sampleCosineWeightedHemisphere(randomGenerator.Float64(),
+			randomGenerator.Float64())
+		norm := math.Sqrt(localCoordinates[0]*localCoordinates[0] + localCoordinates[1]*localCoordinates[1] +
+			localCoordinates[2]*localCoordinates[2])
+		test_helpers.AssertEqual(t, true, math.Abs(norm-1) < 0.0000001)
+		test_helpers.AssertEqual(t, true, localCoordinates[2] >= 0)
+		sumOfCosines += localCoordinates[2]
+	}
+
+	// E[cos] = integral of cos^2 / pi over the hemisphere = 2 / 3
+	test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(2.0/3, sumOfCosines/float64(numberOfSamples)))
+}
+
+// findHemisphereChiSquare finds the chi-square statistic of directions sampled around a normal, on a grid of equally
+// probable cells over the squared cosine or the cosine with the normal and the angle around it.
+//
+// Parameters:
+//  t                - Test instance.
+//  sampleDirection  - The function that samples a direction around the normal.
+//  normal           - The normalized normal.
+//  isCosineWeighted - If the squared cosine, instead of the cosine, is uniform for the distribution.
+//
+// Returns:
+//  The chi-square statistic, with 63 degrees of freedom.
+//
+func findHemisphereChiSquare(t *testing.T, sampleDirection func(*vector.Vector, float64, float64) *vector.Vector,
+	normal *vector.Vector, isCosineWeighted bool) float64 {
+	controller := Controller{}
+	vectorController := &vector.Controller{}
+	randomGenerator := rand.New(rand.NewSource(1))
+	tangent, bitangent := controller.buildOrthonormalBasis(normal)
+
+	numberOfBins := 8
+	numberOfSamples := 64000
+	counts := make([]float64, numberOfBins*numberOfBins)
+	for sampleIndex := 0; sampleIndex < numberOfSamples; sampleIndex++ {
+		direction := sampleDirection(normal, randomGenerator.Float64(), randomGenerator.Float64())
+		test_helpers.AssertEqual(t, true, math.Abs(vectorController.Norm(direction)-1) < 0.0000001)
+		cosine, _ := vectorController.DotProduct(normal, direction)
+		test_helpers.AssertEqual(t, true, cosine >= 0)
+		tangentCoordinate, _ := vectorController.DotProduct(tangent, direction)
+		bitangentCoordinate, _ := vectorController.DotProduct(bitangent, direction)
+		angle := math.Atan2(bitangentCoordinate, tangentCoordinate)
+		if angle < 0 {
+			angle += 2 * math.Pi
+		}
+		if isCosineWeighted {
+			cosine *= cosine
+		}
+		heightBin := int(math.Min(cosine*float64(numberOfBins), float64(numberOfBins-1)))
+		angleBin := int(math.Min(angle/(2*math.Pi)*float64(numberOfBins), float64(numberOfBins-1)))
+		counts[heightBin*numberOfBins+angleBin]++
+	}
+
+	expectedCount := float64(numberOfSamples) / float64(len(counts))
+	chiSquare := 0.0
+	for _, count := range counts {
+		chiSquare += (count - expectedCount) * (count - expectedCount) / expectedCount
+	}
+	return chiSquare
+}
+
+// findPdfIntegral finds the Monte Carlo estimate of the integral of a probability density over the sphere.
+//
+// Parameters:
+//  t      - Test instance.
+//  pdf    - The probability density by solid angle.
+//  normal - The normalized normal.
+//
+// Returns:
+//  The estimate of the integral.
+//
+func findPdfIntegral(t *testing.T, pdf func(*vector.Vector, *vector.Vector) float64, normal *vector.Vector) float64 {
+	randomGenerator := rand.New(rand.NewSource(2))
+	numberOfSamples := 200000
+	sumOfPdfs := 0.0
+	for sampleIndex := 0; sampleIndex < numberOfSamples; sampleIndex++ {
+		sumOfPdfs += pdf(normal, buildRandomDirection(t, randomGenerator))
+	}
+	return 4 * math.Pi * sumOfPdfs / float64(numberOfSamples)
+}
+
+// TestController_SampleCosineWeightedHemisphere_Distribution tests that the directions are distributed with density
+// proportional to the cosine, around any normal.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_SampleCosineWeightedHemisphere_Distribution(t *testing.T) {
+	controller := Controller{}
+	vectorController := &vector.Controller{}
+	// 103.4 is the chi-square with 63 degrees of freedom that is exceeded with probability 0.001
+	for _, normal := range []*vector.Vector{buildTestVector(t, []float64{0, 0, 1}),
+		vectorController.Normalize(buildTestVector(t, []float64{1, -2, 0.5}))} {
+		chiSquare := findHemisphereChiSquare(t, controller.SampleCosineWeightedHemisphere, normal, true)
+		test_helpers.AssertEqual(t, true, chiSquare < 103.4)
+		uniformChiSquare := findHemisphereChiSquare(t, controller.SampleCosineWeightedHemisphere, normal, false)
+		test_helpers.AssertEqual(t, true, uniformChiSquare > 103.4)
+	}
+}
+
+// TestController_SampleUniformHemisphere_Distribution tests that the directions are uniformly distributed, around
+// any normal.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_SampleUniformHemisphere_Distribution(t *testing.T) {
+	controller := Controller{}
+	vectorController := &vector.Controller{}
+	for _, normal := range []*vector.Vector{buildTestVector(t, []float64{0, 0, -1}),
+		vectorController.Normalize(buildTestVector(t, []float64{1, -2, 0.5}))} {
+		chiSquare := findHemisphereChiSquare(t, controller.SampleUniformHemisphere, normal, false)
+		test_helpers.AssertEqual(t, true, chiSquare < 103.4)
+		cosineChiSquare := findHemisphereChiSquare(t, controller.SampleUniformHemisphere, normal, true)
+		test_helpers.AssertEqual(t, true, cosineChiSquare > 103.4)
+	}
+}
+
+// TestController_CosineWeightedHemispherePdf tests the probability density of the cosine weighted hemisphere
+// sampling.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_CosineWeightedHemispherePdf(t *testing.T) {
+	controller := Controller{}
+	mathHelper := math_helper.Init(0.01)
+	normal := buildTestVector(t, []float64{0, 1, 0})
+	test_helpers.AssertEqual(t, 1/math.Pi, controller.CosineWeightedHemispherePdf(normal, normal))
+	test_helpers.AssertEqual(t, 0.0, controller.CosineWeightedHemispherePdf(normal,
+		buildTestVector(t, []float64{0, -1, 0})))
+	test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(1,
+		findPdfIntegral(t, controller.CosineWeightedHemispherePdf, normal)))
+}
+
+// TestController_UniformHemispherePdf tests the probability density of the uniform hemisphere sampling.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_UniformHemispherePdf(t *testing.T) {
+	controller := Controller{}
+	mathHelper := math_helper.Init(0.01)
+	normal := buildTestVector(t, []float64{0, 1, 0})
+	test_helpers.AssertEqual(t, 1/(2*math.Pi), controller.UniformHemispherePdf(normal,
+		buildTestVector(t, []float64{1, 0.1, 0})))
+	test_helpers.AssertEqual(t, 0.0, controller.UniformHemispherePdf(normal, buildTestVector(t, []float64{1, -0.1, 0})))
+	test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(1,
+		findPdfIntegral(t, controller.UniformHemispherePdf, normal)))
+}
+
+// TestController_FaceForward tests flipping a normal to the side of a direction.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FaceForward(t *testing.T) {
+	controller := Controller{}
+	normal := buildTestVector(t, []float64{0, 1, 0})
+	assertVectorCoordinates(t, []float64{0, 1, 0}, controller.FaceForward(normal,
+		buildTestVector(t, []float64{1, 1, 0})))
+	assertVectorCoordinates(t, []float64{0, -1, 0}, controller.FaceForward(normal,
+		buildTestVector(t, []float64{1, -1, 0})))
+}
+
+// TestController_FindMirrorReflectionVector tests the perfect mirror reflection of a vector.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FindMirrorReflectionVector(t *testing.T) {
+	controller := Controller{}
+	incidentVector := buildTestVector(t, []float64{math.Sqrt2 / 2, -math.Sqrt2 / 2, 0})
+	normalVector := buildTestVector(t, []float64{0, 1, 0})
+
+	reflectedVector := controller.FindMirrorReflectionVector(incidentVector, normalVector)
+	assertVectorCoordinates(t, []float64{math.Sqrt2 / 2, math.Sqrt2 / 2, 0}, reflectedVector)
+}
+
+// TestController_FindRefractionVector tests the refraction of a vector following Snell is law.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FindRefractionVector(t *testing.T) {
+	controller := Controller{}
+	mathHelper := math_helper.Init(0.0000001)
+	relativeRefractiveIndex := 1 / 1.5
+	incidentVector := buildTestVector(t, []float64{math.Sqrt2 / 2, -math.Sqrt2 / 2, 0})
+	normalVector := buildTestVector(t, []float64{0, 1, 0})
+
+	refractedVector, transmittedCosine, isTotalInternalReflection := controller.FindRefractionVector(
+		incidentVector, normalVector, relativeRefractiveIndex)
+	test_helpers.AssertEqual(t, false, isTotalInternalReflection)
+
+	transmittedSine := relativeRefractiveIndex * math.Sqrt2 / 2
+	test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(
+		math.Sqrt(1-transmittedSine*transmittedSine), transmittedCosine))
+	assertVectorCoordinates(t, []float64{transmittedSine, -transmittedCosine, 0}, refractedVector)
+}
+
+// TestController_FindRefractionVector_NormalIncidence tests the refraction of a vector parallel to the normal.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FindRefractionVector_NormalIncidence(t *testing.T) {
+	controller := Controller{}
+	incidentVector := buildTestVector(t, []float64{0, -1, 0})
+	normalVector := buildTestVector(t, []float64{0, 1, 0})
+
+	refractedVector, transmittedCosine, isTotalInternalReflection := controller.FindRefractionVector(
+		incidentVector, normalVector, 1/1.5)
+	test_helpers.AssertEqual(t, false, isTotalInternalReflection)
+	test_helpers.AssertEqual(t, 1.0, transmittedCosine)
+	assertVectorCoordinates(t, []float64{0, -1, 0}, refractedVector)
+}
+
+// TestController_FindRefractionVector_TotalInternalReflection tests the refraction of a vector beyond the critical
+// angle.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FindRefractionVector_TotalInternalReflection(t *testing.T) {
+	controller := Controller{}
+	incidentVector := buildTestVector(t, []float64{math.Sqrt(3) / 2, -0.5, 0})
+	normalVector := buildTestVector(t, []float64{0, 1, 0})
+
+	refractedVector, _, isTotalInternalReflection := controller.FindRefractionVector(
+		incidentVector, normalVector, 1.5)
+	test_helpers.AssertEqual(t, true, isTotalInternalReflection)
+	test_helpers.AssertEqual(t, true, refractedVector == nil)
+}
+
+// TestController_FindFresnelReflectance tests the Fresnel reflectance of a dielectric surface.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FindFresnelReflectance(t *testing.T) {
+	controller := Controller{}
+	mathHelper := math_helper.Init(0.0000001)
+
+	normalIncidenceReflectance := controller.FindFresnelReflectance(1, 1, 1/1.5)
+	test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(0.04, normalIncidenceReflectance))
+
+	grazingIncidenceReflectance := controller.FindFresnelReflectance(0, math.Sqrt(1-1/(1.5*1.5)), 1/1.5)
+	test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(1, grazingIncidenceReflectance))
+
+	obliqueIncidenceReflectance := controller.FindFresnelReflectance(math.Sqrt2/2, math.Sqrt(1-0.5/(1.5*1.5)),
+		1/1.5)
+	test_helpers.AssertEqual(t, true, obliqueIncidenceReflectance > 0.04)
+	test_helpers.AssertEqual(t, true, obliqueIncidenceReflectance < 1)
+}
+
+// assertSampleConsistency asserts that the samples of a material agree with its reflectance function and probability
+// density.
+//
+// Parameters:
+//  t               - Test instance.
+//  testedMaterial  - The Material.
+//  normal          - The normalized normal of the surface.
+//  outgoing        - The normalized direction towards the viewer.
+//  randomGenerator - The random generator.
+//
+// Returns:
+//  none
+//
+func assertSampleConsistency(t *testing.T, testedMaterial Material, normal, outgoing *vector.Vector,
+	randomGenerator *rand.Rand) {
+	vectorController := &vector.Controller{}
+	mathHelper := math_helper.Init(0.000001)
+	for sampleIndex := 0; sampleIndex < 1000; sampleIndex++ {
+		sample, isSampled := testedMaterial.SampleDirection(normal, outgoing, randomGenerator.Float64(),
+			randomGenerator.Float64())
+		if !isSampled {
+			continue
+		}
+		test_helpers.AssertEqual(t, false, sample.IsDelta())
+		test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(1,
+			vectorController.Norm(sample.GetDirection())))
+		pdf := testedMaterial.Pdf(normal, outgoing, sample.GetDirection())
+		test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(pdf, sample.GetPdf()))
+		test_helpers.AssertEqual(t, true, pdf > 0)
+
+		incomingCosine, err := vectorController.DotProduct(normal, sample.GetDirection())
+		test_helpers.AssertNilError(t, err)
+		reflectance := testedMaterial.Evaluate(normal, outgoing, sample.GetDirection())
+		for colorIndex := 0; colorIndex < 3; colorIndex++ {
+			test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(
+				reflectance[colorIndex]*math.Abs(incomingCosine)/pdf, sample.GetWeight()[colorIndex]))
+		}
+	}
+}
+
+// estimateAlbedo estimates the fraction of light reflected by a material with its own samples.
+//
+// Parameters:
+//  testedMaterial  - The Material.
+//  normal          - The normalized normal of the surface.
+//  outgoing        - The normalized direction towards the viewer.
+//  randomGenerator - The random generator.
+//
+// Returns:
+//  The RGB albedo.
+//
+func estimateAlbedo(testedMaterial Material, normal, outgoing *vector.Vector, randomGenerator *rand.Rand) []float64 {
+	numberOfSamples := 100000
+	albedo := make([]float64, 3)
+	for sampleIndex := 0; sampleIndex < numberOfSamples; sampleIndex++ {
+		sample, isSampled := testedMaterial.SampleDirection(normal, outgoing, randomGenerator.Float64(),
+			randomGenerator.Float64())
+		if !isSampled {
+			continue
+		}
+		for colorIndex := 0; colorIndex < 3; colorIndex++ {
+			albedo[colorIndex] += sample.GetWeight()[colorIndex] / float64(numberOfSamples)
+		}
+	}
+	return albedo
+}
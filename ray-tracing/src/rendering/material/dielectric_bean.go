@@ -0,0 +1,147 @@
+package material
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+)
+
+// Dielectric is a class for smooth transparent materials, such as glass, that reflect or refract according to the
+// Fresnel reflectance.
+// The normal must point outside of the object, so the side of the outgoing direction tells if the ray is inside.
+//
+// Members:
+// 	color           - The RGB transmittance.
+// 	refractiveIndex - The index of refraction of the material.
+//
+type Dielectric struct {
+	color           []float64
+	refractiveIndex float64
+}
+
+// GetColor gets the RGB transmittance.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The RGB transmittance.
+//
+func (dielectric *Dielectric) GetColor() []float64 {
+	return dielectric.color
+}
+
+// GetRefractiveIndex gets the index of refraction.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The index of refraction.
+//
+func (dielectric *Dielectric) GetRefractiveIndex() float64 {
+	return dielectric.refractiveIndex
+}
+
+// SampleDirection chooses between the reflected and the refracted directions with the Fresnel reflectance as the
+// probability of reflection, always reflecting when there is total internal reflection.
+//
+// Parameters:
+// 	normal       - The normalized normal of the surface, pointing outside of the object.
+// 	outgoing     - The normalized direction towards the viewer.
+// 	firstSample  - A random number in [0,1) used to choose between reflection and refraction.
+// 	secondSample - Unused.
+//
+// Returns:
+// 	The Sample.
+// 	If a direction could be sampled.
+//
+func (dielectric *Dielectric) SampleDirection(normal, outgoing *vector.Vector, firstSample,
+	_ float64) (*Sample, bool) {
+	controller := &Controller{}
+	vectorController := &vector.Controller{}
+	incidentVector := vectorController.ScalarMultiplication(outgoing, -1)
+
+	facingNormal := normal
+	relativeRefractiveIndex := 1 / dielectric.GetRefractiveIndex()
+	outgoingCosine, _ := vectorController.DotProduct(outgoing, normal)
+	if outgoingCosine < 0 {
+		// The ray is leaving the object.
+		facingNormal = vectorController.ScalarMultiplication(normal, -1)
+		relativeRefractiveIndex = dielectric.GetRefractiveIndex()
+		outgoingCosine = -outgoingCosine
+	}
+
+	reflectedVector := controller.FindMirrorReflectionVector(incidentVector, facingNormal)
+	refractedVector, transmittedCosine, isTotalInternalReflection := controller.FindRefractionVector(
+		incidentVector, facingNormal, relativeRefractiveIndex)
+	if isTotalInternalReflection {
+		return initSample(reflectedVector, dielectric.GetColor(), 1, true), true
+	}
+
+	reflectance := controller.FindFresnelReflectance(outgoingCosine, transmittedCosine, relativeRefractiveIndex)
+	if firstSample < reflectance {
+		return initSample(reflectedVector, dielectric.GetColor(), reflectance, true), true
+	}
+	return initSample(refractedVector, dielectric.GetColor(), 1-reflectance, true), true
+}
+
+// Evaluate evaluates the RGB reflectance function, which is null outside of the discrete directions.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	A black color.
+//
+func (*Dielectric) Evaluate(_, _, _ *vector.Vector) []float64 {
+	return make([]float64, 3)
+}
+
+// Pdf finds the probability density of sampling an incoming direction, which is null outside of the discrete
+// directions.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	0.
+//
+func (*Dielectric) Pdf(_, _, _ *vector.Vector) float64 {
+	return 0
+}
+
+// IsDelta checks if the material only scatters in discrete directions.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	true.
+//
+func (*Dielectric) IsDelta() bool {
+	return true
+}
+
+// InitDielectric initializes a Dielectric.
+//
+// Parameters:
+// 	color           - The RGB transmittance.
+// 	refractiveIndex - The index of refraction of the material.
+//
+// Returns:
+// 	A Dielectric.
+// 	An error.
+//
+func InitDielectric(color []float64, refractiveIndex float64) (*Dielectric, error) {
+	controller := &Controller{}
+	if err := controller.validateColor(color); err != nil {
+		return nil, err
+	}
+	if refractiveIndex <= 0 {
+		return nil, invalidRefractiveIndexError(refractiveIndex)
+	}
+	return &Dielectric{color: color, refractiveIndex: refractiveIndex}, nil
+}
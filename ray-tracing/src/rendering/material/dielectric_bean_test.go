@@ -0,0 +1,92 @@
+package material
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/math_helper"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// TestDielectric_Init tests the instantiation of a Dielectric.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestDielectric_Init(t *testing.T) {
+	color := []float64{0.5, 0.25, 1}
+	refractiveIndex := 1.5
+	dielectric, err := InitDielectric(color, refractiveIndex)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(color, dielectric.GetColor()))
+	test_helpers.AssertEqual(t, refractiveIndex, dielectric.GetRefractiveIndex())
+	test_helpers.AssertEqual(t, true, dielectric.IsDelta())
+}
+
+// TestDielectric_Init_InvalidRefractiveIndexError tests the instantiation of a Dielectric with a non positive index
+// of refraction.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestDielectric_Init_InvalidRefractiveIndexError(t *testing.T) {
+	refractiveIndex := 0.0
+	expectedErrorMessage := fmt.Sprintf("The index of refraction must be positive: %v.", refractiveIndex)
+	_, err := InitDielectric([]float64{0.5, 0.25, 1}, refractiveIndex)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestDielectric_SampleDirection tests the choice between reflection and refraction of a Dielectric.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestDielectric_SampleDirection(t *testing.T) {
+	mathHelper := math_helper.Init(0.0000001)
+	dielectric, err := InitDielectric([]float64{1, 1, 1}, 1.5)
+	test_helpers.AssertNilError(t, err)
+	normal := buildTestVector(t, []float64{0, 0, 1})
+	outgoing := buildTestVector(t, []float64{0, 0, 1})
+
+	reflectedSample, isSampled := dielectric.SampleDirection(normal, outgoing, 0, 0)
+	test_helpers.AssertEqual(t, true, isSampled)
+	test_helpers.AssertEqual(t, true, reflectedSample.IsDelta())
+	test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(0.04, reflectedSample.GetPdf()))
+	assertVectorCoordinates(t, []float64{0, 0, 1}, reflectedSample.GetDirection())
+
+	refractedSample, isSampled := dielectric.SampleDirection(normal, outgoing, 0.5, 0)
+	test_helpers.AssertEqual(t, true, isSampled)
+	test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(0.96, refractedSample.GetPdf()))
+	assertVectorCoordinates(t, []float64{0, 0, -1}, refractedSample.GetDirection())
+}
+
+// TestDielectric_SampleDirection_TotalInternalReflection tests a ray leaving a Dielectric beyond the critical angle.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestDielectric_SampleDirection_TotalInternalReflection(t *testing.T) {
+	dielectric, err := InitDielectric([]float64{1, 1, 1}, 1.5)
+	test_helpers.AssertNilError(t, err)
+	normal := buildTestVector(t, []float64{0, 0, 1})
+	outgoing := buildTestVector(t, []float64{-math.Sqrt(3) / 2, 0, -0.5})
+
+	sample, isSampled := dielectric.SampleDirection(normal, outgoing, 0.99, 0)
+	test_helpers.AssertEqual(t, true, isSampled)
+	test_helpers.AssertEqual(t, 1.0, sample.GetPdf())
+	assertVectorCoordinates(t, []float64{math.Sqrt(3) / 2, 0, -0.5}, sample.GetDirection())
+}
@@ -0,0 +1,87 @@
+package material
+
+import (
+	"errors"
+	"fmt"
+)
+
+// nonRGBColorError is the error where the color of a Material does not have 3 values.
+//
+// Parameters:
+//	color - The color values.
+//
+// Returns:
+//  An Error.
+//
+func nonRGBColorError(color []float64) error {
+	errorMessage := fmt.Sprintf("There are not 3 color values: %d.", len(color))
+	return errors.New(errorMessage)
+}
+
+// colorOutOfBoundsError is the error where a color coefficient is out of the bounds.
+//
+// Parameters:
+//	color - The RGB color values.
+//
+// Returns:
+//  An Error.
+//
+func colorOutOfBoundsError(color []float64) error {
+	errorMessage := fmt.Sprintf("Color values out of interval [0,1]: %v.", color)
+	return errors.New(errorMessage)
+}
+
+// invalidExponentError is the error where the exponent of a Phong material is negative.
+//
+// Parameters:
+//	exponent - The exponent.
+//
+// Returns:
+//  An Error.
+//
+func invalidExponentError(exponent float64) error {
+	errorMessage := fmt.Sprintf("The Phong exponent must not be negative: %v.", exponent)
+	return errors.New(errorMessage)
+}
+
+// invalidRoughnessError is the error where the roughness of a GGX material is out of the interval (0,1].
+//
+// Parameters:
+//	roughness - The roughness.
+//
+// Returns:
+//  An Error.
+//
+func invalidRoughnessError(roughness float64) error {
+	errorMessage := fmt.Sprintf("The roughness must be in the interval (0,1]: %v.", roughness)
+	return errors.New(errorMessage)
+}
+
+// invalidRefractiveIndexError is the error where the index of refraction of a material is not positive.
+//
+// Parameters:
+//	refractiveIndex - The index of refraction.
+//
+// Returns:
+//  An Error.
+//
+func invalidRefractiveIndexError(refractiveIndex float64) error {
+	errorMessage := fmt.Sprintf("The index of refraction must be positive: %v.", refractiveIndex)
+	return errors.New(errorMessage)
+}
+
+// invalidMixtureError is the error where the components and weights of a Mixture do not match or the weights are
+// not a probability distribution.
+//
+// Parameters:
+//	numberOfComponents - The number of components.
+//	weights            - The weights of the components.
+//
+// Returns:
+//  An Error.
+//
+func invalidMixtureError(numberOfComponents int, weights []float64) error {
+	errorMessage := fmt.Sprintf("Invalid mixture of %d materials with weights %v. The weights must be one per "+
+		"material, not negative and sum 1.", numberOfComponents, weights)
+	return errors.New(errorMessage)
+}
@@ -0,0 +1,105 @@
+package material
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestMaterial_NonRGBColorError tests the error where the color of a Material does not have 3 values.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMaterial_NonRGBColorError(t *testing.T) {
+	color := []float64{1, 1}
+	expectedErrorMessage := fmt.Sprintf("There are not 3 color values: %d.", len(color))
+	err := nonRGBColorError(color)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestMaterial_ColorOutOfBoundsError tests the error where a color coefficient is out of the bounds.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMaterial_ColorOutOfBoundsError(t *testing.T) {
+	color := []float64{1, 2, 1}
+	expectedErrorMessage := fmt.Sprintf("Color values out of interval [0,1]: %v.", color)
+	err := colorOutOfBoundsError(color)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestMaterial_InvalidExponentError tests the error where the exponent of a Phong material is negative.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMaterial_InvalidExponentError(t *testing.T) {
+	exponent := -2.0
+	expectedErrorMessage := fmt.Sprintf("The Phong exponent must not be negative: %v.", exponent)
+	err := invalidExponentError(exponent)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestMaterial_InvalidRoughnessError tests the error where the roughness of a GGX material is out of the bounds.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMaterial_InvalidRoughnessError(t *testing.T) {
+	roughness := 2.0
+	expectedErrorMessage := fmt.Sprintf("The roughness must be in the interval (0,1]: %v.", roughness)
+	err := invalidRoughnessError(roughness)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestMaterial_InvalidRefractiveIndexError tests the error where the index of refraction of a material is not
+// positive.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMaterial_InvalidRefractiveIndexError(t *testing.T) {
+	refractiveIndex := -1.0
+	expectedErrorMessage := fmt.Sprintf("The index of refraction must be positive: %v.", refractiveIndex)
+	err := invalidRefractiveIndexError(refractiveIndex)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestMaterial_InvalidMixtureError tests the error where the components and weights of a Mixture are invalid.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMaterial_InvalidMixtureError(t *testing.T) {
+	weights := []float64{0.5, 0.25}
+	expectedErrorMessage := fmt.Sprintf("Invalid mixture of %d materials with weights %v. The weights must be one "+
+		"per material, not negative and sum 1.", 2, weights)
+	err := invalidMixtureError(2, weights)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
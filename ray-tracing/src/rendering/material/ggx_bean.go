@@ -0,0 +1,258 @@
+package material
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"math"
+)
+
+// minimumAlpha is the narrowest width of the microfacet distribution. Narrower distributions are rounded to a
+// density of 0 divided by 0, or to an infinite density, at the normal.
+const minimumAlpha = 0.001
+
+// GGX is a class for rough conductor materials following the GGX microfacet model, with the Smith shadowing and
+// Schlick is approximation of the Fresnel reflectance.
+// https://www.cs.cornell.edu/~srm/publications/EGSR07-btdf.pdf
+//
+// Members:
+// 	color     - The RGB reflectance at normal incidence.
+// 	roughness - The perceptual roughness, whose square is the width of the microfacet distribution.
+//
+type GGX struct {
+	color     []float64
+	roughness float64
+}
+
+// GetColor gets the RGB reflectance at normal incidence.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The RGB reflectance.
+//
+func (ggx *GGX) GetColor() []float64 {
+	return ggx.color
+}
+
+// GetRoughness gets the perceptual roughness.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The roughness.
+//
+func (ggx *GGX) GetRoughness() float64 {
+	return ggx.roughness
+}
+
+// findAlpha finds the width of the microfacet distribution, which is at least minimumAlpha.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The width.
+//
+func (ggx *GGX) findAlpha() float64 {
+	return math.Max(minimumAlpha, ggx.GetRoughness()*ggx.GetRoughness())
+}
+
+// findDistribution finds the density of microfacets oriented by a half vector.
+//
+// Parameters:
+// 	halfVectorCosine - The cosine between the half vector and the normal.
+//
+// Returns:
+// 	The microfacet distribution.
+//
+func (ggx *GGX) findDistribution(halfVectorCosine float64) float64 {
+	alphaSquared := ggx.findAlpha() * ggx.findAlpha()
+	denominator := halfVectorCosine*halfVectorCosine*(alphaSquared-1) + 1
+	return alphaSquared / (math.Pi * denominator * denominator)
+}
+
+// findMaskingShadowing finds the Smith masking function for one direction.
+//
+// Parameters:
+// 	cosine - The cosine between the direction and the normal.
+//
+// Returns:
+// 	The fraction of visible microfacets.
+//
+func (ggx *GGX) findMaskingShadowing(cosine float64) float64 {
+	alphaSquared := ggx.findAlpha() * ggx.findAlpha()
+	return 2 * cosine / (cosine + math.Sqrt(alphaSquared+(1-alphaSquared)*cosine*cosine))
+}
+
+// findFresnel finds Schlick is approximation of the Fresnel reflectance.
+//
+// Parameters:
+// 	outgoingHalfVectorCosine - The cosine between the outgoing direction and the half vector.
+//
+// Returns:
+// 	The RGB reflectance.
+//
+func (ggx *GGX) findFresnel(outgoingHalfVectorCosine float64) []float64 {
+	fresnel := make([]float64, 3)
+	schlickFactor := math.Pow(1-math.Max(0, outgoingHalfVectorCosine), 5)
+	for colorIndex := 0; colorIndex < 3; colorIndex++ {
+		fresnel[colorIndex] = ggx.GetColor()[colorIndex] + (1-ggx.GetColor()[colorIndex])*schlickFactor
+	}
+	return fresnel
+}
+
+// findCosines finds the cosines needed by the model for a pair of directions.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	The cosine between the outgoing direction and the normal.
+// 	The cosine between the incoming direction and the normal.
+// 	The cosine between the half vector and the normal.
+// 	The cosine between the outgoing direction and the half vector.
+// 	If both directions are above the surface.
+//
+func (*GGX) findCosines(normal, outgoing, incoming *vector.Vector) (float64, float64, float64, float64, bool) {
+	controller := &Controller{}
+	vectorController := &vector.Controller{}
+	facingNormal := controller.FaceForward(normal, outgoing)
+	outgoingCosine, _ := vectorController.DotProduct(facingNormal, outgoing)
+	incomingCosine, _ := vectorController.DotProduct(facingNormal, incoming)
+	if outgoingCosine <= 0 || incomingCosine <= 0 {
+		return 0, 0, 0, 0, false
+	}
+	halfVector, _ := vectorController.Sum(outgoing, incoming, 1, 1)
+	halfVector = vectorController.Normalize(halfVector)
+	halfVectorCosine, _ := vectorController.DotProduct(facingNormal, halfVector)
+	outgoingHalfVectorCosine, _ := vectorController.DotProduct(outgoing, halfVector)
+	return outgoingCosine, incomingCosine, halfVectorCosine, outgoingHalfVectorCosine, true
+}
+
+// SampleDirection samples a microfacet from the distribution and reflects the outgoing direction on it.
+//
+// Parameters:
+// 	normal       - The normalized normal of the surface.
+// 	outgoing     - The normalized direction towards the viewer.
+// 	firstSample  - A random number in [0,1).
+// 	secondSample - A random number in [0,1).
+//
+// Returns:
+// 	The Sample.
+// 	If a direction could be sampled.
+//
+func (ggx *GGX) SampleDirection(normal, outgoing *vector.Vector, firstSample,
+	secondSample float64) (*Sample, bool) {
+	controller := &Controller{}
+	vectorController := &vector.Controller{}
+	facingNormal := controller.FaceForward(normal, outgoing)
+
+	alphaSquared := ggx.findAlpha() * ggx.findAlpha()
+	halfVectorCosine := math.Sqrt((1 - firstSample) / (1 + (alphaSquared-1)*firstSample))
+	halfVectorSine := math.Sqrt(math.Max(0, 1-halfVectorCosine*halfVectorCosine))
+	angle := 2 * math.Pi * secondSample
+	halfVector := controller.toWorldCoordinates(
+		[]float64{halfVectorSine * math.Cos(angle), halfVectorSine * math.Sin(angle), halfVectorCosine}, facingNormal)
+	incoming := controller.FindMirrorReflectionVector(vectorController.ScalarMultiplication(outgoing, -1),
+		halfVector)
+
+	outgoingCosine, incomingCosine, halfVectorCosine, outgoingHalfVectorCosine, isAbove := ggx.findCosines(
+		normal, outgoing, incoming)
+	if !isAbove || outgoingHalfVectorCosine <= 0 || halfVectorCosine <= 0 {
+		return nil, false
+	}
+
+	// f * cos / pdf = F * G * (o.h) / ((n.o) * (n.h))
+	geometricFactor := ggx.findMaskingShadowing(outgoingCosine) * ggx.findMaskingShadowing(incomingCosine) *
+		outgoingHalfVectorCosine / (outgoingCosine * halfVectorCosine)
+	weight := make([]float64, 3)
+	fresnel := ggx.findFresnel(outgoingHalfVectorCosine)
+	for colorIndex := 0; colorIndex < 3; colorIndex++ {
+		weight[colorIndex] = fresnel[colorIndex] * geometricFactor
+	}
+	return initSample(incoming, weight, ggx.Pdf(normal, outgoing, incoming), false), true
+}
+
+// Evaluate evaluates the RGB reflectance function for a pair of directions.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	The RGB value of the reflectance function.
+//
+func (ggx *GGX) Evaluate(normal, outgoing, incoming *vector.Vector) []float64 {
+	reflectance := make([]float64, 3)
+	outgoingCosine, incomingCosine, halfVectorCosine, outgoingHalfVectorCosine, isAbove := ggx.findCosines(
+		normal, outgoing, incoming)
+	if !isAbove {
+		return reflectance
+	}
+
+	// f = D * G * F / (4 * (n.o) * (n.i))
+	specularFactor := ggx.findDistribution(halfVectorCosine) * ggx.findMaskingShadowing(outgoingCosine) *
+		ggx.findMaskingShadowing(incomingCosine) / (4 * outgoingCosine * incomingCosine)
+	fresnel := ggx.findFresnel(outgoingHalfVectorCosine)
+	for colorIndex := 0; colorIndex < 3; colorIndex++ {
+		reflectance[colorIndex] = fresnel[colorIndex] * specularFactor
+	}
+	return reflectance
+}
+
+// Pdf finds the probability density of sampling an incoming direction, by solid angle.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	The probability density.
+//
+func (ggx *GGX) Pdf(normal, outgoing, incoming *vector.Vector) float64 {
+	_, _, halfVectorCosine, outgoingHalfVectorCosine, isAbove := ggx.findCosines(normal, outgoing, incoming)
+	if !isAbove || outgoingHalfVectorCosine <= 0 || halfVectorCosine <= 0 {
+		return 0
+	}
+
+	// pdf = D * (n.h) / (4 * (o.h))
+	return ggx.findDistribution(halfVectorCosine) * halfVectorCosine / (4 * outgoingHalfVectorCosine)
+}
+
+// IsDelta checks if the material only scatters in discrete directions.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	false.
+//
+func (*GGX) IsDelta() bool {
+	return false
+}
+
+// InitGGX initializes a GGX.
+//
+// Parameters:
+// 	color     - The RGB reflectance at normal incidence.
+// 	roughness - The perceptual roughness, in the interval (0,1].
+//
+// Returns:
+// 	A GGX.
+// 	An error.
+//
+func InitGGX(color []float64, roughness float64) (*GGX, error) {
+	controller := &Controller{}
+	if err := controller.validateColor(color); err != nil {
+		return nil, err
+	}
+	if roughness <= 0 || roughness > 1 {
+		return nil, invalidRoughnessError(roughness)
+	}
+	return &GGX{color: color, roughness: roughness}, nil
+}
@@ -0,0 +1,113 @@
+package material
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// TestGGX_Init tests the instantiation of a GGX.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestGGX_Init(t *testing.T) {
+	color := []float64{0.5, 0.25, 1}
+	roughness := 0.3
+	ggx, err := InitGGX(color, roughness)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(color, ggx.GetColor()))
+	test_helpers.AssertEqual(t, roughness, ggx.GetRoughness())
+	test_helpers.AssertEqual(t, false, ggx.IsDelta())
+}
+
+// TestGGX_Init_InvalidRoughnessError tests the instantiation of a GGX with a roughness out of the bounds.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestGGX_Init_InvalidRoughnessError(t *testing.T) {
+	for _, roughness := range []float64{0, 1.5} {
+		expectedErrorMessage := fmt.Sprintf("The roughness must be in the interval (0,1]: %v.", roughness)
+		_, err := InitGGX([]float64{0.5, 0.25, 1}, roughness)
+		test_helpers.AssertNotNilError(t, err)
+		test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+	}
+}
+
+// TestGGX_Evaluate tests the evaluation of the reflectance function of a GGX.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestGGX_Evaluate(t *testing.T) {
+	ggx, err := InitGGX([]float64{1, 1, 1}, 0.3)
+	test_helpers.AssertNilError(t, err)
+	normal := buildTestVector(t, []float64{0, 0, 1})
+	outgoing := buildTestVector(t, []float64{math.Sqrt2 / 2, 0, math.Sqrt2 / 2})
+	mirrorDirection := buildTestVector(t, []float64{-math.Sqrt2 / 2, 0, math.Sqrt2 / 2})
+	otherDirection := buildTestVector(t, []float64{0, 0, 1})
+
+	test_helpers.AssertEqual(t, true, ggx.Evaluate(normal, outgoing, mirrorDirection)[0] >
+		ggx.Evaluate(normal, outgoing, otherDirection)[0])
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0, 0, 0},
+		ggx.Evaluate(normal, outgoing, buildTestVector(t, []float64{0, 0, -1}))))
+	test_helpers.AssertEqual(t, 0.0, ggx.Pdf(normal, outgoing, buildTestVector(t, []float64{0, 0, -1})))
+}
+
+// TestGGX_Evaluate_Smooth tests that a GGX too smooth for the precision of its distribution has finite densities
+// around the mirror direction.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestGGX_Evaluate_Smooth(t *testing.T) {
+	ggx, err := InitGGX([]float64{1, 1, 1}, 0.00001)
+	test_helpers.AssertNilError(t, err)
+	normal := buildTestVector(t, []float64{0, 0, 1})
+	sample, isSampled := ggx.SampleDirection(normal, normal, 0.5, 0.5)
+	test_helpers.AssertEqual(t, true, isSampled)
+	test_helpers.AssertEqual(t, false, math.IsInf(sample.GetPdf(), 0) || math.IsNaN(sample.GetPdf()))
+	reflectance := ggx.Evaluate(normal, normal, normal)
+	test_helpers.AssertEqual(t, false, math.IsInf(reflectance[0], 0) || math.IsNaN(reflectance[0]))
+}
+
+// TestGGX_SampleDirection tests the directions sampled from a GGX, whose albedo decreases with the roughness because
+// only single scattering is modeled.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestGGX_SampleDirection(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(1))
+	previousAlbedo := 1.01
+	for _, roughness := range []float64{0.1, 0.5, 1} {
+		ggx, err := InitGGX([]float64{1, 1, 1}, roughness)
+		test_helpers.AssertNilError(t, err)
+		normal := buildTestVector(t, []float64{0, 0, 1})
+		outgoing := buildTestVector(t, []float64{0.6, 0, 0.8})
+
+		assertSampleConsistency(t, ggx, normal, outgoing, randomGenerator)
+		albedo := estimateAlbedo(ggx, normal, outgoing, randomGenerator)
+		test_helpers.AssertEqual(t, true, albedo[0] > 0.2 && albedo[0] <= previousAlbedo)
+		previousAlbedo = albedo[0]
+	}
+}
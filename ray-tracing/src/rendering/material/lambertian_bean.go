@@ -0,0 +1,114 @@
+package material
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"math"
+)
+
+// Lambertian is a class for perfectly diffuse materials.
+//
+// Members:
+// 	color - The RGB reflectance.
+//
+type Lambertian struct {
+	color []float64
+}
+
+// GetColor gets the RGB reflectance.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The RGB reflectance.
+//
+func (lambertian *Lambertian) GetColor() []float64 {
+	return lambertian.color
+}
+
+// SampleDirection samples an incoming direction with density proportional to the cosine with the normal.
+//
+// Parameters:
+// 	normal       - The normalized normal of the surface.
+// 	outgoing     - The normalized direction towards the viewer.
+// 	firstSample  - A random number in [0,1).
+// 	secondSample - A random number in [0,1).
+//
+// Returns:
+// 	The Sample.
+// 	If a direction could be sampled.
+//
+func (lambertian *Lambertian) SampleDirection(normal, outgoing *vector.Vector, firstSample,
+	secondSample float64) (*Sample, bool) {
+	controller := &Controller{}
+	facingNormal := controller.FaceForward(normal, outgoing)
+	incoming := controller.SampleCosineWeightedHemisphere(facingNormal, firstSample, secondSample)
+
+	pdf := lambertian.Pdf(normal, outgoing, incoming)
+	if pdf <= 0 {
+		return nil, false
+	}
+	return initSample(incoming, lambertian.GetColor(), pdf, false), true
+}
+
+// Evaluate evaluates the RGB reflectance function for a pair of directions.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	The RGB value of the reflectance function.
+//
+func (lambertian *Lambertian) Evaluate(normal, outgoing, incoming *vector.Vector) []float64 {
+	controller := &Controller{}
+	if lambertian.Pdf(normal, outgoing, incoming) <= 0 {
+		return make([]float64, 3)
+	}
+	return controller.scaleColor(lambertian.GetColor(), 1/math.Pi)
+}
+
+// Pdf finds the probability density of sampling an incoming direction, by solid angle.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	The probability density.
+//
+func (*Lambertian) Pdf(normal, outgoing, incoming *vector.Vector) float64 {
+	controller := &Controller{}
+	return controller.CosineWeightedHemispherePdf(controller.FaceForward(normal, outgoing), incoming)
+}
+
+// IsDelta checks if the material only scatters in discrete directions.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	false.
+//
+func (*Lambertian) IsDelta() bool {
+	return false
+}
+
+// InitLambertian initializes a Lambertian.
+//
+// Parameters:
+// 	color - The RGB reflectance.
+//
+// Returns:
+// 	A Lambertian.
+// 	An error.
+//
+func InitLambertian(color []float64) (*Lambertian, error) {
+	controller := &Controller{}
+	if err := controller.validateColor(color); err != nil {
+		return nil, err
+	}
+	return &Lambertian{color: color}, nil
+}
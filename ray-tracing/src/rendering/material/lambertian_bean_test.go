@@ -0,0 +1,104 @@
+package material
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/math_helper"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// TestLambertian_Init tests the instantiation of a Lambertian.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestLambertian_Init(t *testing.T) {
+	color := []float64{0.5, 0.25, 1}
+	lambertian, err := InitLambertian(color)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(color, lambertian.GetColor()))
+	test_helpers.AssertEqual(t, false, lambertian.IsDelta())
+}
+
+// TestLambertian_Init_NonRGBColorError tests the instantiation of a Lambertian with a color without 3 values.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestLambertian_Init_NonRGBColorError(t *testing.T) {
+	color := []float64{0.5, 0.25}
+	expectedErrorMessage := fmt.Sprintf("There are not 3 color values: %d.", len(color))
+	_, err := InitLambertian(color)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestLambertian_Init_ColorOutOfBoundsError tests the instantiation of a Lambertian with a color out of the bounds.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestLambertian_Init_ColorOutOfBoundsError(t *testing.T) {
+	color := []float64{0.5, 1.25, 0}
+	expectedErrorMessage := fmt.Sprintf("Color values out of interval [0,1]: %v.", color)
+	_, err := InitLambertian(color)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestLambertian_Evaluate tests the evaluation of the reflectance function of a Lambertian.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestLambertian_Evaluate(t *testing.T) {
+	lambertian, err := InitLambertian([]float64{0.5, 0.25, 1})
+	test_helpers.AssertNilError(t, err)
+	normal := buildTestVector(t, []float64{0, 0, 1})
+	outgoing := buildTestVector(t, []float64{0, 0, 1})
+
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0.5 / math.Pi, 0.25 / math.Pi, 1 / math.Pi},
+		lambertian.Evaluate(normal, outgoing, buildTestVector(t, []float64{1, 0, 0.1}))))
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0, 0, 0},
+		lambertian.Evaluate(normal, outgoing, buildTestVector(t, []float64{1, 0, -0.1}))))
+	test_helpers.AssertEqual(t, 0.0, lambertian.Pdf(normal, outgoing, buildTestVector(t, []float64{1, 0, -0.1})))
+}
+
+// TestLambertian_SampleDirection tests the directions sampled from a Lambertian.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestLambertian_SampleDirection(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(1))
+	mathHelper := math_helper.Init(0.01)
+	color := []float64{0.5, 0.25, 1}
+	lambertian, err := InitLambertian(color)
+	test_helpers.AssertNilError(t, err)
+	normal := buildTestVector(t, []float64{0, 0, 1})
+	outgoing := buildTestVector(t, []float64{0.6, 0, -0.8})
+
+	assertSampleConsistency(t, lambertian, normal, outgoing, randomGenerator)
+	albedo := estimateAlbedo(lambertian, normal, outgoing, randomGenerator)
+	for colorIndex := 0; colorIndex < 3; colorIndex++ {
+		test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(color[colorIndex], albedo[colorIndex]))
+	}
+}
@@ -0,0 +1,65 @@
+package material
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+)
+
+// Material is the interface for the reflection models of the surfaces of the objects.
+// All directions point away from the surface and the normal is the one of the object, so materials that only reflect
+// use the side of the outgoing direction.
+//
+// Methods:
+// 	SampleDirection - Samples an incoming direction for an outgoing direction.
+// 	Evaluate        - Evaluates the RGB reflectance function for a pair of directions.
+// 	Pdf             - Finds the probability density of sampling an incoming direction, by solid angle.
+// 	IsDelta         - Checks if the material only scatters in discrete directions.
+//
+type Material interface {
+	// SampleDirection samples an incoming direction for an outgoing direction.
+	//
+	// Parameters:
+	// 	normal       - The normalized normal of the surface.
+	// 	outgoing     - The normalized direction towards the viewer.
+	// 	firstSample  - A random number in [0,1).
+	// 	secondSample - A random number in [0,1).
+	//
+	// Returns:
+	// 	The Sample.
+	// 	If a direction could be sampled.
+	//
+	SampleDirection(normal, outgoing *vector.Vector, firstSample, secondSample float64) (*Sample, bool)
+
+	// Evaluate evaluates the RGB reflectance function for a pair of directions.
+	//
+	// Parameters:
+	// 	normal   - The normalized normal of the surface.
+	// 	outgoing - The normalized direction towards the viewer.
+	// 	incoming - The normalized direction towards the light.
+	//
+	// Returns:
+	// 	The RGB value of the reflectance function.
+	//
+	Evaluate(normal, outgoing, incoming *vector.Vector) []float64
+
+	// Pdf finds the probability density of sampling an incoming direction, by solid angle.
+	//
+	// Parameters:
+	// 	normal   - The normalized normal of the surface.
+	// 	outgoing - The normalized direction towards the viewer.
+	// 	incoming - The normalized direction towards the light.
+	//
+	// Returns:
+	// 	The probability density.
+	//
+	Pdf(normal, outgoing, incoming *vector.Vector) float64
+
+	// IsDelta checks if the material only scatters in discrete directions, in which case Evaluate and Pdf are null.
+	//
+	// Parameters:
+	// 	none
+	//
+	// Returns:
+	// 	If the material is a delta distribution.
+	//
+	IsDelta() bool
+}
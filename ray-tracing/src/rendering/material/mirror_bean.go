@@ -0,0 +1,105 @@
+package material
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+)
+
+// Mirror is a class for perfect mirror materials.
+//
+// Members:
+// 	color - The RGB reflectance.
+//
+type Mirror struct {
+	color []float64
+}
+
+// GetColor gets the RGB reflectance.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The RGB reflectance.
+//
+func (mirror *Mirror) GetColor() []float64 {
+	return mirror.color
+}
+
+// SampleDirection finds the mirror reflection of the outgoing direction.
+//
+// Parameters:
+// 	normal       - The normalized normal of the surface.
+// 	outgoing     - The normalized direction towards the viewer.
+// 	firstSample  - Unused.
+// 	secondSample - Unused.
+//
+// Returns:
+// 	The Sample.
+// 	If a direction could be sampled.
+//
+func (mirror *Mirror) SampleDirection(normal, outgoing *vector.Vector, _, _ float64) (*Sample, bool) {
+	controller := &Controller{}
+	vectorController := &vector.Controller{}
+	facingNormal := controller.FaceForward(normal, outgoing)
+	incoming := controller.FindMirrorReflectionVector(vectorController.ScalarMultiplication(outgoing, -1),
+		facingNormal)
+	return initSample(incoming, mirror.GetColor(), 1, true), true
+}
+
+// Evaluate evaluates the RGB reflectance function, which is null outside of the mirror direction.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	A black color.
+//
+func (*Mirror) Evaluate(_, _, _ *vector.Vector) []float64 {
+	return make([]float64, 3)
+}
+
+// Pdf finds the probability density of sampling an incoming direction, which is null outside of the mirror
+// direction.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	0.
+//
+func (*Mirror) Pdf(_, _, _ *vector.Vector) float64 {
+	return 0
+}
+
+// IsDelta checks if the material only scatters in discrete directions.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	true.
+//
+func (*Mirror) IsDelta() bool {
+	return true
+}
+
+// InitMirror initializes a Mirror.
+//
+// Parameters:
+// 	color - The RGB reflectance.
+//
+// Returns:
+// 	A Mirror.
+// 	An error.
+//
+func InitMirror(color []float64) (*Mirror, error) {
+	controller := &Controller{}
+	if err := controller.validateColor(color); err != nil {
+		return nil, err
+	}
+	return &Mirror{color: color}, nil
+}
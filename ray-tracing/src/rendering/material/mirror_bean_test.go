@@ -0,0 +1,51 @@
+package material
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// TestMirror_Init tests the instantiation of a Mirror.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMirror_Init(t *testing.T) {
+	color := []float64{0.5, 0.25, 1}
+	mirror, err := InitMirror(color)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(color, mirror.GetColor()))
+	test_helpers.AssertEqual(t, true, mirror.IsDelta())
+}
+
+// TestMirror_SampleDirection tests the direction sampled from a Mirror.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMirror_SampleDirection(t *testing.T) {
+	color := []float64{0.5, 0.25, 1}
+	mirror, err := InitMirror(color)
+	test_helpers.AssertNilError(t, err)
+	normal := buildTestVector(t, []float64{0, 0, 1})
+	outgoing := buildTestVector(t, []float64{math.Sqrt2 / 2, 0, math.Sqrt2 / 2})
+
+	sample, isSampled := mirror.SampleDirection(normal, outgoing, 0.3, 0.7)
+	test_helpers.AssertEqual(t, true, isSampled)
+	test_helpers.AssertEqual(t, true, sample.IsDelta())
+	test_helpers.AssertEqual(t, 1.0, sample.GetPdf())
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(color, sample.GetWeight()))
+	assertVectorCoordinates(t, []float64{-math.Sqrt2 / 2, 0, math.Sqrt2 / 2}, sample.GetDirection())
+
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0, 0, 0},
+		mirror.Evaluate(normal, outgoing, sample.GetDirection())))
+	test_helpers.AssertEqual(t, 0.0, mirror.Pdf(normal, outgoing, sample.GetDirection()))
+}
@@ -0,0 +1,186 @@
+package material
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"math"
+)
+
+// mixtureWeightsTolerance is the tolerance for the sum of the weights of a Mixture.
+const mixtureWeightsTolerance = 0.0000001
+
+// Mixture is a class for a weighted combination of materials, such as a diffuse base with a specular coating.
+//
+// Members:
+// 	components - The combined materials.
+// 	weights    - The weights of the materials, which sum 1.
+//
+type Mixture struct {
+	components []Material
+	weights    []float64
+}
+
+// GetComponents gets the combined materials.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The combined materials.
+//
+func (mixture *Mixture) GetComponents() []Material {
+	return mixture.components
+}
+
+// GetWeights gets the weights of the materials.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The weights.
+//
+func (mixture *Mixture) GetWeights() []float64 {
+	return mixture.weights
+}
+
+// SampleDirection chooses a material with its weight as probability and samples it.
+// Delta materials keep their own weight, while the others are weighted by the combined reflectance function and
+// probability density of all non delta materials.
+//
+// Parameters:
+// 	normal       - The normalized normal of the surface.
+// 	outgoing     - The normalized direction towards the viewer.
+// 	firstSample  - A random number in [0,1), used both to choose the material and to sample it.
+// 	secondSample - A random number in [0,1).
+//
+// Returns:
+// 	The Sample.
+// 	If a direction could be sampled.
+//
+func (mixture *Mixture) SampleDirection(normal, outgoing *vector.Vector, firstSample,
+	secondSample float64) (*Sample, bool) {
+	componentIndex := -1
+	cumulativeWeight := 0.0
+	selectedCumulativeWeight := 0.0
+	for index, weight := range mixture.GetWeights() {
+		if weight > 0 {
+			// The last material with weight is kept in case the weights sum slightly less than 1.
+			componentIndex = index
+			selectedCumulativeWeight = cumulativeWeight
+			if firstSample < cumulativeWeight+weight {
+				break
+			}
+		}
+		cumulativeWeight += weight
+	}
+	if componentIndex == -1 {
+		return nil, false
+	}
+
+	selectedWeight := mixture.GetWeights()[componentIndex]
+	remappedSample := math.Min(math.Max(0, firstSample-selectedCumulativeWeight)/selectedWeight, math.Nextafter(1, 0))
+	componentSample, isSampled := mixture.GetComponents()[componentIndex].SampleDirection(normal, outgoing,
+		remappedSample, secondSample)
+	if !isSampled {
+		return nil, false
+	}
+	if componentSample.IsDelta() {
+		return initSample(componentSample.GetDirection(), componentSample.GetWeight(),
+			selectedWeight*componentSample.GetPdf(), true), true
+	}
+
+	pdf := mixture.Pdf(normal, outgoing, componentSample.GetDirection())
+	if pdf <= 0 {
+		return nil, false
+	}
+	controller := &Controller{}
+	vectorController := &vector.Controller{}
+	facingNormal := controller.FaceForward(normal, outgoing)
+	incomingCosine, _ := vectorController.DotProduct(facingNormal, componentSample.GetDirection())
+	weight := controller.scaleColor(mixture.Evaluate(normal, outgoing, componentSample.GetDirection()),
+		math.Abs(incomingCosine)/pdf)
+	return initSample(componentSample.GetDirection(), weight, pdf, false), true
+}
+
+// Evaluate evaluates the weighted sum of the reflectance functions of the materials.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	The RGB value of the reflectance function.
+//
+func (mixture *Mixture) Evaluate(normal, outgoing, incoming *vector.Vector) []float64 {
+	reflectance := make([]float64, 3)
+	for index, component := range mixture.GetComponents() {
+		componentReflectance := component.Evaluate(normal, outgoing, incoming)
+		for colorIndex := 0; colorIndex < 3; colorIndex++ {
+			reflectance[colorIndex] += mixture.GetWeights()[index] * componentReflectance[colorIndex]
+		}
+	}
+	return reflectance
+}
+
+// Pdf finds the weighted sum of the probability densities of the materials.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	The probability density.
+//
+func (mixture *Mixture) Pdf(normal, outgoing, incoming *vector.Vector) float64 {
+	pdf := 0.0
+	for index, component := range mixture.GetComponents() {
+		pdf += mixture.GetWeights()[index] * component.Pdf(normal, outgoing, incoming)
+	}
+	return pdf
+}
+
+// IsDelta checks if all the materials with weight only scatter in discrete directions.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	If the mixture is a delta distribution.
+//
+func (mixture *Mixture) IsDelta() bool {
+	for index, component := range mixture.GetComponents() {
+		if mixture.GetWeights()[index] > 0 && !component.IsDelta() {
+			return false
+		}
+	}
+	return true
+}
+
+// InitMixture initializes a Mixture.
+//
+// Parameters:
+// 	components - The combined materials.
+// 	weights    - The weights of the materials, which must sum 1.
+//
+// Returns:
+// 	A Mixture.
+// 	An error.
+//
+func InitMixture(components []Material, weights []float64) (*Mixture, error) {
+	if len(components) == 0 || len(components) != len(weights) {
+		return nil, invalidMixtureError(len(components), weights)
+	}
+	sumOfWeights := 0.0
+	for _, weight := range weights {
+		if weight < 0 {
+			return nil, invalidMixtureError(len(components), weights)
+		}
+		sumOfWeights += weight
+	}
+	if math.Abs(sumOfWeights-1) > mixtureWeightsTolerance {
+		return nil, invalidMixtureError(len(components), weights)
+	}
+	return &Mixture{components: components, weights: weights}, nil
+}
@@ -0,0 +1,123 @@
+package material
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/math_helper"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// TestMixture_Init tests the instantiation of a Mixture.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMixture_Init(t *testing.T) {
+	lambertian, err := InitLambertian([]float64{1, 1, 1})
+	test_helpers.AssertNilError(t, err)
+	mirror, err := InitMirror([]float64{1, 1, 1})
+	test_helpers.AssertNilError(t, err)
+	components := []Material{lambertian, mirror}
+	weights := []float64{0.25, 0.75}
+
+	mixture, err := InitMixture(components, weights)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(components, mixture.GetComponents()))
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(weights, mixture.GetWeights()))
+	test_helpers.AssertEqual(t, false, mixture.IsDelta())
+
+	deltaMixture, err := InitMixture(components, []float64{0, 1})
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, deltaMixture.IsDelta())
+}
+
+// TestMixture_Init_InvalidMixtureError tests the instantiation of a Mixture with invalid weights.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMixture_Init_InvalidMixtureError(t *testing.T) {
+	lambertian, err := InitLambertian([]float64{1, 1, 1})
+	test_helpers.AssertNilError(t, err)
+	components := []Material{lambertian, lambertian}
+
+	for _, weights := range [][]float64{{1}, {0.5, 0.25}, {1.5, -0.5}} {
+		expectedErrorMessage := fmt.Sprintf("Invalid mixture of %d materials with weights %v. The weights must be "+
+			"one per material, not negative and sum 1.", len(components), weights)
+		_, err = InitMixture(components, weights)
+		test_helpers.AssertNotNilError(t, err)
+		test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+	}
+}
+
+// TestMixture_SampleDirection tests the directions sampled from a Mixture of non delta materials.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMixture_SampleDirection(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(1))
+	mathHelper := math_helper.Init(0.01)
+	lambertian, err := InitLambertian([]float64{0.5, 0.5, 0.5})
+	test_helpers.AssertNilError(t, err)
+	phong, err := InitPhong([]float64{1, 1, 1}, 5)
+	test_helpers.AssertNilError(t, err)
+	mixture, err := InitMixture([]Material{lambertian, phong}, []float64{0.5, 0.5})
+	test_helpers.AssertNilError(t, err)
+	normal := buildTestVector(t, []float64{0, 0, 1})
+	outgoing := buildTestVector(t, []float64{0.6, 0, 0.8})
+
+	assertSampleConsistency(t, mixture, normal, outgoing, randomGenerator)
+
+	expectedAlbedo := 0.5*estimateAlbedo(lambertian, normal, outgoing, randomGenerator)[0] +
+		0.5*estimateAlbedo(phong, normal, outgoing, randomGenerator)[0]
+	albedo := estimateAlbedo(mixture, normal, outgoing, randomGenerator)
+	test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(expectedAlbedo, albedo[0]))
+}
+
+// TestMixture_SampleDirection_Delta tests the directions sampled from a Mixture with a delta material.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMixture_SampleDirection_Delta(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(1))
+	mathHelper := math_helper.Init(0.01)
+	lambertian, err := InitLambertian([]float64{1, 1, 1})
+	test_helpers.AssertNilError(t, err)
+	mirror, err := InitMirror([]float64{0.5, 0.5, 0.5})
+	test_helpers.AssertNilError(t, err)
+	mixture, err := InitMixture([]Material{lambertian, mirror}, []float64{0.25, 0.75})
+	test_helpers.AssertNilError(t, err)
+	normal := buildTestVector(t, []float64{0, 0, 1})
+	outgoing := buildTestVector(t, []float64{0.6, 0, 0.8})
+
+	mirrorSample, isSampled := mixture.SampleDirection(normal, outgoing, 0.5, 0.5)
+	test_helpers.AssertEqual(t, true, isSampled)
+	test_helpers.AssertEqual(t, true, mirrorSample.IsDelta())
+	test_helpers.AssertEqual(t, 0.75, mirrorSample.GetPdf())
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(mirror.GetColor(), mirrorSample.GetWeight()))
+	assertVectorCoordinates(t, []float64{-0.6, 0, 0.8}, mirrorSample.GetDirection())
+
+	diffuseSample, isSampled := mixture.SampleDirection(normal, outgoing, 0.1, 0.5)
+	test_helpers.AssertEqual(t, true, isSampled)
+	test_helpers.AssertEqual(t, false, diffuseSample.IsDelta())
+
+	// The estimator is weighted by the selection probabilities: 0.25 * 1 + 0.75 * 0.5.
+	albedo := estimateAlbedo(mixture, normal, outgoing, randomGenerator)
+	test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(0.25*1+0.75*0.5, albedo[0]))
+}
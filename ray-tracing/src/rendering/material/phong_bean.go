@@ -0,0 +1,174 @@
+package material
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"math"
+)
+
+// Phong is a class for glossy materials following the energy normalized Phong model.
+//
+// Members:
+// 	color    - The RGB reflectance.
+// 	exponent - The specular exponent, where higher values give sharper reflections.
+//
+type Phong struct {
+	color    []float64
+	exponent float64
+}
+
+// GetColor gets the RGB reflectance.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The RGB reflectance.
+//
+func (phong *Phong) GetColor() []float64 {
+	return phong.color
+}
+
+// GetExponent gets the specular exponent.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The specular exponent.
+//
+func (phong *Phong) GetExponent() float64 {
+	return phong.exponent
+}
+
+// findReflectionCosine finds the cosine between the incoming direction and the mirror reflection of the outgoing one.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	The cosine, or 0 if the incoming direction is not on the side of the outgoing one.
+//
+func (*Phong) findReflectionCosine(normal, outgoing, incoming *vector.Vector) float64 {
+	controller := &Controller{}
+	vectorController := &vector.Controller{}
+	facingNormal := controller.FaceForward(normal, outgoing)
+	incomingCosine, _ := vectorController.DotProduct(facingNormal, incoming)
+	if incomingCosine <= 0 {
+		return 0
+	}
+	reflectedOutgoing := controller.FindMirrorReflectionVector(vectorController.ScalarMultiplication(outgoing, -1),
+		facingNormal)
+	reflectionCosine, _ := vectorController.DotProduct(reflectedOutgoing, incoming)
+	return math.Max(0, reflectionCosine)
+}
+
+// SampleDirection samples an incoming direction around the mirror reflection of the outgoing one.
+//
+// Parameters:
+// 	normal       - The normalized normal of the surface.
+// 	outgoing     - The normalized direction towards the viewer.
+// 	firstSample  - A random number in [0,1).
+// 	secondSample - A random number in [0,1).
+//
+// Returns:
+// 	The Sample.
+// 	If a direction could be sampled.
+//
+func (phong *Phong) SampleDirection(normal, outgoing *vector.Vector, firstSample,
+	secondSample float64) (*Sample, bool) {
+	controller := &Controller{}
+	vectorController := &vector.Controller{}
+	facingNormal := controller.FaceForward(normal, outgoing)
+	reflectedOutgoing := controller.FindMirrorReflectionVector(vectorController.ScalarMultiplication(outgoing, -1),
+		facingNormal)
+
+	lobeCosine := math.Pow(1-firstSample, 1/(phong.GetExponent()+1))
+	lobeSine := math.Sqrt(math.Max(0, 1-lobeCosine*lobeCosine))
+	angle := 2 * math.Pi * secondSample
+	incoming := controller.toWorldCoordinates(
+		[]float64{lobeSine * math.Cos(angle), lobeSine * math.Sin(angle), lobeCosine}, reflectedOutgoing)
+
+	incomingCosine, _ := vectorController.DotProduct(facingNormal, incoming)
+	pdf := phong.Pdf(normal, outgoing, incoming)
+	if incomingCosine <= 0 || pdf <= 0 {
+		return nil, false
+	}
+
+	// f * cos / pdf = color * (n + 2) / (n + 1) * cos
+	weight := controller.scaleColor(phong.GetColor(),
+		(phong.GetExponent()+2)/(phong.GetExponent()+1)*incomingCosine)
+	return initSample(incoming, weight, pdf, false), true
+}
+
+// Evaluate evaluates the RGB reflectance function for a pair of directions.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	The RGB value of the reflectance function.
+//
+func (phong *Phong) Evaluate(normal, outgoing, incoming *vector.Vector) []float64 {
+	controller := &Controller{}
+	reflectionCosine := phong.findReflectionCosine(normal, outgoing, incoming)
+	if reflectionCosine <= 0 {
+		return make([]float64, 3)
+	}
+	return controller.scaleColor(phong.GetColor(),
+		(phong.GetExponent()+2)/(2*math.Pi)*math.Pow(reflectionCosine, phong.GetExponent()))
+}
+
+// Pdf finds the probability density of sampling an incoming direction, by solid angle.
+//
+// Parameters:
+// 	normal   - The normalized normal of the surface.
+// 	outgoing - The normalized direction towards the viewer.
+// 	incoming - The normalized direction towards the light.
+//
+// Returns:
+// 	The probability density.
+//
+func (phong *Phong) Pdf(normal, outgoing, incoming *vector.Vector) float64 {
+	reflectionCosine := phong.findReflectionCosine(normal, outgoing, incoming)
+	if reflectionCosine <= 0 {
+		return 0
+	}
+	return (phong.GetExponent() + 1) / (2 * math.Pi) * math.Pow(reflectionCosine, phong.GetExponent())
+}
+
+// IsDelta checks if the material only scatters in discrete directions.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	false.
+//
+func (*Phong) IsDelta() bool {
+	return false
+}
+
+// InitPhong initializes a Phong.
+//
+// Parameters:
+// 	color    - The RGB reflectance.
+// 	exponent - The specular exponent, where higher values give sharper reflections.
+//
+// Returns:
+// 	A Phong.
+// 	An error.
+//
+func InitPhong(color []float64, exponent float64) (*Phong, error) {
+	controller := &Controller{}
+	if err := controller.validateColor(color); err != nil {
+		return nil, err
+	}
+	if exponent < 0 {
+		return nil, invalidExponentError(exponent)
+	}
+	return &Phong{color: color, exponent: exponent}, nil
+}
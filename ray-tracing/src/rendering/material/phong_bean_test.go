@@ -0,0 +1,87 @@
+package material
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// TestPhong_Init tests the instantiation of a Phong.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestPhong_Init(t *testing.T) {
+	color := []float64{0.5, 0.25, 1}
+	exponent := 20.0
+	phong, err := InitPhong(color, exponent)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(color, phong.GetColor()))
+	test_helpers.AssertEqual(t, exponent, phong.GetExponent())
+	test_helpers.AssertEqual(t, false, phong.IsDelta())
+}
+
+// TestPhong_Init_InvalidExponentError tests the instantiation of a Phong with a negative exponent.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestPhong_Init_InvalidExponentError(t *testing.T) {
+	exponent := -1.0
+	expectedErrorMessage := fmt.Sprintf("The Phong exponent must not be negative: %v.", exponent)
+	_, err := InitPhong([]float64{0.5, 0.25, 1}, exponent)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestPhong_Evaluate tests the evaluation of the reflectance function of a Phong.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestPhong_Evaluate(t *testing.T) {
+	phong, err := InitPhong([]float64{1, 1, 1}, 10)
+	test_helpers.AssertNilError(t, err)
+	normal := buildTestVector(t, []float64{0, 0, 1})
+	outgoing := buildTestVector(t, []float64{math.Sqrt2 / 2, 0, math.Sqrt2 / 2})
+	mirrorDirection := buildTestVector(t, []float64{-math.Sqrt2 / 2, 0, math.Sqrt2 / 2})
+
+	reflectance := phong.Evaluate(normal, outgoing, mirrorDirection)
+	test_helpers.AssertEqual(t, true, math.Abs(12/(2*math.Pi)-reflectance[0]) < 0.0000001)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0, 0, 0},
+		phong.Evaluate(normal, outgoing, buildTestVector(t, []float64{0, 0, -1}))))
+	test_helpers.AssertEqual(t, true, phong.Pdf(normal, outgoing, mirrorDirection) >
+		phong.Pdf(normal, outgoing, buildTestVector(t, []float64{0, 0, 1})))
+}
+
+// TestPhong_SampleDirection tests the directions sampled from a Phong.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestPhong_SampleDirection(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(1))
+	phong, err := InitPhong([]float64{1, 1, 1}, 10)
+	test_helpers.AssertNilError(t, err)
+	normal := buildTestVector(t, []float64{0, 0, 1})
+	outgoing := buildTestVector(t, []float64{0.6, 0, 0.8})
+
+	assertSampleConsistency(t, phong, normal, outgoing, randomGenerator)
+	albedo := estimateAlbedo(phong, normal, outgoing, randomGenerator)
+	test_helpers.AssertEqual(t, true, albedo[0] > 0.5 && albedo[0] <= 1)
+}
@@ -0,0 +1,83 @@
+package material
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+)
+
+// Sample is a class for a direction sampled from a Material.
+//
+// Members:
+// 	direction - The normalized incoming direction.
+// 	weight    - The RGB reflectance function times the cosine with the normal divided by the probability density.
+// 	pdf       - The probability density of the direction, by solid angle, or its discrete probability if delta.
+// 	isDelta   - If the direction was sampled from a delta distribution.
+//
+type Sample struct {
+	direction *vector.Vector
+	weight    []float64
+	pdf       float64
+	isDelta   bool
+}
+
+// GetDirection gets the sampled direction.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The sampled direction.
+//
+func (sample *Sample) GetDirection() *vector.Vector {
+	return sample.direction
+}
+
+// GetWeight gets the RGB weight of the Sample.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The RGB weight.
+//
+func (sample *Sample) GetWeight() []float64 {
+	return sample.weight
+}
+
+// GetPdf gets the probability density of the Sample.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The probability density.
+//
+func (sample *Sample) GetPdf() float64 {
+	return sample.pdf
+}
+
+// IsDelta checks if the Sample comes from a delta distribution.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	If the Sample is delta.
+//
+func (sample *Sample) IsDelta() bool {
+	return sample.isDelta
+}
+
+// initSample initializes a Sample.
+//
+// Parameters:
+// 	direction - The normalized incoming direction.
+// 	weight    - The RGB reflectance function times the cosine with the normal divided by the probability density.
+// 	pdf       - The probability density of the direction, by solid angle, or its discrete probability if delta.
+// 	isDelta   - If the direction was sampled from a delta distribution.
+//
+// Returns:
+// 	A Sample.
+//
+func initSample(direction *vector.Vector, weight []float64, pdf float64, isDelta bool) *Sample {
+	return &Sample{direction: direction, weight: weight, pdf: pdf, isDelta: isDelta}
+}
@@ -1,8 +1,10 @@
 package object
 
 import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/material"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/point_repository"
 )
 
@@ -12,6 +14,7 @@ import (
 //  name                 - The name of the Object.
 // 	repository           - The point repository.
 // 	triangles            - The triangles that form the Object.
+// 	spheres              - The spheres that form the Object.
 //  normals              - The normals of the vertices.
 //  lightCharacteristics - The light characteristics of the Object.
 //
@@ -19,6 +22,7 @@ type Object struct {
 	name               string
 	repository         *point_repository.PointRepository
 	triangles          []*triangle.Triangle
+	spheres            []*sphere.Sphere
 	normals            []*vector.Vector
 	lightCharacteristics *lightCharacteristics
 }
@@ -59,6 +63,31 @@ func (object *Object) GetTriangles() []*triangle.Triangle {
 	return object.triangles
 }
 
+// GetSpheres gets the spheres of the Object.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The spheres of the Object.
+//
+func (object *Object) GetSpheres() []*sphere.Sphere {
+	return object.spheres
+}
+
+// NumberOfSurfaces gets the number of triangles plus the number of spheres of the Object.
+// The surfaces are indexed with the triangles first and then the spheres.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of surfaces.
+//
+func (object *Object) NumberOfSurfaces() int {
+	return len(object.GetTriangles()) + len(object.GetSpheres())
+}
+
 // GetNormals gets the normals of the vertices of the Object.
 //
 // Parameters:
@@ -100,6 +129,14 @@ func (object *Object) IsEqual(other *Object) bool {
 			return false
 		}
 	}
+	if len(object.GetSpheres()) != len(other.GetSpheres()) {
+		return false
+	}
+	for sphereIndex := 0; sphereIndex < len(object.GetSpheres()); sphereIndex++ {
+		if !object.GetSpheres()[sphereIndex].IsEqual(other.GetSpheres()[sphereIndex]) {
+			return false
+		}
+	}
 	if len(object.GetNormals()) != len(other.GetNormals()) {
 		return false
 	}
@@ -119,25 +156,34 @@ func (object *Object) IsEqual(other *Object) bool {
 //  name                   - The name of the Object.
 // 	repository             - The point repository.
 // 	triangles              - The triangles that form the Object.
+// 	spheres                - The spheres that form the Object.
 //  normals                - The normals of the vertices.
 //  color                  - RGB for the color of the object.
 //  specularReflection     - Percentage of specular rays.
 //  roughNess              - How much reflections rays get distorted.
 //  transmissionReflection - Percentage of transmission rays.
 //  diffuseReflection      - Percentage of diffuse rays.
+//  refractiveIndex        - The index of refraction of the material of the object.
+//  surfaceMaterial        - The reflection model of the object, replacing the percentages when not nil.
 //
 // Returns:
 // 	An Object.
 // 	An error.
 //
 func Init(name string, repository *point_repository.PointRepository, triangles []*triangle.Triangle,
-	normals []*vector.Vector, color []float64, specularReflection, roughNess, transmissionReflection,
-	diffuseReflection float64) (*Object, error) {
+	spheres []*sphere.Sphere, normals []*vector.Vector, color []float64, specularReflection, roughNess,
+	transmissionReflection, diffuseReflection, refractiveIndex float64, surfaceMaterial material.Material) (*Object,
+	error) {
 	characteristics, err := initLightCharacteristics(color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+		transmissionReflection, diffuseReflection, refractiveIndex, surfaceMaterial)
 	if err != nil {
 		return nil, err
 	}
-	return &Object{name: name, repository: repository, triangles: triangles, normals: normals,
+	for _, currentSphere := range spheres {
+		if currentSphere.GetRadius() <= 0 {
+			return nil, invalidSphereRadiusError(currentSphere.GetRadius())
+		}
+	}
+	return &Object{name: name, repository: repository, triangles: triangles, spheres: spheres, normals: normals,
 		lightCharacteristics: characteristics}, nil
 }
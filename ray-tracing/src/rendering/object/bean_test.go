@@ -3,6 +3,7 @@ package object
 import (
 	"fmt"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/point_repository"
@@ -83,14 +84,15 @@ func TestObject_Init(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.25
 	diffuseReflection := 0.25
+	refractiveIndex := 1.5
 
-	object, err := Init(name, repository, triangles, normals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	object, err := Init(name, repository, triangles, nil, normals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
 	expectedLightCharacteristics := &lightCharacteristics{color: color,
 		specularReflection: specularReflection, roughNess: roughNess, transmissionReflection: transmissionReflection,
-		diffuseReflection: diffuseReflection}
+		diffuseReflection: diffuseReflection, refractiveIndex: refractiveIndex}
 
 	expectedObject := &Object{
 		name: name, repository: repository, triangles: triangles, normals: normals,
@@ -119,15 +121,60 @@ func TestObject_Init_Error(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.25
 	diffuseReflection := 0.25
+	refractiveIndex := 1.5
 
 	expectedErrorMessage := fmt.Sprintf("There are not 3 color values: %d.", len(color))
 
-	_, err = Init(name, repository, triangles, normals, color, specularReflection, roughNess, transmissionReflection,
-		diffuseReflection)
+	_, err = Init(name, repository, triangles, nil, normals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNotNilError(t, err)
 	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
 }
 
+// TestObject_Init_InvalidSphereRadius tests the instantiation of an Object with a sphere without a positive radius.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestObject_Init_InvalidSphereRadius(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("The radius of a sphere must be positive: %v.", 0.0)
+
+	_, err := Init("my object", buildSamplePointRepository(t), []*triangle.Triangle{},
+		[]*sphere.Sphere{sphere.Init(0, 1), sphere.Init(1, 0)}, buildNormals(t), []float64{1, 1, 1}, 0, 0, 0, 1, 1,
+		nil)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestObject_IsEqual_DifferentSpheres tests the is equal of Objects with different spheres.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestObject_IsEqual_DifferentSpheres(t *testing.T) {
+	repository := buildSamplePointRepository(t)
+	firstObject, err := Init("my object", repository, []*triangle.Triangle{}, []*sphere.Sphere{sphere.Init(0, 1)},
+		buildNormals(t), []float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+	secondObject, err := Init("my object", repository, []*triangle.Triangle{}, []*sphere.Sphere{sphere.Init(0, 2)},
+		buildNormals(t), []float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+	thirdObject, err := Init("my object", repository, []*triangle.Triangle{}, []*sphere.Sphere{},
+		buildNormals(t), []float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+
+	test_helpers.AssertEqual(t, true, firstObject.IsEqual(firstObject))
+	test_helpers.AssertEqual(t, false, firstObject.IsEqual(secondObject))
+	test_helpers.AssertEqual(t, false, firstObject.IsEqual(thirdObject))
+	test_helpers.AssertEqual(t, 1, firstObject.NumberOfSurfaces())
+}
+
 // TestObject_IsEqual_DifferentTriangleLength tests the is equal of an Object.
 //
 // Parameters:
@@ -152,13 +199,14 @@ func TestObject_IsEqual_DifferentTriangleLength(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.25
 	diffuseReflection := 0.25
+	refractiveIndex := 1.5
 
-	firstObject, err := Init(name, repository, firstTriangles, normals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	firstObject, err := Init(name, repository, firstTriangles, nil, normals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
-	secondObject, err := Init(name, repository, otherTriangles, normals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	secondObject, err := Init(name, repository, otherTriangles, nil, normals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
 	test_helpers.AssertEqual(t, false, firstObject.IsEqual(secondObject))
@@ -190,13 +238,14 @@ func TestObject_IsEqual_DifferentNormalsLength(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.25
 	diffuseReflection := 0.25
+	refractiveIndex := 1.5
 
-	firstObject, err := Init(name, repository, firstTriangles, firstNormals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	firstObject, err := Init(name, repository, firstTriangles, nil, firstNormals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
-	secondObject, err := Init(name, repository, firstTriangles, otherNormals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	secondObject, err := Init(name, repository, firstTriangles, nil, otherNormals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
 	test_helpers.AssertEqual(t, false, firstObject.IsEqual(secondObject))
@@ -226,13 +275,14 @@ func TestObject_IsEqual_DifferentTriangles(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.25
 	diffuseReflection := 0.25
+	refractiveIndex := 1.5
 
-	firstObject, err := Init(name, repository, firstTriangles, normals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	firstObject, err := Init(name, repository, firstTriangles, nil, normals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
-	secondObject, err := Init(name, repository, otherTriangles, normals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	secondObject, err := Init(name, repository, otherTriangles, nil, normals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
 	test_helpers.AssertEqual(t, false, firstObject.IsEqual(secondObject))
@@ -265,13 +315,14 @@ func TestObject_IsEqual_DifferentNormals(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.25
 	diffuseReflection := 0.25
+	refractiveIndex := 1.5
 
-	firstObject, err := Init(name, repository, firstTriangles, firstNormals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	firstObject, err := Init(name, repository, firstTriangles, nil, firstNormals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
-	secondObject, err := Init(name, repository, firstTriangles, otherNormals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	secondObject, err := Init(name, repository, firstTriangles, nil, otherNormals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
 	test_helpers.AssertEqual(t, false, firstObject.IsEqual(secondObject))
@@ -1,6 +1,18 @@
 package object
 
-import "github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/material"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/point_repository"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
+	"math"
+)
+
+// uniformScaleTolerance is the relative tolerance for the axes of a transformation to be taken as equally scaled and
+// orthogonal.
+const uniformScaleTolerance = 1e-9
 
 // Controller is a class for controlling objects.
 //
@@ -58,5 +70,233 @@ func (*Controller) GetBoundingBox(object *Object) []float64 {
 			}
 		}
 	}
+	for _, currentSphere := range object.GetSpheres() {
+		center, err := repository.GetPoint(currentSphere.GetCenterPointIndex())
+		if err != nil {
+			continue
+		}
+		for coordinateIndex := 0; coordinateIndex < repository.PointsDimension(); coordinateIndex++ {
+			coordinateValue, _ := center.GetCoordinate(coordinateIndex)
+			if boundingBox[coordinateIndex] > coordinateValue-currentSphere.GetRadius() {
+				boundingBox[coordinateIndex] = coordinateValue - currentSphere.GetRadius()
+			}
+			if boundingBox[coordinateIndex+repository.PointsDimension()] < coordinateValue+currentSphere.GetRadius() {
+				boundingBox[coordinateIndex+repository.PointsDimension()] = coordinateValue + currentSphere.GetRadius()
+			}
+		}
+	}
 	return boundingBox
 }
+
+// GetSphereIndex is a function to find if a surface of an Object is a sphere.
+// The surfaces are indexed with the triangles first and then the spheres.
+//
+// Parameters:
+//  object       - The Object.
+//  surfaceIndex - The index of the surface on the Object.
+//
+// Returns:
+//  The index of the sphere on the Object.
+//  If the surface is a sphere.
+//
+func (*Controller) GetSphereIndex(object *Object, surfaceIndex int) (int, bool) {
+	sphereIndex := surfaceIndex - len(object.GetTriangles())
+	if sphereIndex < 0 || sphereIndex >= len(object.GetSpheres()) {
+		return -1, false
+	}
+	return sphereIndex, true
+}
+
+// GetSphereNormal is a function to get the analytic normal of a sphere of an Object at a point of its surface.
+//
+// Parameters:
+//  object       - The Object.
+//  sphereIndex  - The index of the sphere on the Object.
+//  surfacePoint - The point on the surface of the sphere.
+//
+// Returns:
+//  The normalized normal, pointing outwards.
+//  An error.
+//
+func (*Controller) GetSphereNormal(object *Object, sphereIndex int, surfacePoint *point.Point) (*vector.Vector,
+	error) {
+	currentSphere := object.GetSpheres()[sphereIndex]
+	center, err := object.GetRepository().GetPoint(currentSphere.GetCenterPointIndex())
+	if err != nil {
+		return nil, err
+	}
+	pointController := point.Controller{}
+	normal, err := pointController.ExtractVector(center, surfacePoint)
+	if err != nil {
+		return nil, err
+	}
+	vectorController := vector.Controller{}
+	return vectorController.Normalize(normal), nil
+}
+
+// GetMaterial is a function to get the reflection model of an Object.
+// Objects without a material get a mixture built from their percentages: a Lambertian for the diffuse rays, a mirror or
+// a Phong lobe whose exponent follows the roughness for the specular rays and a dielectric for the transmission rays.
+//
+// Parameters:
+//  object - The Object.
+//
+// Returns:
+//  The material of the Object.
+//  An error.
+//
+func (*Controller) GetMaterial(object *Object) (material.Material, error) {
+	characteristics := object.GetLightCharacteristics()
+	if characteristics.GetMaterial() != nil {
+		return characteristics.GetMaterial(), nil
+	}
+
+	var components []material.Material
+	var weights []float64
+	if characteristics.GetDiffuseReflection() > 0 {
+		lambertian, err := material.InitLambertian(characteristics.GetColor())
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, lambertian)
+		weights = append(weights, characteristics.GetDiffuseReflection())
+	}
+	if characteristics.GetSpecularReflection() > 0 {
+		var specularMaterial material.Material
+		var err error
+		if characteristics.GetRoughNess() == 0 {
+			specularMaterial, err = material.InitMirror(characteristics.GetColor())
+		} else {
+			// Maps the roughness to the exponent with the same width of highlight.
+			exponent := math.Max(0, 2/(characteristics.GetRoughNess()*characteristics.GetRoughNess())-2)
+			specularMaterial, err = material.InitPhong(characteristics.GetColor(), exponent)
+		}
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, specularMaterial)
+		weights = append(weights, characteristics.GetSpecularReflection())
+	}
+	if characteristics.GetTransmissionReflection() > 0 {
+		dielectric, err := material.InitDielectric(characteristics.GetColor(), characteristics.GetRefractiveIndex())
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, dielectric)
+		weights = append(weights, characteristics.GetTransmissionReflection())
+	}
+	return material.InitMixture(components, weights)
+}
+
+// findUniformScale finds the factor of a transformation that scales all directions equally, as a rotation or a
+// reflection followed by a scale and a translation, which keeps spheres as spheres.
+//
+// Parameters:
+//  transformation - The 4x4 Matrix of the transformation in homogeneous coordinates.
+//
+// Returns:
+//  The factor of the scale.
+//  If the transformation scales all directions equally.
+//
+func (*Controller) findUniformScale(transformation *matrix.Matrix) (float64, bool) {
+	axes := make([][]float64, 3)
+	for columnIndex := range axes {
+		axes[columnIndex] = make([]float64, 3)
+		for lineIndex := 0; lineIndex < 3; lineIndex++ {
+			axes[columnIndex][lineIndex], _ = transformation.GetValue(lineIndex, columnIndex)
+		}
+	}
+	dotProduct := func(first, second []float64) float64 {
+		return first[0]*second[0] + first[1]*second[1] + first[2]*second[2]
+	}
+
+	squaredScale := dotProduct(axes[0], axes[0])
+	tolerance := uniformScaleTolerance * squaredScale
+	for firstAxisIndex := range axes {
+		if math.Abs(dotProduct(axes[firstAxisIndex], axes[firstAxisIndex])-squaredScale) > tolerance {
+			return 0, false
+		}
+		for secondAxisIndex := firstAxisIndex + 1; secondAxisIndex < len(axes); secondAxisIndex++ {
+			if math.Abs(dotProduct(axes[firstAxisIndex], axes[secondAxisIndex])) > tolerance {
+				return 0, false
+			}
+		}
+	}
+	return math.Sqrt(squaredScale), true
+}
+
+// transformNormals transforms the normals of an Object by the inverse transpose of a transformation, so that they
+// stay perpendicular to the transformed surfaces.
+//
+// Parameters:
+//  normals        - The normals.
+//  transformation - The 4x4 Matrix of the transformation in homogeneous coordinates.
+//
+// Returns:
+//  The normalized transformed normals.
+//  An error.
+//
+func (*Controller) transformNormals(normals []*vector.Vector, transformation *matrix.Matrix) ([]*vector.Vector,
+	error) {
+	matrixController := matrix.Controller{}
+	inverse, err := matrixController.Invert(transformation)
+	if err != nil {
+		return nil, err
+	}
+	normalTransformation := matrixController.Transpose(inverse)
+
+	vectorController := vector.Controller{}
+	transformedNormals := make([]*vector.Vector, len(normals))
+	for normalIndex, normal := range normals {
+		normalMatrix, err := matrixController.MultiplyMatrix(normalTransformation,
+			vectorController.ToHomogeneousCoordinates(normal))
+		if err != nil {
+			return nil, err
+		}
+		transformedNormal, _ := vector.Init(normal.Dimension())
+		for coordinateIndex := 0; coordinateIndex < normal.Dimension(); coordinateIndex++ {
+			coordinate, _ := normalMatrix.GetValue(coordinateIndex, 0)
+			_ = transformedNormal.SetCoordinate(coordinateIndex, coordinate)
+		}
+		transformedNormals[normalIndex] = vectorController.Normalize(transformedNormal)
+	}
+	return transformedNormals, nil
+}
+
+// Transform is a function to place an Object by an affine transformation, as a translation, a rotation, a scale or a
+// composition of them. The points are multiplied by the transformation and the normals by its inverse transpose.
+// Spheres only accept transformations that scale all directions equally, which scale their radius.
+//
+// Parameters:
+//  object         - The Object.
+//  transformation - The 4x4 Matrix of the transformation in homogeneous coordinates.
+//
+// Returns:
+//  The transformed Object, which shares the triangles and the light characteristics of the Object.
+//  An error.
+//
+func (controller *Controller) Transform(object *Object, transformation *matrix.Matrix) (*Object, error) {
+	spheres := object.GetSpheres()
+	if len(spheres) > 0 {
+		scale, isUniform := controller.findUniformScale(transformation)
+		if !isUniform {
+			return nil, nonUniformSphereTransformationError(object)
+		}
+		spheres = make([]*sphere.Sphere, len(object.GetSpheres()))
+		for sphereIndex, currentSphere := range object.GetSpheres() {
+			spheres[sphereIndex] = sphere.Init(currentSphere.GetCenterPointIndex(), currentSphere.GetRadius()*scale)
+		}
+	}
+
+	normals, err := controller.transformNormals(object.GetNormals(), transformation)
+	if err != nil {
+		return nil, err
+	}
+	pointRepositoryController := point_repository.Controller{}
+	repository, err := pointRepositoryController.MultiplyByMatrix(object.GetRepository(), transformation)
+	if err != nil {
+		return nil, err
+	}
+	return &Object{name: object.GetName(), repository: repository, triangles: object.GetTriangles(),
+		spheres: spheres, normals: normals, lightCharacteristics: object.GetLightCharacteristics()}, nil
+}
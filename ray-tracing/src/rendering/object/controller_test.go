@@ -2,9 +2,13 @@ package object
 
 import (
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/material"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -36,9 +40,10 @@ func TestController_GetBoundingBox(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.25
 	diffuseReflection := 0.25
+	refractiveIndex := 1.5
 
-	object, err := Init(name, repository, triangles, firstNormals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	object, err := Init(name, repository, triangles, nil, firstNormals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
 	objectController := Controller{}
@@ -49,6 +54,74 @@ func TestController_GetBoundingBox(t *testing.T) {
 	test_helpers.AssertEqual(t, true, reflect.DeepEqual(expectedBoundingBox, boundingBox))
 }
 
+// TestController_GetBoundingBox_Spheres tests the bounding box of an Object with spheres.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_GetBoundingBox_Spheres(t *testing.T) {
+	object, err := Init("my object", buildSamplePointRepository(t), []*triangle.Triangle{},
+		[]*sphere.Sphere{sphere.Init(0, 1), sphere.Init(2, 0.5)}, buildNormals(t), []float64{1, 1, 1}, 0, 0, 0, 1, 1,
+		nil)
+	test_helpers.AssertNilError(t, err)
+
+	objectController := Controller{}
+	expectedBoundingBox := []float64{-0.5, -1, -1, 3, 2, 2.5}
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(expectedBoundingBox, objectController.GetBoundingBox(object)))
+}
+
+// TestController_GetSphereIndex tests the conversion of the index of a surface to the index of a sphere.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_GetSphereIndex(t *testing.T) {
+	firstTriangle, err := triangle.Init([]int{0, 1, 2}, []int{0, 1, 2})
+	test_helpers.AssertNilError(t, err)
+	object, err := Init("my object", buildSamplePointRepository(t), []*triangle.Triangle{firstTriangle},
+		[]*sphere.Sphere{sphere.Init(0, 1), sphere.Init(2, 0.5)}, buildNormals(t), []float64{1, 1, 1}, 0, 0, 0, 1, 1,
+		nil)
+	test_helpers.AssertNilError(t, err)
+
+	objectController := Controller{}
+	for surfaceIndex, expectedSphereIndex := range []int{-1, 0, 1, -1} {
+		sphereIndex, isSphere := objectController.GetSphereIndex(object, surfaceIndex)
+		test_helpers.AssertEqual(t, expectedSphereIndex, sphereIndex)
+		test_helpers.AssertEqual(t, expectedSphereIndex >= 0, isSphere)
+	}
+}
+
+// TestController_GetSphereNormal tests the normal of a sphere of an Object.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_GetSphereNormal(t *testing.T) {
+	object, err := Init("my object", buildSamplePointRepository(t), []*triangle.Triangle{},
+		[]*sphere.Sphere{sphere.Init(0, 2)}, buildNormals(t), []float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+	surfacePoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	err = surfacePoint.SetCoordinate(0, 2)
+	test_helpers.AssertNilError(t, err)
+	err = surfacePoint.SetCoordinate(2, -2)
+	test_helpers.AssertNilError(t, err)
+
+	objectController := Controller{}
+	normal, err := objectController.GetSphereNormal(object, 0, surfacePoint)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0, 0, -1}, normal.CopyAllCoordinates()))
+}
+
 // TestController_GetCenter tests the get center of an Object.
 //
 // Parameters:
@@ -76,9 +149,10 @@ func TestController_GetCenter(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.25
 	diffuseReflection := 0.25
+	refractiveIndex := 1.5
 
-	object, err := Init(name, repository, triangles, firstNormals, color, specularReflection, roughNess,
-		transmissionReflection, diffuseReflection)
+	object, err := Init(name, repository, triangles, nil, firstNormals, color, specularReflection, roughNess,
+		transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
 	objectController := Controller{}
@@ -96,3 +170,179 @@ func TestController_GetCenter(t *testing.T) {
 
 	test_helpers.AssertEqual(t, true, expectedCenterPoint.IsEqual(centerPoint))
 }
+
+// TestController_GetMaterial tests the material built from the percentages of an Object.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_GetMaterial(t *testing.T) {
+	color := []float64{0.1, 0.25, 0.5}
+	object, err := Init("my object", buildSamplePointRepository(t), []*triangle.Triangle{}, nil, buildNormals(t), color,
+		0.5, 0.5, 0.25, 0.25, 1.5, nil)
+	test_helpers.AssertNilError(t, err)
+
+	objectController := Controller{}
+	objectMaterial, err := objectController.GetMaterial(object)
+	test_helpers.AssertNilError(t, err)
+
+	mixture, isMixture := objectMaterial.(*material.Mixture)
+	test_helpers.AssertEqual(t, true, isMixture)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0.25, 0.5, 0.25}, mixture.GetWeights()))
+
+	lambertian, isLambertian := mixture.GetComponents()[0].(*material.Lambertian)
+	test_helpers.AssertEqual(t, true, isLambertian)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(color, lambertian.GetColor()))
+
+	phong, isPhong := mixture.GetComponents()[1].(*material.Phong)
+	test_helpers.AssertEqual(t, true, isPhong)
+	test_helpers.AssertEqual(t, 6.0, phong.GetExponent())
+
+	dielectric, isDielectric := mixture.GetComponents()[2].(*material.Dielectric)
+	test_helpers.AssertEqual(t, true, isDielectric)
+	test_helpers.AssertEqual(t, 1.5, dielectric.GetRefractiveIndex())
+}
+
+// TestController_GetMaterial_Mirror tests the material built from the percentages of a smooth Object.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_GetMaterial_Mirror(t *testing.T) {
+	object, err := Init("my object", buildSamplePointRepository(t), []*triangle.Triangle{}, nil, buildNormals(t),
+		[]float64{1, 1, 1}, 1, 0, 0, 0, 1, nil)
+	test_helpers.AssertNilError(t, err)
+
+	objectController := Controller{}
+	objectMaterial, err := objectController.GetMaterial(object)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, objectMaterial.IsDelta())
+
+	mixture, isMixture := objectMaterial.(*material.Mixture)
+	test_helpers.AssertEqual(t, true, isMixture)
+	_, isMirror := mixture.GetComponents()[0].(*material.Mirror)
+	test_helpers.AssertEqual(t, true, isMirror)
+}
+
+// TestController_GetMaterial_Explicit tests the material of an Object that has one.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_GetMaterial_Explicit(t *testing.T) {
+	color := []float64{1, 1, 1}
+	ggx, err := material.InitGGX(color, 0.5)
+	test_helpers.AssertNilError(t, err)
+	object, err := Init("my object", buildSamplePointRepository(t), []*triangle.Triangle{}, nil, buildNormals(t), color,
+		0, 0, 0, 1, 1, ggx)
+	test_helpers.AssertNilError(t, err)
+
+	objectController := Controller{}
+	objectMaterial, err := objectController.GetMaterial(object)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, material.Material(ggx), objectMaterial)
+}
+
+// TestController_Transform tests placing an Object by a scale followed by a translation, whose normals follow the
+// inverse transpose of the transformation.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Transform(t *testing.T) {
+	firstTriangle, err := triangle.Init([]int{0, 1, 2}, []int{0, 0, 0})
+	test_helpers.AssertNilError(t, err)
+	normal, err := vector.Init(3)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertNilError(t, normal.SetCoordinate(0, 1))
+	test_helpers.AssertNilError(t, normal.SetCoordinate(1, 1))
+	object, err := Init("my object", buildSamplePointRepository(t), []*triangle.Triangle{firstTriangle}, nil,
+		[]*vector.Vector{normal}, []float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+
+	matrixController := matrix.Controller{}
+	transformation, err := matrixController.Compose(matrixController.BuildScale(2, 1, 1),
+		matrixController.BuildTranslation(1, 0, 0))
+	test_helpers.AssertNilError(t, err)
+	objectController := Controller{}
+	transformedObject, err := objectController.Transform(object, transformation)
+	test_helpers.AssertNilError(t, err)
+
+	expectedPoints := [][]float64{{5, 0, 0}, {1, 2, 0}, {1, 0, 2}}
+	for pointIndex, expectedCoordinates := range expectedPoints {
+		transformedPoint, err := transformedObject.GetRepository().GetPoint(pointIndex)
+		test_helpers.AssertNilError(t, err)
+		for coordinateIndex, expectedCoordinate := range expectedCoordinates {
+			coordinate, err := transformedPoint.GetCoordinate(coordinateIndex)
+			test_helpers.AssertNilError(t, err)
+			test_helpers.AssertEqual(t, expectedCoordinate, coordinate)
+		}
+	}
+	expectedNormal := []float64{0.5 / math.Sqrt(1.25), 1 / math.Sqrt(1.25), 0}
+	for coordinateIndex, coordinate := range transformedObject.GetNormals()[0].CopyAllCoordinates() {
+		test_helpers.AssertEqual(t, true, math.Abs(expectedNormal[coordinateIndex]-coordinate) < 1e-12)
+	}
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{1, 1, 0}, normal.CopyAllCoordinates()))
+	test_helpers.AssertEqual(t, true, transformedObject.GetTriangles()[0].IsEqual(firstTriangle))
+	test_helpers.AssertEqual(t, "my object", transformedObject.GetName())
+}
+
+// TestController_Transform_Spheres tests that the spheres of an Object only accept transformations that scale all
+// directions equally.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Transform_Spheres(t *testing.T) {
+	object, err := Init("my object", buildSamplePointRepository(t), []*triangle.Triangle{},
+		[]*sphere.Sphere{sphere.Init(0, 1)}, nil, []float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+	matrixController := matrix.Controller{}
+	objectController := Controller{}
+
+	rotation, err := matrixController.BuildAxisAngleRotation([]float64{0, 0, 1}, 30)
+	test_helpers.AssertNilError(t, err)
+	transformation, err := matrixController.Compose(matrixController.BuildScale(-2, -2, -2), rotation)
+	test_helpers.AssertNilError(t, err)
+	transformedObject, err := objectController.Transform(object, transformation)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, math.Abs(2-transformedObject.GetSpheres()[0].GetRadius()) < 1e-12)
+	test_helpers.AssertEqual(t, 1.0, object.GetSpheres()[0].GetRadius())
+
+	_, err = objectController.Transform(object, matrixController.BuildScale(2, 1, 1))
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, nonUniformSphereTransformationError(object).Error(), err.Error())
+}
+
+// TestController_Transform_Singular tests placing an Object by a transformation without an inverse.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Transform_Singular(t *testing.T) {
+	object, err := Init("my object", buildSamplePointRepository(t), []*triangle.Triangle{}, nil, buildNormals(t),
+		[]float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+	matrixController := matrix.Controller{}
+	objectController := Controller{}
+	_, err = objectController.Transform(object, matrixController.BuildScale(1, 0, 1))
+	test_helpers.AssertNotNilError(t, err)
+}
@@ -47,3 +47,44 @@ func invalidReflectionCoefficientsError(specularReflection, transmissionReflecti
 		"diffuse %v, specular %v, transmission %v.", diffuseReflection, specularReflection, transmissionReflection)
 	return errors.New(errorMessage)
 }
+
+// invalidRefractiveIndexError is the error where an Object is index of refraction is not positive.
+//
+// Parameters:
+//  refractiveIndex - The index of refraction.
+//
+// Returns:
+//  An Error.
+//
+func invalidRefractiveIndexError(refractiveIndex float64) error {
+	errorMessage := fmt.Sprintf("The index of refraction must be positive: %v.", refractiveIndex)
+	return errors.New(errorMessage)
+}
+
+// invalidSphereRadiusError is the error where a sphere of an Object does not have a positive radius.
+//
+// Parameters:
+//  radius - The radius of the sphere.
+//
+// Returns:
+//  An Error.
+//
+func invalidSphereRadiusError(radius float64) error {
+	errorMessage := fmt.Sprintf("The radius of a sphere must be positive: %v.", radius)
+	return errors.New(errorMessage)
+}
+
+// nonUniformSphereTransformationError is the error where the spheres of an Object would not stay spheres after a
+// transformation.
+//
+// Parameters:
+//  object - The Object.
+//
+// Returns:
+//  An Error.
+//
+func nonUniformSphereTransformationError(object *Object) error {
+	errorMessage := fmt.Sprintf("The spheres of %s only accept transformations that scale all directions equally.",
+		object.GetName())
+	return errors.New(errorMessage)
+}
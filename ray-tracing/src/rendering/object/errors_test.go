@@ -58,3 +58,53 @@ func TestObject_InvalidReflectionCoefficientsError(t *testing.T) {
 	test_helpers.AssertNotNilError(t, err)
 	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
 }
+
+// TestObject_InvalidRefractiveIndexError tests the error where an Object is index of refraction is not positive.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestObject_InvalidRefractiveIndexError(t *testing.T) {
+	refractiveIndex := -1.5
+	expectedErrorMessage := fmt.Sprintf("The index of refraction must be positive: %v.", refractiveIndex)
+	err := invalidRefractiveIndexError(refractiveIndex)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestObject_InvalidSphereRadiusError tests the error where a sphere of an Object does not have a positive radius.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestObject_InvalidSphereRadiusError(t *testing.T) {
+	radius := -2.0
+	expectedErrorMessage := fmt.Sprintf("The radius of a sphere must be positive: %v.", radius)
+	err := invalidSphereRadiusError(radius)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestObject_NonUniformSphereTransformationError tests the error where the spheres of an Object would not stay
+// spheres after a transformation.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestObject_NonUniformSphereTransformationError(t *testing.T) {
+	object, err := Init("ball", buildSamplePointRepository(t), nil, nil, nil, []float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+	expectedErrorMessage := "The spheres of ball only accept transformations that scale all directions equally."
+	err = nonUniformSphereTransformationError(object)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
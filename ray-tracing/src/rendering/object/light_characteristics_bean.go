@@ -1,6 +1,7 @@
 package object
 
 import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/material"
 	"reflect"
 )
 
@@ -12,6 +13,8 @@ import (
 //  roughNess              - How much reflections rays get distorted.
 //  transmissionReflection - Percentage of transmission rays.
 //  diffuseReflection      - Percentage of diffuse rays.
+//  refractiveIndex        - The index of refraction of the material of the object.
+//  surfaceMaterial        - The reflection model of the object, replacing the percentages when not nil.
 //
 type lightCharacteristics struct {
 	color              []float64
@@ -19,6 +22,8 @@ type lightCharacteristics struct {
 	roughNess          float64
 	transmissionReflection float64
 	diffuseReflection  float64
+	refractiveIndex    float64
+	surfaceMaterial    material.Material
 }
 
 // GetColor gets the RGB color.
@@ -81,6 +86,30 @@ func (characteristics *lightCharacteristics) GetDiffuseReflection() float64 {
 	return characteristics.diffuseReflection
 }
 
+// GetRefractiveIndex gets the index of refraction.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The index of refraction.
+//
+func (characteristics *lightCharacteristics) GetRefractiveIndex() float64 {
+	return characteristics.refractiveIndex
+}
+
+// GetMaterial gets the reflection model.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The reflection model, or nil if the percentages are used.
+//
+func (characteristics *lightCharacteristics) GetMaterial() material.Material {
+	return characteristics.surfaceMaterial
+}
+
 // IsEqual checks if a lightCharacteristics object is equal to another.
 //
 // Parameters:
@@ -94,7 +123,9 @@ func (characteristics *lightCharacteristics) IsEqual(other *lightCharacteristics
 		characteristics.GetSpecularReflection() == other.GetSpecularReflection() &&
 		characteristics.GetRoughNess() == other.GetRoughNess() &&
 		characteristics.GetTransmissionReflection() == other.GetTransmissionReflection() &&
-		characteristics.GetDiffuseReflection() == other.GetDiffuseReflection()
+		characteristics.GetDiffuseReflection() == other.GetDiffuseReflection() &&
+		characteristics.GetRefractiveIndex() == other.GetRefractiveIndex() &&
+		reflect.DeepEqual(characteristics.GetMaterial(), other.GetMaterial())
 }
 
 // initLightCharacteristics initializes the light characteristics.
@@ -105,13 +136,15 @@ func (characteristics *lightCharacteristics) IsEqual(other *lightCharacteristics
 //  roughNess              - How much reflections rays get distorted.
 //  transmissionReflection - Percentage of transmission rays.
 //  diffuseReflection      - Percentage of diffuse rays.
+//  refractiveIndex        - The index of refraction of the material of the object.
+//  surfaceMaterial        - The reflection model of the object, replacing the percentages when not nil.
 //
 // Returns:
 // 	A lightCharacteristics.
 // 	An error.
 //
 func initLightCharacteristics(color []float64, specularReflection, roughNess, transmissionReflection,
-	diffuseReflection float64) (*lightCharacteristics, error) {
+	diffuseReflection, refractiveIndex float64, surfaceMaterial material.Material) (*lightCharacteristics, error) {
 	if len(color) != 3 {
 		return nil, nonRGBColorError(color)
 	}
@@ -125,6 +158,10 @@ func initLightCharacteristics(color []float64, specularReflection, roughNess, tr
 		return nil, invalidReflectionCoefficientsError(
 			specularReflection, transmissionReflection, diffuseReflection)
 	}
+	if refractiveIndex <= 0 {
+		return nil, invalidRefractiveIndexError(refractiveIndex)
+	}
 	return &lightCharacteristics{color: color, specularReflection: specularReflection, roughNess: roughNess,
-		transmissionReflection: transmissionReflection, diffuseReflection: diffuseReflection}, nil
+		transmissionReflection: transmissionReflection, diffuseReflection: diffuseReflection,
+		refractiveIndex: refractiveIndex, surfaceMaterial: surfaceMaterial}, nil
 }
@@ -2,6 +2,7 @@ package object
 
 import (
 	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/material"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
 	"testing"
 )
@@ -20,17 +21,40 @@ func TestLightCharacteristics_Init(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.25
 	diffuseReflection := 0.25
+	refractiveIndex := 1.5
 
 	receivedLightCharacteristics, err := initLightCharacteristics(
-		color, specularReflection, roughNess, transmissionReflection, diffuseReflection)
+		color, specularReflection, roughNess, transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNilError(t, err)
 
 	expectedLightCharacteristics := &lightCharacteristics{color: color, specularReflection: specularReflection,
 		roughNess: roughNess, transmissionReflection: transmissionReflection,
-		diffuseReflection: diffuseReflection}
+		diffuseReflection: diffuseReflection, refractiveIndex: refractiveIndex}
 	test_helpers.AssertEqual(t, true, expectedLightCharacteristics.IsEqual(receivedLightCharacteristics))
 }
 
+// TestLightCharacteristics_Init_Material tests the instantiation of a lightCharacteristics with a material.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestLightCharacteristics_Init_Material(t *testing.T) {
+	color := []float64{0.1, 0.25, 0.5}
+	surfaceMaterial, err := material.InitPhong(color, 20)
+	test_helpers.AssertNilError(t, err)
+
+	receivedLightCharacteristics, err := initLightCharacteristics(color, 0, 0, 0, 1, 1, surfaceMaterial)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, material.Material(surfaceMaterial), receivedLightCharacteristics.GetMaterial())
+
+	characteristicsWithoutMaterial, err := initLightCharacteristics(color, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, false, characteristicsWithoutMaterial.IsEqual(receivedLightCharacteristics))
+}
+
 // TestLightCharacteristics_Init_NonRGBColorError tests the instantiation of a lightCharacteristics.
 //
 // Parameters:
@@ -45,10 +69,11 @@ func TestLightCharacteristics_Init_NonRGBColorError(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.25
 	diffuseReflection := 0.25
+	refractiveIndex := 1.5
 
 	expectedErrorMessage := fmt.Sprintf("There are not 3 color values: %d.", len(color))
 
-	_, err := initLightCharacteristics(color, specularReflection, roughNess, transmissionReflection, diffuseReflection)
+	_, err := initLightCharacteristics(color, specularReflection, roughNess, transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNotNilError(t, err)
 	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
 }
@@ -67,10 +92,11 @@ func TestLightCharacteristics_Init_ColorOutOfBoundsError(t *testing.T) {
 	roughNess := 0.0
 	transmissionReflection := 0.25
 	diffuseReflection := 0.25
+	refractiveIndex := 1.5
 
 	expectedErrorMessage := fmt.Sprintf("Color values out of interval [0,1]: %v.", color)
 
-	_, err := initLightCharacteristics(color, specularReflection, roughNess, transmissionReflection, diffuseReflection)
+	_, err := initLightCharacteristics(color, specularReflection, roughNess, transmissionReflection, diffuseReflection, refractiveIndex, nil)
 	test_helpers.AssertNotNilError(t, err)
 	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
 }
@@ -89,12 +115,37 @@ func TestLightCharacteristics_Init_InvalidReflectionCoefficientsError(t *testing
 	roughNess := 0.0
 	transmissionReflection := 0.25
 	diffuseReflection := 0.25
+	refractiveIndex := 1.5
 
 	expectedErrorMessage := fmt.Sprintf("At least one of the reflections is smaller than 0 or they do not " +
 		"sum 1: diffuse %v, specular %v, transmission %v.",
 		diffuseReflection, specularReflection, transmissionReflection)
 
-	_, err := initLightCharacteristics(color, specularReflection, roughNess, transmissionReflection, diffuseReflection)
+	_, err := initLightCharacteristics(color, specularReflection, roughNess, transmissionReflection, diffuseReflection, refractiveIndex, nil)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestLightCharacteristics_Init_InvalidRefractiveIndexError tests the instantiation of a lightCharacteristics.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestLightCharacteristics_Init_InvalidRefractiveIndexError(t *testing.T) {
+	color := []float64{0.5, 0.25, 0.5}
+	specularReflection := 0.0
+	roughNess := 0.0
+	transmissionReflection := 1.0
+	diffuseReflection := 0.0
+	refractiveIndex := 0.0
+
+	expectedErrorMessage := fmt.Sprintf("The index of refraction must be positive: %v.", refractiveIndex)
+
+	_, err := initLightCharacteristics(color, specularReflection, roughNess, transmissionReflection, diffuseReflection,
+		refractiveIndex, nil)
 	test_helpers.AssertNotNilError(t, err)
 	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
 }
@@ -0,0 +1,115 @@
+package path_tracing
+
+import (
+	"math"
+)
+
+// AdaptiveSampling is a class for the settings of the adaptive sampling of the pixels, which stops tracing rays for a
+// pixel once the standard error of the mean luminance of its rays is small enough relative to the mean.
+//
+// Members:
+// 	minimumSamples - The number of rays every pixel traces before checking if it converged.
+// 	maximumSamples - The most rays a pixel traces.
+// 	noiseThreshold - The standard error of the mean luminance, relative to the mean, under which a pixel converged.
+//
+type AdaptiveSampling struct {
+	minimumSamples int
+	maximumSamples int
+	noiseThreshold float64
+}
+
+// GetMinimumSamples gets the number of rays every pixel traces before checking if it converged.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The minimum number of rays.
+//
+func (adaptiveSampling *AdaptiveSampling) GetMinimumSamples() int {
+	return adaptiveSampling.minimumSamples
+}
+
+// GetMaximumSamples gets the most rays a pixel traces.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The maximum number of rays.
+//
+func (adaptiveSampling *AdaptiveSampling) GetMaximumSamples() int {
+	return adaptiveSampling.maximumSamples
+}
+
+// GetNoiseThreshold gets the standard error of the mean luminance, relative to the mean, under which a pixel
+// converged.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The noise threshold.
+//
+func (adaptiveSampling *AdaptiveSampling) GetNoiseThreshold() float64 {
+	return adaptiveSampling.noiseThreshold
+}
+
+// IsConverged checks if a pixel can stop tracing rays, from the running mean and sum of squared deviations of the
+// luminance of its rays.
+//
+// Parameters:
+// 	numberOfSamples   - The number of rays traced.
+// 	mean              - The mean luminance of the rays.
+// 	squaredDeviations - The sum of the squared deviations of the luminance of the rays from the mean.
+//
+// Returns:
+// 	If the pixel converged.
+//
+func (adaptiveSampling *AdaptiveSampling) IsConverged(numberOfSamples int, mean, squaredDeviations float64) bool {
+	if numberOfSamples < adaptiveSampling.GetMinimumSamples() {
+		return false
+	}
+	if numberOfSamples >= adaptiveSampling.GetMaximumSamples() {
+		return true
+	}
+	variance := squaredDeviations / float64(numberOfSamples-1)
+	standardError := math.Sqrt(variance / float64(numberOfSamples))
+	return standardError <= adaptiveSampling.GetNoiseThreshold()*math.Abs(mean)
+}
+
+// IsEqual checks if two AdaptiveSampling are equal.
+//
+// Parameters:
+// 	other - The other AdaptiveSampling.
+//
+// Returns:
+// 	If both are equal.
+//
+func (adaptiveSampling *AdaptiveSampling) IsEqual(other *AdaptiveSampling) bool {
+	return adaptiveSampling.GetMinimumSamples() == other.GetMinimumSamples() &&
+		adaptiveSampling.GetMaximumSamples() == other.GetMaximumSamples() &&
+		adaptiveSampling.GetNoiseThreshold() == other.GetNoiseThreshold()
+}
+
+// InitAdaptiveSampling initializes the AdaptiveSampling.
+//
+// Parameters:
+// 	minimumSamples - The number of rays every pixel traces before checking if it converged, at least 2.
+// 	maximumSamples - The most rays a pixel traces, at least the minimum.
+// 	noiseThreshold - The standard error of the mean luminance, relative to the mean, under which a pixel converged.
+//
+// Returns:
+// 	The AdaptiveSampling.
+// 	An error.
+//
+func InitAdaptiveSampling(minimumSamples, maximumSamples int, noiseThreshold float64) (*AdaptiveSampling, error) {
+	if minimumSamples < 2 || maximumSamples < minimumSamples {
+		return nil, adaptiveSamplesError(minimumSamples, maximumSamples)
+	}
+	if noiseThreshold <= 0 {
+		return nil, noiseThresholdError(noiseThreshold)
+	}
+	return &AdaptiveSampling{minimumSamples: minimumSamples, maximumSamples: maximumSamples,
+		noiseThreshold: noiseThreshold}, nil
+}
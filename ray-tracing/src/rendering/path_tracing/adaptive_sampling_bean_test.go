@@ -0,0 +1,78 @@
+package path_tracing
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestAdaptiveSampling_Init tests the instantiation of the AdaptiveSampling.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestAdaptiveSampling_Init(t *testing.T) {
+	adaptiveSampling, err := InitAdaptiveSampling(8, 256, 0.02)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 8, adaptiveSampling.GetMinimumSamples())
+	test_helpers.AssertEqual(t, 256, adaptiveSampling.GetMaximumSamples())
+	test_helpers.AssertEqual(t, 0.02, adaptiveSampling.GetNoiseThreshold())
+}
+
+// TestAdaptiveSampling_Init_SamplesError tests the instantiation of the AdaptiveSampling with invalid bounds of the
+// number of rays.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestAdaptiveSampling_Init_SamplesError(t *testing.T) {
+	for _, samples := range [][]int{{1, 8}, {8, 4}} {
+		expectedErrorMessage := fmt.Sprintf("Invalid adaptive samples from %d to %d. Expected 2 <= minimum <= maximum",
+			samples[0], samples[1])
+		_, err := InitAdaptiveSampling(samples[0], samples[1], 0.02)
+		test_helpers.AssertNotNilError(t, err)
+		test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+	}
+}
+
+// TestAdaptiveSampling_Init_NoiseThresholdError tests the instantiation of the AdaptiveSampling with a noise threshold
+// that is not positive.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestAdaptiveSampling_Init_NoiseThresholdError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid noise threshold %v. Expected more than 0", 0.0)
+	_, err := InitAdaptiveSampling(8, 256, 0)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestAdaptiveSampling_IsConverged tests the convergence of a pixel from the statistics of its rays.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestAdaptiveSampling_IsConverged(t *testing.T) {
+	adaptiveSampling, err := InitAdaptiveSampling(4, 100, 0.1)
+	test_helpers.AssertNilError(t, err)
+	// A mean of 1 with variance 1 has standard error 1 / sqrt(n), under 0.1 from 100 rays.
+	test_helpers.AssertEqual(t, false, adaptiveSampling.IsConverged(3, 1, 0))
+	test_helpers.AssertEqual(t, true, adaptiveSampling.IsConverged(4, 1, 0))
+	test_helpers.AssertEqual(t, true, adaptiveSampling.IsConverged(4, 0, 0))
+	test_helpers.AssertEqual(t, false, adaptiveSampling.IsConverged(50, 1, 49))
+	test_helpers.AssertEqual(t, true, adaptiveSampling.IsConverged(99, 10, 98))
+	test_helpers.AssertEqual(t, true, adaptiveSampling.IsConverged(100, 1, 99))
+}
@@ -1,8 +1,11 @@
 package path_tracing
 
 import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/bounding_volume_hierarchy"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/camera"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/instance"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/light"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/material"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/screen"
 )
@@ -10,16 +13,27 @@ import (
 // PathTracer is a class for path tracing algorithm.
 //
 // Members:
-// 	objects     - The list of objects.
-//  pixelScreen - The screen.
-//  sceneCamera - The camera on the scene.
-//  lights      - The list of light objects.
+// 	objects           - The list of objects.
+//  instances         - The objects as instances on the coordinates of the scene, followed by the instances of the
+//                      meshes of the scene.
+//  pixelScreen       - The screen.
+//  sceneCamera       - The camera on the scene.
+//  lights            - The list of light objects.
+//  objectsHierarchy  - The two level bounding volume hierarchy of the instances.
+//  lightsHierarchy   - The bounding volume hierarchy of the objects of the lights.
+//  materials         - The materials of the instances, in the same order.
+//  lightDistribution - The distribution used to sample points on the lights.
 //
 type PathTracer struct {
-	objects     []*object.Object
-	pixelScreen *screen.Screen
-	sceneCamera *camera.Camera
-	lights      []*light.Light
+	objects           []*object.Object
+	instances         []*instance.Instance
+	pixelScreen       *screen.Screen
+	sceneCamera       *camera.Camera
+	lights            []*light.Light
+	objectsHierarchy  *bounding_volume_hierarchy.InstanceHierarchy
+	lightsHierarchy   *bounding_volume_hierarchy.BoundingVolumeHierarchy
+	materials         []material.Material
+	lightDistribution *LightDistribution
 }
 
 // GetObjects gets the objects of the PathTracer.
@@ -34,6 +48,18 @@ func (pathTracer *PathTracer) GetObjects() []*object.Object {
 	return pathTracer.objects
 }
 
+// GetInstances gets the instances of the PathTracer.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The objects as instances on the coordinates of the scene, followed by the instances of the meshes.
+//
+func (pathTracer *PathTracer) GetInstances() []*instance.Instance {
+	return pathTracer.instances
+}
+
 // GetPixelScreen gets the pixel screen of the PathTracer.
 //
 // Parameters:
@@ -70,7 +96,56 @@ func (pathTracer *PathTracer) GetLights() []*light.Light {
 	return pathTracer.lights
 }
 
-// Init initializes a PathTracer.
+// GetObjectsHierarchy gets the bounding volume hierarchy of the instances of the PathTracer.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The two level bounding volume hierarchy of the instances, where the instance index is the index on the instances.
+//
+func (pathTracer *PathTracer) GetObjectsHierarchy() *bounding_volume_hierarchy.InstanceHierarchy {
+	return pathTracer.objectsHierarchy
+}
+
+// GetLightsHierarchy gets the bounding volume hierarchy of the light objects of the PathTracer.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The bounding volume hierarchy of the light objects, where the object index is the light index.
+//
+func (pathTracer *PathTracer) GetLightsHierarchy() *bounding_volume_hierarchy.BoundingVolumeHierarchy {
+	return pathTracer.lightsHierarchy
+}
+
+// GetMaterials gets the materials of the objects of the PathTracer.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The materials, where the index is the instance index.
+//
+func (pathTracer *PathTracer) GetMaterials() []material.Material {
+	return pathTracer.materials
+}
+
+// GetLightDistribution gets the distribution used to sample points on the lights of the PathTracer.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The LightDistribution.
+//
+func (pathTracer *PathTracer) GetLightDistribution() *LightDistribution {
+	return pathTracer.lightDistribution
+}
+
+// Init initializes a PathTracer, building the bounding volume hierarchies of its objects and lights, the materials of
+// its objects and the distribution of its lights.
 //
 // Parameters:
 // 	objects     - The list of objects.
@@ -80,8 +155,63 @@ func (pathTracer *PathTracer) GetLights() []*light.Light {
 //
 // Returns:
 // 	a PathTracer.
+// 	an error.
 //
 func Init(objects []*object.Object, pixelScreen *screen.Screen, sceneCamera *camera.Camera,
-	lights []*light.Light) *PathTracer {
-	return &PathTracer{objects: objects, pixelScreen: pixelScreen, sceneCamera: sceneCamera, lights: lights}
+	lights []*light.Light) (*PathTracer, error) {
+	return InitWithInstances(objects, []*instance.Instance{}, pixelScreen, sceneCamera, lights)
+}
+
+// InitWithInstances initializes a PathTracer whose scene also has instances of meshes, building the bounding volume
+// hierarchies of its instances and lights, the materials of its instances and the distribution of its lights.
+// The objects become instances on the coordinates of the scene, before the instances of the meshes.
+//
+// Parameters:
+// 	objects     - The list of objects.
+// 	instances   - The list of instances of meshes.
+//  pixelScreen - The screen.
+//  sceneCamera - The camera on the scene.
+//  lights      - The list of light objects.
+//
+// Returns:
+// 	a PathTracer.
+// 	an error.
+//
+func InitWithInstances(objects []*object.Object, instances []*instance.Instance, pixelScreen *screen.Screen,
+	sceneCamera *camera.Camera, lights []*light.Light) (*PathTracer, error) {
+	sceneInstances := make([]*instance.Instance, 0, len(objects)+len(instances))
+	for _, currentObject := range objects {
+		objectInstance, err := instance.Init(currentObject, nil)
+		if err != nil {
+			return nil, err
+		}
+		sceneInstances = append(sceneInstances, objectInstance)
+	}
+	sceneInstances = append(sceneInstances, instances...)
+	objectsHierarchy, err := bounding_volume_hierarchy.InitInstanceHierarchy(sceneInstances)
+	if err != nil {
+		return nil, err
+	}
+
+	lightObjects := make([]*object.Object, len(lights))
+	for lightIndex, currentLight := range lights {
+		lightObjects[lightIndex] = currentLight.GetLightObject()
+	}
+	lightsHierarchy, err := bounding_volume_hierarchy.Init(lightObjects)
+	if err != nil {
+		return nil, err
+	}
+
+	objectController := object.Controller{}
+	materials := make([]material.Material, len(sceneInstances))
+	for instanceIndex, currentInstance := range sceneInstances {
+		materials[instanceIndex], err = objectController.GetMaterial(currentInstance.GetObject())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &PathTracer{objects: objects, instances: sceneInstances, pixelScreen: pixelScreen,
+		sceneCamera: sceneCamera, lights: lights, objectsHierarchy: objectsHierarchy, lightsHierarchy: lightsHierarchy,
+		materials: materials, lightDistribution: InitLightDistribution(lights)}, nil
 }
@@ -1,24 +1,34 @@
 package path_tracing
 
 import (
-	"fmt"
+	"context"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/line"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/bounding_volume_hierarchy"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/camera"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/color_matrix"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/instance"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/material"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
-	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/ray"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/radiance_matrix"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/sampler"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/screen"
-	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/thread_locker"
-	"log"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tile"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
 	"math"
-	"math/rand"
 	"os"
+	"runtime"
+	"sort"
 	"strconv"
-	"time"
+	"sync"
 )
 
+// shadowRayEpsilon is the fraction of the distance to a light point where a shadow ray stops looking for occluders,
+// so the light itself does not block the ray.
+const shadowRayEpsilon = 0.0001
+
 // Controller is a class for controlling the path tracing algorithm.
 //
 // Members:
@@ -26,59 +36,47 @@ import (
 //
 type Controller struct {}
 
-// findDiffuseReflectionVector finds a ray for diffuse reflection in semi-sphere.
+// findDiffuseReflectionVector finds a ray for diffuse reflection, sampled in the hemisphere around the normal with
+// density proportional to the cosine with the normal.
 //
 // Parameters:
-//  normal - the normal.
+//  nextRayOrigin - The origin of the next ray.
+//  normalVector  - The normalized normal.
+//  pixelSampler  - The Sampler of the numbers of the path.
 //
 // Returns:
 // 	The diffuse vector.
 //
-func (controller *Controller) findDiffuseReflectionVector(nextRayOrigin *point.Point,
-	normalVector *vector.Vector) *vector.Vector {
-	offsetVector := controller.findOffsetVectorInSemiSphere()
-	vectorController := &vector.Controller{}
-
-	diffuseVector, _ := vectorController.Sum(normalVector, offsetVector, 1, 1)
-	return vectorController.Normalize(diffuseVector)
-}
-
-// findOffsetVectorInSemiSphere finds a offset vector in a semi-sphere.
-//
-// Parameters:
-//  none
-//
-// Returns:
-// 	The offset specular vector.
-//
-func (*Controller) findOffsetVectorInSemiSphere() *vector.Vector {
-	firstCoordinate := (rand.Float64() * 2) - 1
-	secondCoordinate := (rand.Float64() * 2) - 1
-	thirdCoordinate := (rand.Float64() * 2) - 1
-
-	offsetVector, _ := vector.Init(3)
-	_ = offsetVector.SetCoordinate(0, firstCoordinate)
-	_ = offsetVector.SetCoordinate(1, secondCoordinate)
-	_ = offsetVector.SetCoordinate(2, thirdCoordinate)
-
-	vectorController := &vector.Controller{}
-	normalizedOffsetVector := vectorController.Normalize(offsetVector)
-
-	return normalizedOffsetVector
+func (*Controller) findDiffuseReflectionVector(nextRayOrigin *point.Point, normalVector *vector.Vector,
+	pixelSampler sampler.Sampler) *vector.Vector {
+	materialController := &material.Controller{}
+	firstSample, secondSample := pixelSampler.Get2D()
+	return materialController.SampleCosineWeightedHemisphere(normalVector, firstSample, secondSample)
 }
 
-// findNormal finds the resulting normal of a object intersection.
+// findNormal finds the resulting normal of an instance intersection.
+// Triangles interpolate the normals of their vertices and spheres use their analytic normal, both on the coordinates
+// of the object of the instance before they are taken to the scene.
 //
 // Parameters:
-//  intersectedObject      - The object that has the next ray is origin.
-//  triangleIndex          - The index of the triangle of the intersected object that hast the point.
+//  intersectedInstance    - The instance that has the next ray is origin.
+//  triangleIndex          - The index of the surface of the object of the intersected instance that hast the point.
 //  barycentricCoordinates - The barycentric coordinates of the next ray origin relative to the triangle.
+//  nextRayOrigin          - The intersected point.
 //
 // Returns:
 // 	The resulting normal.
 //
-func (*Controller) findNormal(intersectedObject *object.Object, triangleIndex int,
-	barycentricCoordinates []float64) *vector.Vector {
+func (*Controller) findNormal(intersectedInstance *instance.Instance, triangleIndex int,
+	barycentricCoordinates []float64, nextRayOrigin *point.Point) *vector.Vector {
+	instanceController := instance.Controller{}
+	objectController := object.Controller{}
+	intersectedObject := intersectedInstance.GetObject()
+	if sphereIndex, isSphere := objectController.GetSphereIndex(intersectedObject, triangleIndex); isSphere {
+		normal, _ := objectController.GetSphereNormal(intersectedObject, sphereIndex,
+			instanceController.ToObjectPoint(intersectedInstance, nextRayOrigin))
+		return instanceController.ToSceneNormal(intersectedInstance, normal)
+	}
 	normals := make([]*vector.Vector, 3)
 	for index := 0; index < 3; index++ {
 		normalIndex, _ := intersectedObject.GetTriangles()[triangleIndex].GetVertexNormalIndex(index)
@@ -88,37 +86,52 @@ func (*Controller) findNormal(intersectedObject *object.Object, triangleIndex in
 	firstPlusSecondNormal, _ := vectorController.Sum(normals[0], normals[1], barycentricCoordinates[0],
 		barycentricCoordinates[1])
 	sumNormals, _ := vectorController.Sum(firstPlusSecondNormal, normals[2], 1, barycentricCoordinates[2])
-	return vectorController.Normalize(sumNormals)
+	return instanceController.ToSceneNormal(intersectedInstance, vectorController.Normalize(sumNormals))
 }
 
-// findSpecularReflectionVector finds the resulting normal.
+// findSpecularReflectionVector finds the resulting normal. The vector towards the first light is reflected around the
+// normal, or the ray itself when the scene has no lights, as a mirror.
 //
 // Parameters:
 //  pathTracer        - The PathTracer.
+//  currentRay        - The ray that intersected the object.
 //  nextRayOrigin     - The origin of the next ray.
 //  intersectedObject - The object that has the next ray is origin.
 //  normalVector      - The resulting normal of a object intersection.
+//  pixelSampler      - The Sampler of the numbers of the path.
 //
 // Returns:
 // 	The specular vector.
 //
-func (controller *Controller) findSpecularReflectionVector(pathTracer *PathTracer, nextRayOrigin *point.Point,
-	intersectedObject *object.Object, normalVector *vector.Vector) *vector.Vector {
+func (*Controller) findSpecularReflectionVector(pathTracer *PathTracer, currentRay *line.Line,
+	nextRayOrigin *point.Point, intersectedObject *object.Object, normalVector *vector.Vector,
+	pixelSampler sampler.Sampler) *vector.Vector {
 
 	vectorController := &vector.Controller{}
 	pointController := &point.Controller{}
 	objectController := &object.Controller{}
 
-	lightCenter := objectController.GetCenter(pathTracer.GetLights()[0].GetLightObject())
-	lightVector, _ := pointController.ExtractVector(nextRayOrigin, lightCenter)
-	normalizedLightVector := vectorController.Normalize(lightVector)
+	var specularVector *vector.Vector
+	if len(pathTracer.GetLights()) == 0 {
+		// R = D - 2N(N.D)
+		direction := vectorController.Normalize(currentRay.GetVectorDirector())
+		normalDotProductDirection, _ := vectorController.DotProduct(normalVector, direction)
+		specularVector, _ = vectorController.Sum(direction, normalVector, 1, -2 * normalDotProductDirection)
+	} else {
+		lightCenter := objectController.GetCenter(pathTracer.GetLights()[0].GetLightObject())
+		lightVector, _ := pointController.ExtractVector(nextRayOrigin, lightCenter)
+		normalizedLightVector := vectorController.Normalize(lightVector)
 
-	normalDotProductLight, _ := vectorController.DotProduct(normalVector, normalizedLightVector)
+		normalDotProductLight, _ := vectorController.DotProduct(normalVector, normalizedLightVector)
 
-	// R = 2N(N.L) - L
-	specularVector, _ := vectorController.Sum(normalVector, normalizedLightVector, 2 * normalDotProductLight, -1)
+		// R = 2N(N.L) - L
+		specularVector, _ = vectorController.Sum(normalVector, normalizedLightVector, 2 * normalDotProductLight, -1)
+	}
 
-	offsetVector := controller.findOffsetVectorInSemiSphere()
+	materialController := &material.Controller{}
+	firstSample, secondSample := pixelSampler.Get2D()
+	offsetVector := materialController.SampleUniformHemisphere(vectorController.Normalize(specularVector),
+		firstSample, secondSample)
 	offsetVectorWithRoughness := vectorController.ScalarMultiplication(offsetVector,
 		intersectedObject.GetLightCharacteristics().GetRoughNess())
 
@@ -127,58 +140,124 @@ func (controller *Controller) findSpecularReflectionVector(pathTracer *PathTrace
 	return vectorController.Normalize(resultingSpecularVector)
 }
 
+// findTransmissionVector finds a ray for the transmission through a dielectric object.
+// The ray is either reflected or refracted according to the Fresnel reflectance, and always reflected when there is
+// total internal reflection.
+//
+// Parameters:
+//  currentRay        - The ray that intersected the object.
+//  intersectedObject - The object that has the next ray is origin.
+//  normalVector      - The resulting normal of a object intersection.
+//  pixelSampler      - The Sampler of the numbers of the path.
+//
+// Returns:
+// 	The transmission vector.
+//
+func (*Controller) findTransmissionVector(currentRay *line.Line, intersectedObject *object.Object,
+	normalVector *vector.Vector, pixelSampler sampler.Sampler) *vector.Vector {
+	vectorController := &vector.Controller{}
+	outgoingVector := vectorController.Normalize(vectorController.ScalarMultiplication(
+		currentRay.GetVectorDirector(), -1))
+
+	dielectric, _ := material.InitDielectric(intersectedObject.GetLightCharacteristics().GetColor(),
+		intersectedObject.GetLightCharacteristics().GetRefractiveIndex())
+	firstSample, secondSample := pixelSampler.Get2D()
+	transmissionSample, _ := dielectric.SampleDirection(normalVector, outgoingVector, firstSample, secondSample)
+	return transmissionSample.GetDirection()
+}
+
+// findMaterialVector finds a ray sampled from the material of an object.
+//
+// Parameters:
+//  currentRay        - The ray that intersected the object.
+//  intersectedObject - The object that has the next ray is origin.
+//  normalVector      - The resulting normal of a object intersection.
+//  pixelSampler      - The Sampler of the numbers of the path.
+//
+// Returns:
+// 	The sampled vector.
+// 	If a vector could be sampled.
+//
+func (*Controller) findMaterialVector(currentRay *line.Line, intersectedObject *object.Object,
+	normalVector *vector.Vector, pixelSampler sampler.Sampler) (*vector.Vector, bool) {
+	vectorController := &vector.Controller{}
+	outgoingVector := vectorController.Normalize(vectorController.ScalarMultiplication(
+		currentRay.GetVectorDirector(), -1))
+
+	firstSample, secondSample := pixelSampler.Get2D()
+	materialSample, isSampled := intersectedObject.GetLightCharacteristics().GetMaterial().SampleDirection(
+		normalVector, outgoingVector, firstSample, secondSample)
+	if !isSampled {
+		return nil, false
+	}
+	return materialSample.GetDirection(), true
+}
+
 // findNextRay finds the next ray.
 //
 // Parameters:
 //  pathTracer             - The PathTracer.
+//  currentRay             - The ray that intersected the object.
 //  nextRayOrigin          - The origin of the next ray.
-//  intersectedObject      - The object that has the next ray is origin.
-//  triangleIndex          - The index of the triangle of the intersected object that hast the point.
+//  intersectedInstance    - The instance that has the next ray is origin.
+//  triangleIndex          - The index of the triangle of the object of the intersected instance that hast the point.
 //  barycentricCoordinates - The barycentric coordinates of the next ray origin relative to the triangle.
 //  isShadowed             - The flag for if the starting point of the next ray is shadowed.
+//  pixelSampler           - The Sampler of the numbers of the path.
 //
 // Returns:
-// 	The next ray.
+// 	The next ray, or nil if the path ends on the object.
 //
-func (controller *Controller) findNextRay(pathTracer *PathTracer, nextRayOrigin *point.Point,
-	intersectedObject *object.Object, triangleIndex int, barycentricCoordinates []float64, isShadowed bool) *line.Line {
+func (controller *Controller) findNextRay(pathTracer *PathTracer, currentRay *line.Line, nextRayOrigin *point.Point,
+	intersectedInstance *instance.Instance, triangleIndex int, barycentricCoordinates []float64, isShadowed bool,
+	pixelSampler sampler.Sampler) *line.Line {
+
+	normalVector := controller.findNormal(intersectedInstance, triangleIndex, barycentricCoordinates, nextRayOrigin)
+	intersectedObject := intersectedInstance.GetObject()
 
-	normalVector := controller.findNormal(intersectedObject, triangleIndex, barycentricCoordinates)
+	if intersectedObject.GetLightCharacteristics().GetMaterial() != nil {
+		materialVector, isSampled := controller.findMaterialVector(currentRay, intersectedObject, normalVector,
+			pixelSampler)
+		if !isSampled {
+			return nil
+		}
+		newRay, _ := line.Init(nextRayOrigin, materialVector)
+		return newRay
+	}
 
 	diffusedReflection := intersectedObject.GetLightCharacteristics().GetDiffuseReflection()
 	specularReflection := intersectedObject.GetLightCharacteristics().GetSpecularReflection()
-	transmissionReflection := 0.0
+	transmissionReflection := intersectedObject.GetLightCharacteristics().GetTransmissionReflection()
 
 	if isShadowed {
 		if specularReflection > 0 {
-			diffusedReflection = 0.95
-			specularReflection = 0.05
+			diffusedReflection = 0.95 * (1 - transmissionReflection)
+			specularReflection = 0.05 * (1 - transmissionReflection)
 		} else {
-			diffusedReflection = 1
+			diffusedReflection = 1 - transmissionReflection
 			specularReflection = 0
 		}
-
-		transmissionReflection = 0
 	}
 
 	sumOfTotalReflections := diffusedReflection + specularReflection + transmissionReflection
-	selectedRandomValue := rand.Float64() * sumOfTotalReflections
+	selectedRandomValue := pixelSampler.Get1D() * sumOfTotalReflections
 
 	var newRayVectorDirector *vector.Vector
 	if selectedRandomValue <= diffusedReflection {
-		newRayVectorDirector = controller.findDiffuseReflectionVector(nextRayOrigin, normalVector)
+		newRayVectorDirector = controller.findDiffuseReflectionVector(nextRayOrigin, normalVector, pixelSampler)
 	} else if selectedRandomValue <= diffusedReflection + specularReflection {
 		newRayVectorDirector = controller.findSpecularReflectionVector(
-			pathTracer, nextRayOrigin, intersectedObject, normalVector)
+			pathTracer, currentRay, nextRayOrigin, intersectedObject, normalVector, pixelSampler)
 	} else {
-		// TODO: Transmission reflexion.
+		newRayVectorDirector = controller.findTransmissionVector(currentRay, intersectedObject, normalVector,
+			pixelSampler)
 	}
 
 	newRay, _ := line.Init(nextRayOrigin, newRayVectorDirector)
 	return newRay
 }
 
-// intersectObjects uses a ray to intersect all objects.
+// intersectObjects uses a ray to intersect all objects and instances.
 //
 // Parameters:
 // 	pathTracer          - The PathTracer.
@@ -188,38 +267,14 @@ func (controller *Controller) findNextRay(pathTracer *PathTracer, nextRayOrigin
 // Returns:
 // 	If there is intersections with the objects.
 // 	The closest line parameter.
-// 	The closest object index.
+// 	The closest instance index.
 // 	The closest triangle index.
 // 	The closest triangle is barycentric coordinates.
 //
 func (controller *Controller) intersectObjects(pathTracer *PathTracer, currentRay *line.Line,
 	minimumRayParameter float64) (bool, float64, int, int, []float64) {
-	closestLineParameter := math.MaxFloat64
-	closesObjectIndex := -1
-	closestTriangleIndex := -1
-	closestTriangleBarycentricCoordinates := make([]float64, 3)
-	hasObjectIntersections := false
-	rayController := ray.Controller{}
-
-	for objectIndex, currentObject := range pathTracer.GetObjects() {
-		for triangleIndex, currentTriangle := range currentObject.GetTriangles() {
-
-			lineParameter, barycentricCoordinates, hasIntersection, _ := rayController.IntersectRayTriangle(
-				currentRay, currentTriangle, currentObject.GetRepository())
-
-			if hasIntersection && lineParameter >= minimumRayParameter {
-				hasObjectIntersections = true
-				if  lineParameter < closestLineParameter {
-					closestLineParameter = lineParameter
-					closesObjectIndex = objectIndex
-					closestTriangleIndex = triangleIndex
-					closestTriangleBarycentricCoordinates = barycentricCoordinates
-				}
-			}
-		}
-	}
-	return hasObjectIntersections, closestLineParameter, closesObjectIndex, closestTriangleIndex,
-	closestTriangleBarycentricCoordinates
+	hierarchyController := bounding_volume_hierarchy.Controller{}
+	return hierarchyController.IntersectInstances(pathTracer.GetObjectsHierarchy(), currentRay, minimumRayParameter)
 }
 
 // intersectLights uses a ray to intersect all lights.
@@ -233,30 +288,14 @@ func (controller *Controller) intersectObjects(pathTracer *PathTracer, currentRa
 // 	If there is intersections with lights.
 // 	The closest light is line parameter.
 // 	The closest light index.
+// 	The closest triangle index on the object of the light.
 //
 func (controller *Controller) intersectLights(pathTracer *PathTracer, currentRay *line.Line,
-	minimumRayParameter float64) (bool, float64, int) {
-	closesLightLineParameterIndex := math.MaxFloat64
-	closestLightIndex := -1
-	hasLightIntersection := false
-	rayController := ray.Controller{}
-
-	for lightIndex, currentLight := range pathTracer.GetLights() {
-		for _, currentTriangle := range currentLight.GetLightObject().GetTriangles() {
-			lineParameter, _, hasIntersection, _ := rayController.IntersectRayTriangle(
-				currentRay, currentTriangle, currentLight.GetLightObject().GetRepository())
-
-			if hasIntersection && lineParameter >= minimumRayParameter {
-				hasLightIntersection = true
-				if lineParameter <= closesLightLineParameterIndex {
-					closesLightLineParameterIndex = lineParameter
-					closestLightIndex = lightIndex
-				}
-			}
-		}
-	}
-
-	return hasLightIntersection, closesLightLineParameterIndex, closestLightIndex
+	minimumRayParameter float64) (bool, float64, int, int) {
+	hierarchyController := bounding_volume_hierarchy.Controller{}
+	hasLightIntersection, closestLightLineParameter, closestLightIndex, closestTriangleIndex, _ :=
+		hierarchyController.Intersect(pathTracer.GetLightsHierarchy(), currentRay, minimumRayParameter)
+	return hasLightIntersection, closestLightLineParameter, closestLightIndex, closestTriangleIndex
 }
 
 // traceShadowRays traces a ray to evey light to see if any can be directly intersected.
@@ -276,7 +315,7 @@ func (controller *Controller) traceShadowRays(pathTracer *PathTracer, startingPo
 		lightCenter := objectController.GetCenter(currentLight.GetLightObject())
 		currentRay, _ := lineController.ExtractLine(startingPoint, lightCenter)
 
-		hasLightIntersection, closestLineLightParameter, _ := controller.intersectLights(pathTracer, currentRay, 0)
+		hasLightIntersection, closestLineLightParameter, _, _ := controller.intersectLights(pathTracer, currentRay, 0)
 
 		if hasLightIntersection {
 			hasObjectIntersection, closestLineObjectParameter, _, _, _ := controller.intersectObjects(pathTracer, currentRay, 0)
@@ -289,6 +328,209 @@ func (controller *Controller) traceShadowRays(pathTracer *PathTracer, startingPo
 	return false
 }
 
+// findTriangleGeometry finds the geometric normal and the area of a triangle of an object.
+//
+// Parameters:
+// 	currentObject - The object.
+// 	triangleIndex - The index of the triangle on the object.
+//
+// Returns:
+// 	The normalized geometric normal, nil if the triangle has no area or the index is of a sphere.
+// 	The area of the triangle.
+//
+func (*Controller) findTriangleGeometry(currentObject *object.Object, triangleIndex int) (*vector.Vector, float64) {
+	if triangleIndex >= len(currentObject.GetTriangles()) {
+		return nil, 0
+	}
+	triangleController := triangle.Controller{}
+	pointController := point.Controller{}
+	vectorController := &vector.Controller{}
+
+	vertices, err := triangleController.GetActualPoints(currentObject.GetTriangles()[triangleIndex],
+		currentObject.GetRepository())
+	if err != nil {
+		return nil, 0
+	}
+	firstEdge, _ := pointController.ExtractVector(vertices[0], vertices[1])
+	secondEdge, _ := pointController.ExtractVector(vertices[0], vertices[2])
+	crossProduct, err := vectorController.CrossProduct(firstEdge, secondEdge)
+	if err != nil {
+		return nil, 0
+	}
+	doubleArea := vectorController.Norm(crossProduct)
+	if doubleArea == 0 {
+		return nil, 0
+	}
+	return vectorController.ScalarMultiplication(crossProduct, 1/doubleArea), doubleArea / 2
+}
+
+// sampleLightPoint samples a point uniformly on the area of all lights.
+//
+// Parameters:
+// 	pathTracer     - The PathTracer.
+// 	triangleSample - A random number in [0,1) used to choose the triangle.
+// 	firstSample    - A random number in [0,1) used to choose the point on the triangle.
+// 	secondSample   - A random number in [0,1) used to choose the point on the triangle.
+//
+// Returns:
+// 	The index of the light that has the point.
+// 	The sampled point.
+// 	The normalized geometric normal of the light at the point.
+//
+func (controller *Controller) sampleLightPoint(pathTracer *PathTracer, triangleSample, firstSample,
+	secondSample float64) (int, *point.Point, *vector.Vector) {
+	distribution := pathTracer.GetLightDistribution()
+	targetArea := triangleSample * distribution.GetTotalArea()
+	distributionIndex := sort.Search(len(distribution.GetCumulativeAreas()), func(index int) bool {
+		return distribution.GetCumulativeAreas()[index] > targetArea
+	})
+	if distributionIndex == len(distribution.GetCumulativeAreas()) {
+		distributionIndex--
+	}
+	lightIndex := distribution.GetLightIndexes()[distributionIndex]
+	triangleIndex := distribution.GetTriangleIndexes()[distributionIndex]
+	lightObject := pathTracer.GetLights()[lightIndex].GetLightObject()
+
+	triangleController := triangle.Controller{}
+	vertices, _ := triangleController.GetActualPoints(lightObject.GetTriangles()[triangleIndex],
+		lightObject.GetRepository())
+	normalVector, _ := controller.findTriangleGeometry(lightObject, triangleIndex)
+
+	// Uniform barycentric coordinates: (1 - sqrt(u), v * sqrt(u), (1 - v) * sqrt(u))
+	squareRootSample := math.Sqrt(firstSample)
+	barycentricCoordinates := []float64{1 - squareRootSample, secondSample * squareRootSample,
+		(1 - secondSample) * squareRootSample}
+	sampledPoint, _ := point.Init(3)
+	for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+		coordinate := 0.0
+		for vertexIndex := 0; vertexIndex < 3; vertexIndex++ {
+			vertexCoordinate, _ := vertices[vertexIndex].GetCoordinate(coordinateIndex)
+			coordinate += barycentricCoordinates[vertexIndex] * vertexCoordinate
+		}
+		_ = sampledPoint.SetCoordinate(coordinateIndex, coordinate)
+	}
+	return lightIndex, sampledPoint, normalVector
+}
+
+// findLightPdf finds the probability density, by solid angle, of sampling a point on the lights.
+//
+// Parameters:
+// 	pathTracer      - The PathTracer.
+// 	lightNormal     - The normalized geometric normal of the light at the point.
+// 	incomingVector  - The normalized direction from the surface towards the point.
+// 	distance        - The distance from the surface to the point.
+//
+// Returns:
+// 	The probability density.
+//
+func (*Controller) findLightPdf(pathTracer *PathTracer, lightNormal, incomingVector *vector.Vector,
+	distance float64) float64 {
+	vectorController := &vector.Controller{}
+	lightCosine, _ := vectorController.DotProduct(lightNormal, incomingVector)
+	lightCosine = math.Abs(lightCosine)
+	if lightCosine == 0 || pathTracer.GetLightDistribution().IsEmpty() {
+		return 0
+	}
+	// The area density 1/A converted to solid angle: d^2 / (|cos| * A)
+	return distance * distance / (lightCosine * pathTracer.GetLightDistribution().GetTotalArea())
+}
+
+// powerHeuristic finds the multiple importance sampling weight of a strategy with the power heuristic.
+//
+// Parameters:
+// 	samplePdf - The probability density of the strategy that made the sample.
+// 	otherPdf  - The probability density of the other strategy for the same sample.
+//
+// Returns:
+// 	The weight.
+//
+func (*Controller) powerHeuristic(samplePdf, otherPdf float64) float64 {
+	if samplePdf <= 0 {
+		return 0
+	}
+	return samplePdf * samplePdf / (samplePdf*samplePdf + otherPdf*otherPdf)
+}
+
+// isVisible checks if there is nothing between a point and a point on a light.
+//
+// Parameters:
+// 	pathTracer   - The PathTracer.
+// 	surfacePoint - The point on the surface.
+// 	lightPoint   - The point on the light.
+//
+// Returns:
+// 	If the point on the light is visible.
+//
+func (controller *Controller) isVisible(pathTracer *PathTracer, surfacePoint, lightPoint *point.Point) bool {
+	lineController := line.Controller{}
+	shadowRay, err := lineController.ExtractLine(surfacePoint, lightPoint)
+	if err != nil {
+		return false
+	}
+	maximumRayParameter := 1 - shadowRayEpsilon
+	hasObjectIntersection, closestLineParameter, _, _, _ := controller.intersectObjects(pathTracer, shadowRay, 0)
+	if hasObjectIntersection && closestLineParameter < maximumRayParameter {
+		return false
+	}
+	hasLightIntersection, closestLightLineParameter, _, _ := controller.intersectLights(pathTracer, shadowRay, 0)
+	return !hasLightIntersection || closestLightLineParameter >= maximumRayParameter
+}
+
+// estimateDirectLight estimates the light arriving directly from the lights and reflected towards the viewer, by
+// sampling a point on the lights and weighting it against the sampling of the material.
+//
+// Parameters:
+// 	pathTracer      - The PathTracer.
+// 	surfaceMaterial - The material of the surface.
+// 	surfacePoint    - The point on the surface.
+// 	normalVector    - The normalized normal of the surface.
+// 	outgoingVector  - The normalized direction towards the viewer.
+// 	pixelSampler    - The Sampler of the numbers of the path.
+//
+// Returns:
+// 	The reflected RGB light.
+//
+func (controller *Controller) estimateDirectLight(pathTracer *PathTracer, surfaceMaterial material.Material,
+	surfacePoint *point.Point, normalVector, outgoingVector *vector.Vector, pixelSampler sampler.Sampler) []float64 {
+	color := make([]float64, 3)
+	if pathTracer.GetLightDistribution().IsEmpty() {
+		return color
+	}
+	pointController := point.Controller{}
+	vectorController := &vector.Controller{}
+
+	triangleSample := pixelSampler.Get1D()
+	firstSample, secondSample := pixelSampler.Get2D()
+	lightIndex, lightPoint, lightNormal := controller.sampleLightPoint(pathTracer, triangleSample, firstSample,
+		secondSample)
+	lightVector, _ := pointController.ExtractVector(surfacePoint, lightPoint)
+	distance := vectorController.Norm(lightVector)
+	if distance == 0 {
+		return color
+	}
+	incomingVector := vectorController.ScalarMultiplication(lightVector, 1/distance)
+	lightPdf := controller.findLightPdf(pathTracer, lightNormal, incomingVector, distance)
+	if lightPdf == 0 {
+		return color
+	}
+
+	reflectance := surfaceMaterial.Evaluate(normalVector, outgoingVector, incomingVector)
+	incomingCosine, _ := vectorController.DotProduct(normalVector, incomingVector)
+	if (reflectance[0] == 0 && reflectance[1] == 0 && reflectance[2] == 0) || incomingCosine == 0 ||
+		!controller.isVisible(pathTracer, surfacePoint, lightPoint) {
+		return color
+	}
+
+	misWeight := controller.powerHeuristic(lightPdf, surfaceMaterial.Pdf(normalVector, outgoingVector,
+		incomingVector))
+	sampledLight := pathTracer.GetLights()[lightIndex]
+	for index := 0; index < 3; index++ {
+		color[index] = reflectance[index] * math.Abs(incomingCosine) * sampledLight.GetColor()[index] *
+			sampledLight.GetLightIntensity() * misWeight / lightPdf
+	}
+	return color
+}
+
 // iterateRay uses a ray to calculate the color.
 //
 // Parameters:
@@ -296,13 +538,14 @@ func (controller *Controller) traceShadowRays(pathTracer *PathTracer, startingPo
 // 	currentIteration - The number of the current iteration.
 //  depthIterations  - Number of depth rays recursions.
 //  currentRay       - The current ray.
+//  pixelSampler     - The Sampler of the numbers of the path.
 //
 // Returns:
 // 	The color found by the ray.
 // 	If the following iteration has intersections.
 //
 func (controller *Controller) iterateRay(pathTracer *PathTracer, currentIteration, depthIterations int,
-	currentRay *line.Line) ([]float64, bool) {
+	currentRay *line.Line, pixelSampler sampler.Sampler) ([]float64, bool) {
 	color := make([]float64, 3)
 
 	var minimumRayParameter float64
@@ -316,7 +559,7 @@ func (controller *Controller) iterateRay(pathTracer *PathTracer, currentIteratio
 	hasObjectIntersection, closestLineParameter, closesObjectIndex, closestTriangleIndex,
 		closestTriangleBarycentricCoordinates := controller.intersectObjects(pathTracer, currentRay,
 			minimumRayParameter)
-	hasLightIntersection, closestLightLineParameter, closestLight := controller.intersectLights(
+	hasLightIntersection, closestLightLineParameter, closestLight, _ := controller.intersectLights(
 		pathTracer, currentRay, minimumRayParameter)
 
 	hasIntersection := hasObjectIntersection || hasLightIntersection
@@ -331,21 +574,23 @@ func (controller *Controller) iterateRay(pathTracer *PathTracer, currentIteratio
 			lineController := line.Controller{}
 			newRayStartingPoint, _ := lineController.FindPoint(currentRay, closestLineParameter)
 			isShadowed := !controller.traceShadowRays(pathTracer, newRayStartingPoint)
-			objectColor := pathTracer.GetObjects()[closesObjectIndex].GetLightCharacteristics().GetColor()
+			objectColor := pathTracer.GetInstances()[closesObjectIndex].GetObject().GetLightCharacteristics().GetColor()
 			if !isShadowed {
 				for index := 0; index < 3; index++ {
 					color[index] = objectColor[index]
 				}
 			}
 			if currentIteration < depthIterations {
-				newRay := controller.findNextRay(pathTracer, newRayStartingPoint,
-					pathTracer.GetObjects()[closesObjectIndex], closestTriangleIndex,
-					closestTriangleBarycentricCoordinates, isShadowed)
-				colorAux, nextHasIntersection := controller.iterateRay(pathTracer, currentIteration+1, depthIterations,
-					newRay)
-				if nextHasIntersection {
-					for index := 0; index < 3; index++ {
-						color[index] = color[index] + colorAux[index]
+				newRay := controller.findNextRay(pathTracer, currentRay, newRayStartingPoint,
+					pathTracer.GetInstances()[closesObjectIndex], closestTriangleIndex,
+					closestTriangleBarycentricCoordinates, isShadowed, pixelSampler)
+				if newRay != nil {
+					colorAux, nextHasIntersection := controller.iterateRay(pathTracer, currentIteration+1,
+						depthIterations, newRay, pixelSampler)
+					if nextHasIntersection {
+						for index := 0; index < 3; index++ {
+							color[index] = color[index] + colorAux[index]
+						}
 					}
 				}
 
@@ -355,132 +600,477 @@ func (controller *Controller) iterateRay(pathTracer *PathTracer, currentIteratio
 	return color, hasIntersection
 }
 
-// parseRaysColorsToRGB traces all primary rays of a pixel.
+// tracePath estimates the color carried by a ray with the rendering equation, weighting the light found at every
+// bounce by the throughput of the path, which is the product of the reflectance function times the cosine divided by
+// the probability density of every sampled direction.
+// The light is found both by sampling points on the lights at every surface and by the sampled directions that hit a
+// light, both weighted with multiple importance sampling.
+// After the first bounces, Russian roulette ends the path with a probability that grows as the throughput falls, and
+// divides the throughput of the surviving paths by their probability to survive, so the estimate stays unbiased.
 //
 // Parameters:
-// 	raysColors   - The colors found by the rays.
-// 	numberOfRays - The number of rays.
-// 	recursions   - The number of recursions.
+// 	pathTracer           - The PathTracer.
+//  depthIterations      - Number of depth rays recursions.
+//  russianRouletteDepth - The number of bounces the path makes before Russian roulette can end it.
+//  currentRay           - The primary ray.
+//  pixelSampler         - The Sampler of the numbers of the path.
 //
 // Returns:
-// 	The average of the colors as RGB.
+// 	The color found by the ray.
 //
-func (controller *Controller) parseRaysColorsToRGB(raysColors [][]float64, numberOfRays, recursions int) []int {
+func (controller *Controller) tracePath(pathTracer *PathTracer, depthIterations, russianRouletteDepth int,
+	currentRay *line.Line, pixelSampler sampler.Sampler) []float64 {
 	color := make([]float64, 3)
-	for rayIndex := 0; rayIndex < numberOfRays; rayIndex++ {
+	throughput := []float64{1, 1, 1}
+	lineController := line.Controller{}
+	vectorController := &vector.Controller{}
+	minimumRayParameter := 1.0
+	previousPdf := 0.0
+	previousIsDelta := true
+
+	for currentIteration := 0; currentIteration <= depthIterations; currentIteration++ {
+		hasObjectIntersection, closestLineParameter, closestObjectIndex, closestTriangleIndex,
+			closestTriangleBarycentricCoordinates := controller.intersectObjects(pathTracer, currentRay,
+				minimumRayParameter)
+		hasLightIntersection, closestLightLineParameter, closestLight, closestLightTriangle :=
+			controller.intersectLights(pathTracer, currentRay, minimumRayParameter)
+		minimumRayParameter = 0
+
+		if hasLightIntersection && closestLightLineParameter <= closestLineParameter {
+			intersectedLight := pathTracer.GetLights()[closestLight]
+			misWeight := 1.0
+			if !previousIsDelta {
+				lightNormal, _ := controller.findTriangleGeometry(intersectedLight.GetLightObject(),
+					closestLightTriangle)
+				// Spheres are not sampled on the lights, so only the material can find them.
+				if lightNormal != nil {
+					distance := closestLightLineParameter * vectorController.Norm(currentRay.GetVectorDirector())
+					lightPdf := controller.findLightPdf(pathTracer, lightNormal,
+						vectorController.Normalize(currentRay.GetVectorDirector()), distance)
+					misWeight = controller.powerHeuristic(previousPdf, lightPdf)
+				}
+			}
+			for index := 0; index < 3; index++ {
+				color[index] += throughput[index] * intersectedLight.GetColor()[index] *
+					intersectedLight.GetLightIntensity() * misWeight
+			}
+			break
+		}
+		if !hasObjectIntersection || currentIteration == depthIterations {
+			break
+		}
+
+		surfaceMaterial := pathTracer.GetMaterials()[closestObjectIndex]
+		intersectedInstance := pathTracer.GetInstances()[closestObjectIndex]
+		newRayStartingPoint, _ := lineController.FindPoint(currentRay, closestLineParameter)
+		normalVector := controller.findNormal(intersectedInstance, closestTriangleIndex,
+			closestTriangleBarycentricCoordinates, newRayStartingPoint)
+		outgoingVector := vectorController.Normalize(vectorController.ScalarMultiplication(
+			currentRay.GetVectorDirector(), -1))
+
+		if !surfaceMaterial.IsDelta() {
+			directLight := controller.estimateDirectLight(pathTracer, surfaceMaterial, newRayStartingPoint,
+				normalVector, outgoingVector, pixelSampler)
+			for index := 0; index < 3; index++ {
+				color[index] += throughput[index] * directLight[index]
+			}
+		}
+
+		firstSample, secondSample := pixelSampler.Get2D()
+		materialSample, isSampled := surfaceMaterial.SampleDirection(normalVector, outgoingVector, firstSample,
+			secondSample)
+		if !isSampled {
+			break
+		}
+		for index := 0; index < 3; index++ {
+			throughput[index] *= materialSample.GetWeight()[index]
+		}
+		previousPdf = materialSample.GetPdf()
+		previousIsDelta = materialSample.IsDelta()
+
+		if currentIteration >= russianRouletteDepth {
+			survivalProbability := math.Min(1, math.Max(throughput[0], math.Max(throughput[1], throughput[2])))
+			if pixelSampler.Get1D() >= survivalProbability {
+				break
+			}
+			for index := 0; index < 3; index++ {
+				throughput[index] /= survivalProbability
+			}
+		}
+
+		currentRay, _ = line.Init(newRayStartingPoint, materialSample.GetDirection())
+	}
+	return color
+}
+
+// averageRaysColors averages the colors found by the primary rays of a pixel.
+//
+// Parameters:
+// 	raysColors          - The colors found by the rays.
+// 	normalizationFactor - The number the sum of the colors is divided by.
+//
+// Returns:
+// 	The average of the colors as RGB radiance.
+//
+func (controller *Controller) averageRaysColors(raysColors [][]float64, normalizationFactor float64) []float64 {
+	color := make([]float64, 3)
+	for rayIndex := 0; rayIndex < len(raysColors); rayIndex++ {
 		for rayColorCoordinateIndex := 0; rayColorCoordinateIndex < 3; rayColorCoordinateIndex++ {
 			color[rayColorCoordinateIndex] = color[rayColorCoordinateIndex] + raysColors[rayIndex][
 			rayColorCoordinateIndex]
 		}
 	}
 
-	rgbColor := make([]int, 3)
 	for index := 0; index < 3; index++ {
-		rgbColor[index] = int(math.Floor(color[index]/float64(numberOfRays*(recursions+1)) * 255))
-		if rgbColor[index] > 255 {
-			rgbColor[index] = 255
-		} else if rgbColor[index] < 0 {
-			rgbColor[index] = 0
-		}
+		color[index] = color[index] / normalizationFactor
 	}
-	return rgbColor
+	return color
 }
 
-// traceFirstRays traces all primary rays of a pixel.
+// findFiniteRadiance keeps the radiance of a primary ray that is finite, and replaces by black the radiance with a NaN
+// or infinite channel, so a broken sample does not spoil the sums of its pixel.
 //
 // Parameters:
-// 	pathTracer      - The PathTracer.
-// 	lineIndex       - Pixel line index.
-//  columnIndex     - Pixel column index.
-//  numberOfRays    - Number of rays per pixel.
-//  depthIterations - Number of depth rays recursions.
+// 	radiance - The RGB radiance of the ray.
 //
 // Returns:
-// 	The RGB color of the pixel.
+// 	The finite RGB radiance.
 //
-func (controller *Controller) traceFirstRays(pathTracer *PathTracer, lineIndex, columnIndex, numberOfRays,
-	depthIterations int) []int {
-	rand.Seed(time.Now().UnixNano())
-	floatColors := make([][]float64, numberOfRays)
-	lock := thread_locker.Init()
-	cameraController := &camera.Controller{}
-	cameraToWorldMatrix := cameraController.CameraToWorldMatrix(pathTracer.GetSceneCamera())
-	maxNumberOfThreads, err := strconv.Atoi(os.Getenv("NUMBER_OF_THREADS"))
-	if err != nil {
-		log.Fatal(err)
-	}
-	for rayIndex := 0; rayIndex < numberOfRays; rayIndex++ {
-		if lock.GetThreads() < maxNumberOfThreads {
-			lock.AddThread()
-			go func(threadRayIndex int) {
-				pixelLineOffset := rand.Float64()
-				pixelColumnOffset := rand.Float64()
-
-				screenController := &screen.Controller{}
-				rayVectorDirector, _ := screenController.BuildRayVectorDirectorToPixel(lineIndex, columnIndex,
-					pixelLineOffset, pixelColumnOffset, cameraToWorldMatrix, pathTracer.GetPixelScreen(),
-					pathTracer.GetSceneCamera())
-
-				currentRay, _ := line.Init(pathTracer.GetSceneCamera().GetPosition(), rayVectorDirector)
-				currentRayReturnedColor, _ := controller.iterateRay(pathTracer, 0, depthIterations, currentRay)
-				floatColors[threadRayIndex] = currentRayReturnedColor
-				lock.RemoveThread()
-			}(rayIndex)
-		} else {
-			time.Sleep(20)
-			rayIndex--
+func (*Controller) findFiniteRadiance(radiance []float64) []float64 {
+	for _, channel := range radiance {
+		if math.IsNaN(channel) || math.IsInf(channel, 0) {
+			return make([]float64, len(radiance))
 		}
 	}
-	ended := false
-	for !ended {
-		if lock.GetThreads() == 0 {
-			ended = true
-		} else {
-			time.Sleep(25)
+	return radiance
+}
+
+// findLuminance finds the luminance of an RGB radiance.
+//
+// Parameters:
+// 	radiance - The RGB radiance.
+//
+// Returns:
+// 	The luminance.
+//
+func (*Controller) findLuminance(radiance []float64) float64 {
+	return 0.2126*radiance[0] + 0.7152*radiance[1] + 0.0722*radiance[2]
+}
+
+// findMaximumSamplesPerPixel finds the most primary rays a pixel traces, which is the number of rays per pixel without
+// adaptive sampling.
+//
+// Parameters:
+// 	parameters - The parameters of the path tracing.
+//
+// Returns:
+// 	The maximum number of rays.
+//
+func (*Controller) findMaximumSamplesPerPixel(parameters *Parameters) int {
+	if parameters.GetAdaptiveSampling() == nil {
+		return parameters.GetRaysPerPixel()
+	}
+	return parameters.GetAdaptiveSampling().GetMaximumSamples()
+}
+
+// traceRay traces a primary ray of a pixel.
+// The ray starts a sample of the Sampler from the pixel and the index of the ray, so the radiance does not depend on
+// which worker traces the pixel.
+//
+// Parameters:
+// 	pathTracer          - The PathTracer.
+// 	parameters          - The parameters of the path tracing.
+// 	cameraToWorldMatrix - The matrix from camera to world.
+// 	lineIndex           - Pixel line index.
+// 	columnIndex         - Pixel column index.
+// 	rayIndex            - The index of the ray in the pixel.
+// 	pixelSampler        - The Sampler of the worker.
+//
+// Returns:
+// 	The color found by the ray.
+//
+func (controller *Controller) traceRay(pathTracer *PathTracer, parameters *Parameters,
+	cameraToWorldMatrix *matrix.Matrix, lineIndex, columnIndex, rayIndex int, pixelSampler sampler.Sampler) []float64 {
+	screenController := &screen.Controller{}
+	pixelSampler.StartPixelSample(lineIndex, columnIndex, rayIndex)
+	pixelLineOffset, pixelColumnOffset := pixelSampler.Get2D()
+
+	rayVectorDirector, _ := screenController.BuildRayVectorDirectorToPixel(lineIndex, columnIndex,
+		pixelLineOffset, pixelColumnOffset, cameraToWorldMatrix, pathTracer.GetPixelScreen(),
+		pathTracer.GetSceneCamera())
+
+	currentRay, _ := line.Init(pathTracer.GetSceneCamera().GetPosition(), rayVectorDirector)
+	if parameters.GetEstimator() == UnbiasedEstimator {
+		return controller.tracePath(pathTracer, parameters.GetRecursions(), parameters.GetRussianRouletteDepth(),
+			currentRay, pixelSampler)
+	}
+	color, _ := controller.iterateRay(pathTracer, 0, parameters.GetRecursions(), currentRay, pixelSampler)
+	return color
+}
+
+// traceFirstRays traces the primary rays of a pixel. The rays whose radiance is not finite count as black.
+// With adaptive sampling, the pixel stops tracing rays once the luminance of its rays converged, keeping the running
+// mean and sum of squared deviations of the luminance with Welford's algorithm.
+//
+// Parameters:
+// 	pathTracer          - The PathTracer.
+// 	parameters          - The parameters of the path tracing.
+// 	cameraToWorldMatrix - The matrix from camera to world.
+// 	lineIndex           - Pixel line index.
+//  columnIndex         - Pixel column index.
+//  firstRayIndex       - The index of the first ray in the pixel, after the rays of the previous passes.
+//  pixelSampler        - The Sampler of the worker.
+//
+// Returns:
+// 	The RGB radiance of the pixel.
+// 	The number of rays traced.
+//
+func (controller *Controller) traceFirstRays(pathTracer *PathTracer, parameters *Parameters,
+	cameraToWorldMatrix *matrix.Matrix, lineIndex, columnIndex, firstRayIndex int, pixelSampler sampler.Sampler) (
+	[]float64, int) {
+	maximumSamples := controller.findMaximumSamplesPerPixel(parameters)
+	adaptiveSampling := parameters.GetAdaptiveSampling()
+	floatColors := make([][]float64, 0, maximumSamples)
+	luminanceMean := 0.0
+	luminanceSquaredDeviations := 0.0
+	for rayIndex := 0; rayIndex < maximumSamples; rayIndex++ {
+		rayColor := controller.findFiniteRadiance(controller.traceRay(pathTracer, parameters, cameraToWorldMatrix,
+			lineIndex, columnIndex, firstRayIndex+rayIndex, pixelSampler))
+		floatColors = append(floatColors, rayColor)
+		if adaptiveSampling == nil {
+			continue
+		}
+
+		luminance := controller.findLuminance(rayColor)
+		deviation := luminance - luminanceMean
+		luminanceMean += deviation / float64(len(floatColors))
+		luminanceSquaredDeviations += deviation * (luminance - luminanceMean)
+		if adaptiveSampling.IsConverged(len(floatColors), luminanceMean, luminanceSquaredDeviations) {
+			break
 		}
 	}
 
+	numberOfRays := len(floatColors)
+	if parameters.GetEstimator() == UnbiasedEstimator {
+		return controller.averageRaysColors(floatColors, float64(numberOfRays)), numberOfRays
+	}
+	return controller.averageRaysColors(floatColors, float64(numberOfRays*(parameters.GetRecursions()+1))),
+		numberOfRays
+}
 
-	return controller.parseRaysColorsToRGB(floatColors, numberOfRays, depthIterations)
+// traceTile traces all pixels of a tile.
+//
+// Parameters:
+// 	pathTracer          - The PathTracer.
+// 	parameters          - The parameters of the path tracing.
+// 	cameraToWorldMatrix - The matrix from camera to world.
+// 	currentTile         - The tile.
+// 	radianceMatrix      - The radiance matrix that receives the radiance of the pixels.
+// 	firstRayIndex       - The index of the first ray of every pixel, after the rays of the previous passes.
+// 	pixelSampler        - The Sampler of the worker.
+//
+// Returns:
+// 	The number of primary rays traced.
+//
+func (controller *Controller) traceTile(pathTracer *PathTracer, parameters *Parameters,
+	cameraToWorldMatrix *matrix.Matrix, currentTile *tile.Tile, radianceMatrix *radiance_matrix.RadianceMatrix,
+	firstRayIndex int, pixelSampler sampler.Sampler) int {
+	tracedSamples := 0
+	for lineIndex := currentTile.GetStartLine(); lineIndex < currentTile.GetEndLine(); lineIndex++ {
+		for columnIndex := currentTile.GetStartColumn(); columnIndex < currentTile.GetEndColumn(); columnIndex++ {
+			pixelRadiance, pixelSamples := controller.traceFirstRays(pathTracer, parameters, cameraToWorldMatrix,
+				lineIndex, columnIndex, firstRayIndex, pixelSampler)
+			_ = radianceMatrix.SetRadiance(lineIndex, columnIndex, pixelRadiance)
+			tracedSamples += pixelSamples
+		}
+	}
+	return tracedSamples
+}
 
+// findNumberOfWorkers finds the number of workers that render tiles at the same time, from the NUMBER_OF_THREADS
+// environment variable or the number of CPUs when it is not set.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of workers.
+//
+func (*Controller) findNumberOfWorkers() int {
+	numberOfWorkers, err := strconv.Atoi(os.Getenv("NUMBER_OF_THREADS"))
+	if err != nil || numberOfWorkers < 1 {
+		return runtime.NumCPU()
+	}
+	return numberOfWorkers
 }
 
-// Run runs the path tracing.
+// ValidateParameters checks if the parameters can run the path tracing of a PathTracer.
 //
 // Parameters:
-// 	pathTracer        - The PathTracer.
-// 	raysPerPixel      - The number of rays per pixel.
-// 	recursions        - The number recursions of each ray.
-// 	windowStartLine   - The starting line index of the window of the screen to use the path tracing.
-// 	windowStartColumn - The starting column index of the window of the screen to use the path tracing.
-// 	windowEndLine     - The ending line index of the window of the screen to use the path tracing.
-// 	windowEndColumn   - The ending column index of the window of the screen to use the path tracing.
+// 	pathTracer - The PathTracer.
+// 	parameters - The parameters of the path tracing.
 //
 // Returns:
-// 	The color matrix representing the rendered image.
+// 	An error.
 //
-func (controller *Controller) Run(pathTracer *PathTracer, raysPerPixel, recursions, windowStartLine, windowStartColumn,
-	windowEndLine, windowEndColumn int) (*color_matrix.ColorMatrix, error) {
+func (*Controller) ValidateParameters(pathTracer *PathTracer, parameters *Parameters) error {
+	windowStartLine := parameters.GetWindowStartLine()
+	windowStartColumn := parameters.GetWindowStartColumn()
+	windowEndLine := parameters.GetWindowEndLine()
+	windowEndColumn := parameters.GetWindowEndColumn()
 	if windowStartLine < 0 ||
 		windowStartLine > windowEndLine ||
 		windowEndLine > pathTracer.GetPixelScreen().GetHeight() ||
 		windowStartColumn < 0 ||
 		windowStartColumn > windowEndColumn ||
 		windowEndColumn > pathTracer.GetPixelScreen().GetWidth() {
-		return nil, windowError(pathTracer, windowStartLine, windowStartColumn, windowEndLine, windowEndColumn)
+		return windowError(pathTracer, windowStartLine, windowStartColumn, windowEndLine, windowEndColumn)
 	}
 
-	if raysPerPixel < 1 || recursions < 1 {
-		return nil, raysError(raysPerPixel, recursions)
+	if parameters.GetRaysPerPixel() < 1 ||
+		parameters.GetRecursions() < 1 ||
+		parameters.GetRecursions() > MaxRecursions {
+		return raysError(parameters.GetRaysPerPixel(), parameters.GetRecursions())
 	}
+	return nil
+}
+
+// runPass runs a pass of the path tracing, where every pixel traces its rays after the rays of the previous passes.
+// The window is split in tiles that a fixed pool of workers consumes from a channel, and the workers stop taking
+// tiles once the context is canceled.
+//
+// Parameters:
+// 	ctx               - The context that cancels the pass.
+// 	pathTracer        - The PathTracer.
+// 	parameters        - The parameters of the path tracing.
+// 	passIndex         - The index of the pass.
+// 	progressiveRender - The ProgressiveRender that counts the rendered tiles and notifies their progress.
+//
+// Returns:
+// 	The radiance matrix of the pass.
+// 	An error.
+//
+func (controller *Controller) runPass(ctx context.Context, pathTracer *PathTracer, parameters *Parameters,
+	passIndex int, progressiveRender *ProgressiveRender) (*radiance_matrix.RadianceMatrix, error) {
+	maximumSamples := controller.findMaximumSamplesPerPixel(parameters)
+
+	// The window and the tile size were validated before the first pass.
+	tileController := tile.Controller{}
+	tiles, _ := tileController.SplitWindow(parameters.GetWindowStartLine(), parameters.GetWindowStartColumn(),
+		parameters.GetWindowEndLine(), parameters.GetWindowEndColumn(), parameters.GetTileSize())
 
-	colorMatrix := color_matrix.Init(pathTracer.pixelScreen)
-	for lineIndex := windowStartLine; lineIndex < windowEndLine; lineIndex++ {
-		for columnIndex := windowStartColumn; columnIndex < windowEndColumn; columnIndex++ {
-			pixelColor := controller.traceFirstRays(pathTracer, lineIndex, columnIndex, raysPerPixel, recursions)
-			_ = colorMatrix.SetColor(lineIndex, columnIndex, pixelColor)
+	cameraController := &camera.Controller{}
+	cameraToWorldMatrix := cameraController.CameraToWorldMatrix(pathTracer.GetSceneCamera())
+	radianceMatrix := radiance_matrix.Init(pathTracer.pixelScreen)
+
+	tilesChannel := make(chan *tile.Tile, len(tiles))
+	for _, currentTile := range tiles {
+		tilesChannel <- currentTile
+	}
+	close(tilesChannel)
+
+	var workers sync.WaitGroup
+	for workerIndex := 0; workerIndex < controller.findNumberOfWorkers(); workerIndex++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			pixelSampler, _ := sampler.Init(parameters.GetSampler(), maximumSamples*parameters.GetPasses(),
+				parameters.GetSeed())
+			for currentTile := range tilesChannel {
+				if ctx.Err() != nil {
+					return
+				}
+				tracedSamples := controller.traceTile(pathTracer, parameters, cameraToWorldMatrix, currentTile,
+					radianceMatrix, passIndex*maximumSamples, pixelSampler)
+				progressiveRender.addRenderedTile(currentTile, tracedSamples)
+			}
+		}()
+	}
+	workers.Wait()
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return radianceMatrix, nil
+}
+
+// RunProgressive runs the passes of the path tracing, adding each pass to a ProgressiveRender as soon as it is done.
+// The ProgressiveRender keeps the error when the path tracing cannot run or the context is canceled, and the passes
+// completed before the cancellation.
+//
+// Parameters:
+// 	ctx               - The context that cancels the path tracing.
+// 	pathTracer        - The PathTracer.
+// 	parameters        - The parameters of the path tracing.
+// 	progressiveRender - The ProgressiveRender that accumulates the passes.
+//
+// Returns:
+// 	An error.
+//
+func (controller *Controller) RunProgressive(ctx context.Context, pathTracer *PathTracer, parameters *Parameters,
+	progressiveRender *ProgressiveRender) error {
+	err := controller.ValidateParameters(pathTracer, parameters)
+	if err != nil {
+		progressiveRender.fail(err)
+		return err
+	}
+
+	tileController := tile.Controller{}
+	tiles, err := tileController.SplitWindow(parameters.GetWindowStartLine(), parameters.GetWindowStartColumn(),
+		parameters.GetWindowEndLine(), parameters.GetWindowEndColumn(), parameters.GetTileSize())
+	if err != nil {
+		progressiveRender.fail(err)
+		return err
+	}
+	progressiveRender.start(len(tiles))
+
+	for passIndex := 0; passIndex < parameters.GetPasses(); passIndex++ {
+		passRadiance, err := controller.runPass(ctx, pathTracer, parameters, passIndex, progressiveRender)
+		if err != nil {
+			progressiveRender.fail(err)
+			return err
 		}
-		fmt.Println(100*float64(lineIndex-windowStartLine)/float64(windowEndLine-windowStartLine),"%")
+		progressiveRender.addPass(passRadiance)
+	}
+	return nil
+}
+
+// RunRadiance runs all passes of the path tracing, keeping the high dynamic range radiance of the pixels.
+//
+// Parameters:
+// 	ctx        - The context that cancels the path tracing.
+// 	pathTracer - The PathTracer.
+// 	parameters - The parameters of the path tracing.
+//
+// Returns:
+// 	The radiance matrix representing the rendered image.
+// 	An error.
+//
+func (controller *Controller) RunRadiance(ctx context.Context, pathTracer *PathTracer, parameters *Parameters) (
+	*radiance_matrix.RadianceMatrix, error) {
+	progressiveRender := InitProgressiveRender(pathTracer, parameters)
+	err := controller.RunProgressive(ctx, pathTracer, parameters, progressiveRender)
+	if err != nil {
+		return nil, err
+	}
+	return progressiveRender.GetEstimate(), nil
+}
+
+// Run runs the path tracing.
+//
+// Parameters:
+// 	ctx        - The context that cancels the path tracing.
+// 	pathTracer - The PathTracer.
+// 	parameters - The parameters of the path tracing.
+//
+// Returns:
+// 	The color matrix representing the rendered image, tone mapped as the parameters ask.
+//
+func (controller *Controller) Run(ctx context.Context, pathTracer *PathTracer, parameters *Parameters) (
+	*color_matrix.ColorMatrix, error) {
+	radianceMatrix, err := controller.RunRadiance(ctx, pathTracer, parameters)
+	if err != nil {
+		return nil, err
 	}
-	return colorMatrix, nil
+	radianceMatrixController := radiance_matrix.Controller{}
+	return radianceMatrixController.ToColorMatrix(radianceMatrix, parameters.GetToneMapping()), nil
 }
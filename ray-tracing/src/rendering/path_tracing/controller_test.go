@@ -0,0 +1,1050 @@
+package path_tracing
+
+import (
+	"context"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/line"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/camera"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/instance"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/light"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/material"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/point_repository"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/radiance_matrix"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/sampler"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/screen"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tone_mapping"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/math_helper"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/matrix"
+	"math"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// buildVector builds a vector.
+//
+// Parameters:
+//  t           - Test instance.
+//  coordinates - The coordinates of the vector.
+//
+// Returns:
+//  A vector.
+//
+func buildVector(t *testing.T, coordinates []float64) *vector.Vector {
+	builtVector, err := vector.Init(len(coordinates))
+	test_helpers.AssertNilError(t, err)
+	for coordinateIndex, coordinate := range coordinates {
+		err = builtVector.SetCoordinate(coordinateIndex, coordinate)
+		test_helpers.AssertNilError(t, err)
+	}
+	return builtVector
+}
+
+// assertVectorCoordinates asserts the coordinates of a vector with tolerance.
+//
+// Parameters:
+//  t                    - Test instance.
+//  expectedCoordinates  - The expected coordinates.
+//  receivedVector       - The received vector.
+//
+// Returns:
+//  none
+//
+func assertVectorCoordinates(t *testing.T, expectedCoordinates []float64, receivedVector *vector.Vector) {
+	mathHelper := math_helper.Init(0.0000001)
+	for coordinateIndex, expectedCoordinate := range expectedCoordinates {
+		receivedCoordinate, err := receivedVector.GetCoordinate(coordinateIndex)
+		test_helpers.AssertNilError(t, err)
+		test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(expectedCoordinate, receivedCoordinate))
+	}
+}
+
+// buildDielectricObject builds an object that only transmits light.
+//
+// Parameters:
+//  t               - Test instance.
+//  refractiveIndex - The index of refraction of the object.
+//
+// Returns:
+//  An object.
+//
+func buildDielectricObject(t *testing.T, refractiveIndex float64) *object.Object {
+	firstPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	repository, err := point_repository.Init([]*point.Point{firstPoint}, 3)
+	test_helpers.AssertNilError(t, err)
+	dielectricObject, err := object.Init("dielectric", repository, []*triangle.Triangle{}, nil, []*vector.Vector{},
+		[]float64{1, 1, 1}, 0, 0, 1, 0, refractiveIndex, nil)
+	test_helpers.AssertNilError(t, err)
+	return dielectricObject
+}
+
+// TestController_FindDiffuseReflectionVector tests that the diffuse rays stay above the surface with the mean cosine
+// of the cosine weighted hemisphere.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FindDiffuseReflectionVector(t *testing.T) {
+	controller := Controller{}
+	vectorController := &vector.Controller{}
+	startingPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	normalVector := vectorController.Normalize(buildVector(t, []float64{1, 1, -1}))
+
+	numberOfSamples := 10000
+	sumOfCosines := 0.0
+	pixelSampler := sampler.InitIndependent(1)
+	for sampleIndex := 0; sampleIndex < numberOfSamples; sampleIndex++ {
+		diffuseVector := controller.findDiffuseReflectionVector(startingPoint, normalVector, pixelSampler)
+		cosine, err := vectorController.DotProduct(normalVector, diffuseVector)
+		test_helpers.AssertNilError(t, err)
+		test_helpers.AssertEqual(t, true, cosine >= 0)
+		sumOfCosines += cosine
+	}
+	test_helpers.AssertEqual(t, true, math.Abs(sumOfCosines/float64(numberOfSamples)-2.0/3) < 0.01)
+}
+
+// TestController_FindTransmissionVector_TotalInternalReflection tests the transmission of a ray leaving an object
+// beyond the critical angle.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FindTransmissionVector_TotalInternalReflection(t *testing.T) {
+	controller := Controller{}
+	dielectricObject := buildDielectricObject(t, 1.5)
+	startingPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	currentRay, err := line.Init(startingPoint, buildVector(t, []float64{math.Sqrt(3) / 2, 0.5, 0}))
+	test_helpers.AssertNilError(t, err)
+	normalVector := buildVector(t, []float64{0, 1, 0})
+
+	transmissionVector := controller.findTransmissionVector(currentRay, dielectricObject, normalVector,
+		sampler.InitIndependent(1))
+	assertVectorCoordinates(t, []float64{math.Sqrt(3) / 2, -0.5, 0}, transmissionVector)
+}
+
+// TestController_FindTransmissionVector tests the transmission of a ray entering an object.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FindTransmissionVector(t *testing.T) {
+	controller := Controller{}
+	dielectricObject := buildDielectricObject(t, 1.5)
+	startingPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	currentRay, err := line.Init(startingPoint, buildVector(t, []float64{0, -2, 0}))
+	test_helpers.AssertNilError(t, err)
+	normalVector := buildVector(t, []float64{0, 1, 0})
+
+	numberOfReflections := 0
+	numberOfSamples := 10000
+	pixelSampler := sampler.InitIndependent(1)
+	for sampleIndex := 0; sampleIndex < numberOfSamples; sampleIndex++ {
+		transmissionVector := controller.findTransmissionVector(currentRay, dielectricObject, normalVector,
+			pixelSampler)
+		secondCoordinate, err := transmissionVector.GetCoordinate(1)
+		test_helpers.AssertNilError(t, err)
+		if secondCoordinate > 0 {
+			assertVectorCoordinates(t, []float64{0, 1, 0}, transmissionVector)
+			numberOfReflections++
+		} else {
+			assertVectorCoordinates(t, []float64{0, -1, 0}, transmissionVector)
+		}
+	}
+	reflectedFraction := float64(numberOfReflections) / float64(numberOfSamples)
+	test_helpers.AssertEqual(t, true, reflectedFraction > 0.02 && reflectedFraction < 0.06)
+}
+
+// buildTriangleMesh builds an object whose triangles follow the given vertices.
+//
+// Parameters:
+//  t               - Test instance.
+//  vertices        - The coordinates of the vertices, three per triangle.
+//  normal          - The coordinates of the normal of every vertex.
+//  specular        - The percentage of specular rays, the remaining being diffuse.
+//  surfaceMaterial - The material of the object.
+//
+// Returns:
+//  An object.
+//
+func buildTriangleMesh(t *testing.T, vertices [][]float64, normal []float64, specular float64,
+	surfaceMaterial material.Material) *object.Object {
+	points := make([]*point.Point, len(vertices))
+	for vertexIndex, coordinates := range vertices {
+		currentPoint, err := point.Init(3)
+		test_helpers.AssertNilError(t, err)
+		for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+			err = currentPoint.SetCoordinate(coordinateIndex, coordinates[coordinateIndex])
+			test_helpers.AssertNilError(t, err)
+		}
+		points[vertexIndex] = currentPoint
+	}
+	triangles := make([]*triangle.Triangle, len(vertices)/3)
+	for triangleIndex := 0; triangleIndex < len(triangles); triangleIndex++ {
+		currentTriangle, err := triangle.Init(
+			[]int{3 * triangleIndex, 3*triangleIndex + 1, 3*triangleIndex + 2}, []int{0, 0, 0})
+		test_helpers.AssertNilError(t, err)
+		triangles[triangleIndex] = currentTriangle
+	}
+	repository, err := point_repository.Init(points, 3)
+	test_helpers.AssertNilError(t, err)
+
+	mesh, err := object.Init("mesh", repository, triangles, nil, []*vector.Vector{buildVector(t, normal)},
+		[]float64{0.5, 0.5, 0.5}, specular, 0, 0, 1-specular, 1, surfaceMaterial)
+	test_helpers.AssertNilError(t, err)
+	return mesh
+}
+
+// buildMirrorScene builds a scene with a mirror floor on z = 0 and a light on the plane x = 10.
+//
+// Parameters:
+//  t             - Test instance.
+//  floorMaterial - The material of the floor, nil to use the percentages.
+//
+// Returns:
+//  A PathTracer.
+//
+func buildMirrorScene(t *testing.T, floorMaterial material.Material) *PathTracer {
+	floor := buildTriangleMesh(t, [][]float64{{-20, -20, 0}, {20, -20, 0}, {20, 20, 0}, {-20, -20, 0}, {20, 20, 0},
+		{-20, 20, 0}}, []float64{0, 0, 1}, 1, floorMaterial)
+	lightObject := buildTriangleMesh(t, [][]float64{{10, -5, 0}, {10, 5, 0}, {10, 0, 10}}, []float64{-1, 0, 0}, 0,
+		nil)
+	sceneLight, err := light.Init(2, lightObject, []float64{1, 0.5, 0.25})
+	test_helpers.AssertNilError(t, err)
+
+	pathTracer, err := Init([]*object.Object{floor}, nil, nil, []*light.Light{sceneLight})
+	test_helpers.AssertNilError(t, err)
+	return pathTracer
+}
+
+// TestController_TracePath tests the throughput of a path reflected by a mirror into a light.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_TracePath(t *testing.T) {
+	controller := Controller{}
+	mirror, err := material.InitMirror([]float64{0.5, 0.5, 0.5})
+	test_helpers.AssertNilError(t, err)
+	startingPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	err = startingPoint.SetCoordinate(2, 5)
+	test_helpers.AssertNilError(t, err)
+	currentRay, err := line.Init(startingPoint, buildVector(t, []float64{1, 0, -1}))
+	test_helpers.AssertNilError(t, err)
+
+	expectedColor := []float64{0.5 * 1 * 2, 0.5 * 0.5 * 2, 0.5 * 0.25 * 2}
+	for _, pathTracer := range []*PathTracer{buildMirrorScene(t, mirror), buildMirrorScene(t, nil)} {
+		for _, depthIterations := range []int{1, 2, 5} {
+			color := controller.tracePath(pathTracer, depthIterations, depthIterations, currentRay,
+				sampler.InitIndependent(1))
+			test_helpers.AssertEqual(t, true, reflect.DeepEqual(expectedColor, color))
+		}
+	}
+}
+
+// TestController_TracePath_RussianRoulette tests that Russian roulette keeps the mean color of a path reflected by a
+// half reflective mirror into a light, by ending half of the paths and doubling the others.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_TracePath_RussianRoulette(t *testing.T) {
+	controller := Controller{}
+	mirror, err := material.InitMirror([]float64{0.5, 0.5, 0.5})
+	test_helpers.AssertNilError(t, err)
+	startingPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	err = startingPoint.SetCoordinate(2, 5)
+	test_helpers.AssertNilError(t, err)
+	currentRay, err := line.Init(startingPoint, buildVector(t, []float64{1, 0, -1}))
+	test_helpers.AssertNilError(t, err)
+
+	expectedColor := []float64{0.5 * 1 * 2, 0.5 * 0.5 * 2, 0.5 * 0.25 * 2}
+	pathTracer := buildMirrorScene(t, mirror)
+	pixelSampler := sampler.InitIndependent(1)
+	numberOfSamples := 10000
+	numberOfEndedPaths := 0
+	averageColor := make([]float64, 3)
+	for sampleIndex := 0; sampleIndex < numberOfSamples; sampleIndex++ {
+		color := controller.tracePath(pathTracer, 5, 0, currentRay, pixelSampler)
+		if reflect.DeepEqual([]float64{0, 0, 0}, color) {
+			numberOfEndedPaths++
+		} else {
+			for index := 0; index < 3; index++ {
+				test_helpers.AssertEqual(t, 2*expectedColor[index], color[index])
+			}
+		}
+		for index := 0; index < 3; index++ {
+			averageColor[index] += color[index] / float64(numberOfSamples)
+		}
+	}
+	test_helpers.AssertEqual(t, true, math.Abs(float64(numberOfEndedPaths)/float64(numberOfSamples)-0.5) < 0.02)
+	for index := 0; index < 3; index++ {
+		test_helpers.AssertEqual(t, true,
+			math.Abs(averageColor[index]-expectedColor[index]) < 0.04*expectedColor[index])
+	}
+}
+
+// TestController_TracePath_NoIntersection tests the color of a path that leaves the scene.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_TracePath_NoIntersection(t *testing.T) {
+	controller := Controller{}
+	startingPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	err = startingPoint.SetCoordinate(2, 5)
+	test_helpers.AssertNilError(t, err)
+	currentRay, err := line.Init(startingPoint, buildVector(t, []float64{0, 0, 1}))
+	test_helpers.AssertNilError(t, err)
+
+	color := controller.tracePath(buildMirrorScene(t, nil), 5, 5, currentRay, sampler.InitIndependent(1))
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0, 0, 0}, color))
+}
+
+// buildAreaLightScene builds a scene with a lambertian floor on the plane z = 0 under a triangular light on the plane
+// z = 1, with an optional black occluder between them.
+//
+// Parameters:
+//  t           - Test instance.
+//  hasOccluder - If the occluder must be on the scene.
+//
+// Returns:
+//  A PathTracer.
+//
+func buildAreaLightScene(t *testing.T, hasOccluder bool) *PathTracer {
+	lambertian, err := material.InitLambertian([]float64{0.5, 0.5, 0.5})
+	test_helpers.AssertNilError(t, err)
+	floor := buildTriangleMesh(t, [][]float64{{-20, -20, 0}, {20, -20, 0}, {20, 20, 0}, {-20, -20, 0}, {20, 20, 0},
+		{-20, 20, 0}}, []float64{0, 0, 1}, 0, lambertian)
+	objects := []*object.Object{floor}
+	if hasOccluder {
+		black, err := material.InitLambertian([]float64{0, 0, 0})
+		test_helpers.AssertNilError(t, err)
+		occluder := buildTriangleMesh(t, [][]float64{{-1, -1, 0.5}, {1, -1, 0.5}, {1, 1, 0.5}, {-1, -1, 0.5},
+			{1, 1, 0.5}, {-1, 1, 0.5}}, []float64{0, 0, 1}, 0, black)
+		objects = append(objects, occluder)
+	}
+	lightObject := buildTriangleMesh(t, [][]float64{{-0.5, -0.5, 1}, {0.5, -0.5, 1}, {0, 0.5, 1}},
+		[]float64{0, 0, -1}, 0, nil)
+	sceneLight, err := light.Init(1, lightObject, []float64{1, 1, 1})
+	test_helpers.AssertNilError(t, err)
+
+	pathTracer, err := Init(objects, nil, nil, []*light.Light{sceneLight})
+	test_helpers.AssertNilError(t, err)
+	return pathTracer
+}
+
+// TestController_FindTriangleGeometry tests the normal and the area of a triangle.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FindTriangleGeometry(t *testing.T) {
+	controller := Controller{}
+	mesh := buildTriangleMesh(t, [][]float64{{0, 0, 0}, {0, 2, 0}, {0, 0, 3}, {0, 0, 0}, {1, 1, 1}, {2, 2, 2}},
+		[]float64{1, 0, 0}, 0, nil)
+
+	normalVector, area := controller.findTriangleGeometry(mesh, 0)
+	assertVectorCoordinates(t, []float64{1, 0, 0}, normalVector)
+	test_helpers.AssertEqual(t, 3.0, area)
+
+	normalVector, area = controller.findTriangleGeometry(mesh, 1)
+	test_helpers.AssertEqual(t, true, normalVector == nil)
+	test_helpers.AssertEqual(t, 0.0, area)
+}
+
+// TestController_SampleLightPoint tests that the points sampled on the lights are inside the triangles.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_SampleLightPoint(t *testing.T) {
+	controller := Controller{}
+	pathTracer := buildAreaLightScene(t, false)
+	for _, samples := range [][]float64{{0, 0, 0}, {0.5, 0.25, 0.75}, {0.99, 0.999, 0.001}, {0.3, 1, 1}} {
+		lightIndex, sampledPoint, lightNormal := controller.sampleLightPoint(pathTracer, samples[0], samples[1],
+			samples[2])
+		test_helpers.AssertEqual(t, 0, lightIndex)
+		assertVectorCoordinates(t, []float64{0, 0, 1}, lightNormal)
+		xCoordinate, _ := sampledPoint.GetCoordinate(0)
+		yCoordinate, _ := sampledPoint.GetCoordinate(1)
+		zCoordinate, _ := sampledPoint.GetCoordinate(2)
+		test_helpers.AssertEqual(t, 1.0, zCoordinate)
+		test_helpers.AssertEqual(t, true, yCoordinate >= -0.5 && yCoordinate <= 0.5)
+		test_helpers.AssertEqual(t, true, math.Abs(xCoordinate) <= (0.5-yCoordinate)/2+1e-9)
+	}
+}
+
+// TestController_FindLightPdf tests the probability density by solid angle of sampling the lights.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FindLightPdf(t *testing.T) {
+	controller := Controller{}
+	pathTracer := buildAreaLightScene(t, false)
+	mathHelper := math_helper.Init(1e-9)
+
+	lightPdf := controller.findLightPdf(pathTracer, buildVector(t, []float64{0, 0, -1}),
+		buildVector(t, []float64{0, 0, 1}), 2)
+	test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(4/0.5, lightPdf))
+
+	lightPdf = controller.findLightPdf(pathTracer, buildVector(t, []float64{0, 0, -1}),
+		buildVector(t, []float64{0, math.Sqrt(0.5), math.Sqrt(0.5)}), 2)
+	test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(4/(0.5*math.Sqrt(0.5)), lightPdf))
+
+	lightPdf = controller.findLightPdf(pathTracer, buildVector(t, []float64{0, 0, -1}),
+		buildVector(t, []float64{1, 0, 0}), 2)
+	test_helpers.AssertEqual(t, 0.0, lightPdf)
+}
+
+// TestController_PowerHeuristic tests the weights of multiple importance sampling.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_PowerHeuristic(t *testing.T) {
+	controller := Controller{}
+	test_helpers.AssertEqual(t, 0.5, controller.powerHeuristic(2, 2))
+	test_helpers.AssertEqual(t, 0.8, controller.powerHeuristic(2, 1))
+	test_helpers.AssertEqual(t, 1.0, controller.powerHeuristic(2, 0))
+	test_helpers.AssertEqual(t, 0.0, controller.powerHeuristic(0, 0))
+	test_helpers.AssertEqual(t, 1.0, controller.powerHeuristic(3, 4)+controller.powerHeuristic(4, 3))
+}
+
+// TestController_IsVisible tests the visibility of points on the lights.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_IsVisible(t *testing.T) {
+	controller := Controller{}
+	surfacePoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	lightPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	err = lightPoint.SetCoordinate(2, 1)
+	test_helpers.AssertNilError(t, err)
+
+	test_helpers.AssertEqual(t, true, controller.isVisible(buildAreaLightScene(t, false), surfacePoint, lightPoint))
+	test_helpers.AssertEqual(t, false, controller.isVisible(buildAreaLightScene(t, true), surfacePoint, lightPoint))
+}
+
+// TestController_TracePath_AreaLight tests that the light reflected by a lambertian floor converges to the direct
+// illumination of the area light, integrated numerically.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_TracePath_AreaLight(t *testing.T) {
+	controller := Controller{}
+	pathTracer := buildAreaLightScene(t, false)
+	startingPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	err = startingPoint.SetCoordinate(0, -2)
+	test_helpers.AssertNilError(t, err)
+	err = startingPoint.SetCoordinate(2, 0.5)
+	test_helpers.AssertNilError(t, err)
+	currentRay, err := line.Init(startingPoint, buildVector(t, []float64{1, 0, -0.25}))
+	test_helpers.AssertNilError(t, err)
+
+	// L = albedo / pi * integral of cos(surface) * cos(light) / d^2 dA, with both cosines equal to 1 / d.
+	numberOfSteps := 400
+	integral := 0.0
+	for yIndex := 0; yIndex < numberOfSteps; yIndex++ {
+		yCoordinate := -0.5 + (float64(yIndex)+0.5)/float64(numberOfSteps)
+		halfWidth := (0.5 - yCoordinate) / 2
+		for xIndex := 0; xIndex < numberOfSteps; xIndex++ {
+			xCoordinate := -halfWidth + 2*halfWidth*(float64(xIndex)+0.5)/float64(numberOfSteps)
+			distanceSquared := xCoordinate*xCoordinate + yCoordinate*yCoordinate + 1
+			integral += 1 / (distanceSquared * distanceSquared) * 2 * halfWidth / float64(numberOfSteps) /
+				float64(numberOfSteps)
+		}
+	}
+	expectedRadiance := 0.5 / math.Pi * integral
+
+	numberOfSamples := 20000
+	pixelSampler := sampler.InitIndependent(1)
+	for _, depthIterations := range []int{1, 3} {
+		averageRadiance := 0.0
+		for sampleIndex := 0; sampleIndex < numberOfSamples; sampleIndex++ {
+			averageRadiance += controller.tracePath(pathTracer, depthIterations, depthIterations, currentRay,
+				pixelSampler)[0] / float64(numberOfSamples)
+		}
+		test_helpers.AssertEqual(t, true, math.Abs(averageRadiance-expectedRadiance) < 0.03*expectedRadiance)
+	}
+}
+
+// TestController_TracePath_OccludedAreaLight tests that a black occluder blocks all the light of the floor.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_TracePath_OccludedAreaLight(t *testing.T) {
+	controller := Controller{}
+	pathTracer := buildAreaLightScene(t, true)
+	startingPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	err = startingPoint.SetCoordinate(0, -2)
+	test_helpers.AssertNilError(t, err)
+	err = startingPoint.SetCoordinate(2, 0.5)
+	test_helpers.AssertNilError(t, err)
+	currentRay, err := line.Init(startingPoint, buildVector(t, []float64{1, 0, -0.25}))
+	test_helpers.AssertNilError(t, err)
+
+	pixelSampler := sampler.InitIndependent(1)
+	for sampleIndex := 0; sampleIndex < 1000; sampleIndex++ {
+		color := controller.tracePath(pathTracer, 3, 3, currentRay, pixelSampler)
+		test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0, 0, 0}, color))
+	}
+}
+
+// TestController_FindNormal_Sphere tests the analytic normal of a sphere hit by a ray.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FindNormal_Sphere(t *testing.T) {
+	controller := Controller{}
+	center, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	err = center.SetCoordinate(2, 3)
+	test_helpers.AssertNilError(t, err)
+	repository, err := point_repository.Init([]*point.Point{center}, 3)
+	test_helpers.AssertNilError(t, err)
+	sphereObject, err := object.Init("sphere", repository, []*triangle.Triangle{},
+		[]*sphere.Sphere{sphere.Init(0, 2)}, []*vector.Vector{}, []float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+
+	startingPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	currentRay, err := line.Init(startingPoint, buildVector(t, []float64{0, 0, 1}))
+	test_helpers.AssertNilError(t, err)
+	pathTracer, err := Init([]*object.Object{sphereObject}, nil, nil, []*light.Light{})
+	test_helpers.AssertNilError(t, err)
+
+	hasIntersection, lineParameter, objectIndex, surfaceIndex, barycentricCoordinates := controller.intersectObjects(
+		pathTracer, currentRay, 0)
+	test_helpers.AssertEqual(t, true, hasIntersection)
+	test_helpers.AssertEqual(t, 1.0, lineParameter)
+	test_helpers.AssertEqual(t, 0, objectIndex)
+	test_helpers.AssertEqual(t, 0, surfaceIndex)
+
+	lineController := line.Controller{}
+	intersectionPoint, err := lineController.FindPoint(currentRay, lineParameter)
+	test_helpers.AssertNilError(t, err)
+	normalVector := controller.findNormal(pathTracer.GetInstances()[objectIndex], surfaceIndex, barycentricCoordinates,
+		intersectionPoint)
+	assertVectorCoordinates(t, []float64{0, 0, -1}, normalVector)
+}
+
+// TestController_FindNormal_Instance tests the normal of a sphere of an instance stretched into an ellipsoid.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FindNormal_Instance(t *testing.T) {
+	controller := Controller{}
+	center, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	repository, err := point_repository.Init([]*point.Point{center}, 3)
+	test_helpers.AssertNilError(t, err)
+	sphereObject, err := object.Init("sphere", repository, []*triangle.Triangle{},
+		[]*sphere.Sphere{sphere.Init(0, 1)}, []*vector.Vector{}, []float64{1, 1, 1}, 0, 0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+	matrixController := matrix.Controller{}
+	transformation, err := matrixController.Compose(matrixController.BuildScale(1, 1, 2),
+		matrixController.BuildTranslation(0, 0, 5))
+	test_helpers.AssertNilError(t, err)
+	sphereInstance, err := instance.Init(sphereObject, transformation)
+	test_helpers.AssertNilError(t, err)
+	pathTracer, err := InitWithInstances([]*object.Object{}, []*instance.Instance{sphereInstance}, nil, nil,
+		[]*light.Light{})
+	test_helpers.AssertNilError(t, err)
+
+	startingPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	err = startingPoint.SetCoordinate(0, 0.6)
+	test_helpers.AssertNilError(t, err)
+	currentRay, err := line.Init(startingPoint, buildVector(t, []float64{0, 0, 1}))
+	test_helpers.AssertNilError(t, err)
+	hasIntersection, lineParameter, instanceIndex, surfaceIndex, barycentricCoordinates :=
+		controller.intersectObjects(pathTracer, currentRay, 0)
+	test_helpers.AssertEqual(t, true, hasIntersection)
+	test_helpers.AssertEqual(t, true, math.Abs(3.4-lineParameter) < 1e-9)
+	test_helpers.AssertEqual(t, 0, instanceIndex)
+
+	lineController := line.Controller{}
+	intersectionPoint, err := lineController.FindPoint(currentRay, lineParameter)
+	test_helpers.AssertNilError(t, err)
+	normalVector := controller.findNormal(pathTracer.GetInstances()[instanceIndex], surfaceIndex,
+		barycentricCoordinates, intersectionPoint)
+	// The ellipsoid x^2 + y^2 + (z - 5)^2 / 4 = 1 has the gradient (2x, 2y, (z - 5) / 2) at (0.6, 0, 3.4).
+	assertVectorCoordinates(t, []float64{1.2 / math.Sqrt(2.08), 0, -0.8 / math.Sqrt(2.08)}, normalVector)
+}
+
+// TestController_AverageRaysColors tests the average of the colors of the rays of a pixel.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_AverageRaysColors(t *testing.T) {
+	controller := Controller{}
+	raysColors := [][]float64{{1, 0.5, -1}, {2, 0.5, 0}}
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{1.5, 0.5, -0.5},
+		controller.averageRaysColors(raysColors, 2)))
+}
+
+// buildLightWallScene builds a scene whose camera on the origin looks at a light wall on the plane x = 10 that covers
+// the whole screen.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  A PathTracer.
+//
+func buildLightWallScene(t *testing.T) *PathTracer {
+	lightObject := buildTriangleMesh(t, [][]float64{{10, -100, -100}, {10, 100, -100}, {10, 100, 100},
+		{10, -100, -100}, {10, 100, 100}, {10, -100, 100}}, []float64{-1, 0, 0}, 0, nil)
+	sceneLight, err := light.Init(2, lightObject, []float64{1, 0.5, 0.25})
+	test_helpers.AssertNilError(t, err)
+
+	cameraPosition, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	sceneCamera, err := camera.Init(cameraPosition, buildVector(t, []float64{1, 0, 0}),
+		buildVector(t, []float64{0, 0, 1}), buildVector(t, []float64{0, -1, 0}), 60, 1)
+	test_helpers.AssertNilError(t, err)
+	pixelScreen, err := screen.Init(7, 5)
+	test_helpers.AssertNilError(t, err)
+
+	pathTracer, err := Init([]*object.Object{}, pixelScreen, sceneCamera, []*light.Light{sceneLight})
+	test_helpers.AssertNilError(t, err)
+	return pathTracer
+}
+
+// TestController_RunRadiance tests that the workers render every pixel of the window, and only them.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_RunRadiance(t *testing.T) {
+	controller := Controller{}
+	pathTracer := buildLightWallScene(t)
+	for _, tileSize := range []int{1, 2, 16} {
+		parameters, err := InitParameters(2, 1, 1, 2, 5, 6, UnbiasedEstimator, tone_mapping.InitDefault(), tileSize,
+			0, sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, DefaultPasses)
+		test_helpers.AssertNilError(t, err)
+
+		radianceMatrix, err := controller.RunRadiance(context.Background(), pathTracer, parameters)
+		test_helpers.AssertNilError(t, err)
+		for lineIndex, line := range radianceMatrix.GetRadiances() {
+			for columnIndex, radiance := range line {
+				expectedRadiance := []float32{0, 0, 0}
+				if lineIndex >= 1 && lineIndex < 5 && columnIndex >= 2 && columnIndex < 6 {
+					expectedRadiance = []float32{2, 1, 0.5}
+				}
+				test_helpers.AssertEqual(t, true, reflect.DeepEqual(expectedRadiance, radiance))
+			}
+		}
+	}
+}
+
+// TestController_RunRadiance_WindowError tests rendering a window out of the screen.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_RunRadiance_WindowError(t *testing.T) {
+	controller := Controller{}
+	parameters, err := InitParameters(1, 1, 0, 0, 6, 7, UnbiasedEstimator, tone_mapping.InitDefault(),
+		DefaultTileSize, 0, sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, DefaultPasses)
+	test_helpers.AssertNilError(t, err)
+	_, err = controller.RunRadiance(context.Background(), buildLightWallScene(t), parameters)
+	test_helpers.AssertNotNilError(t, err)
+}
+
+// TestController_RunRadiance_RecursionsError tests rendering with more recursions than the safety cap.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_RunRadiance_RecursionsError(t *testing.T) {
+	controller := Controller{}
+	parameters, err := InitParameters(1, MaxRecursions+1, 0, 0, 5, 7, UnbiasedEstimator, tone_mapping.InitDefault(),
+		DefaultTileSize, 0, sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, DefaultPasses)
+	test_helpers.AssertNilError(t, err)
+	_, err = controller.RunRadiance(context.Background(), buildLightWallScene(t), parameters)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, raysError(1, MaxRecursions+1).Error(), err.Error())
+}
+
+// TestController_TraceFirstRays_AdaptiveSampling tests that a pixel that sees a uniform light stops at the minimum
+// number of rays, while a pixel that sees a noisy floor traces more rays, up to the maximum.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_TraceFirstRays_AdaptiveSampling(t *testing.T) {
+	controller := Controller{}
+	lambertian, err := material.InitLambertian([]float64{0.5, 0.5, 0.5})
+	test_helpers.AssertNilError(t, err)
+	floor := buildTriangleMesh(t, [][]float64{{-20, -20, -1}, {20, -20, -1}, {20, 20, -1}, {-20, -20, -1},
+		{20, 20, -1}, {-20, 20, -1}}, []float64{0, 0, 1}, 0, lambertian)
+	lightWall := buildLightWallScene(t)
+	pathTracer, err := Init([]*object.Object{floor}, lightWall.GetPixelScreen(), lightWall.GetSceneCamera(),
+		lightWall.GetLights())
+	test_helpers.AssertNilError(t, err)
+	cameraController := &camera.Controller{}
+	cameraToWorldMatrix := cameraController.CameraToWorldMatrix(pathTracer.GetSceneCamera())
+
+	for _, maximumSamples := range []int{16, 256} {
+		adaptiveSampling, err := InitAdaptiveSampling(4, maximumSamples, 0.05)
+		test_helpers.AssertNilError(t, err)
+		parameters, err := InitParameters(1, 3, 0, 0, 5, 7, UnbiasedEstimator, tone_mapping.InitDefault(),
+			DefaultTileSize, 0, sampler.IndependentSamplerType, DefaultRussianRouletteDepth, adaptiveSampling,
+			DefaultPasses)
+		test_helpers.AssertNilError(t, err)
+
+		lightRadiance, lightRays := controller.traceFirstRays(pathTracer, parameters, cameraToWorldMatrix, 0, 3,
+			0, sampler.InitIndependent(0))
+		test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{2, 1, 0.5}, lightRadiance))
+		test_helpers.AssertEqual(t, 4, lightRays)
+
+		_, floorRays := controller.traceFirstRays(pathTracer, parameters, cameraToWorldMatrix, 4, 3,
+			0, sampler.InitIndependent(0))
+		test_helpers.AssertEqual(t, true, floorRays > 4)
+		test_helpers.AssertEqual(t, true, floorRays <= maximumSamples)
+	}
+}
+
+// TestController_FindFiniteRadiance tests that the radiances with a NaN or infinite channel are replaced by black.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_FindFiniteRadiance(t *testing.T) {
+	controller := Controller{}
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{1, 0.5, 0},
+		controller.findFiniteRadiance([]float64{1, 0.5, 0})))
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0, 0, 0},
+		controller.findFiniteRadiance([]float64{1, math.NaN(), 0})))
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0, 0, 0},
+		controller.findFiniteRadiance([]float64{math.Inf(1), 0.5, 0})))
+}
+
+// TestController_TraceFirstRays_NotFinite tests that the rays of a light of infinite intensity count as black, so the
+// luminance of adaptive sampling stays finite.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_TraceFirstRays_NotFinite(t *testing.T) {
+	controller := Controller{}
+	lightWall := buildLightWallScene(t)
+	infiniteLight, err := light.Init(math.Inf(1), lightWall.GetLights()[0].GetLightObject(), []float64{1, 0.5, 0.25})
+	test_helpers.AssertNilError(t, err)
+	pathTracer, err := Init([]*object.Object{}, lightWall.GetPixelScreen(), lightWall.GetSceneCamera(),
+		[]*light.Light{infiniteLight})
+	test_helpers.AssertNilError(t, err)
+	cameraController := &camera.Controller{}
+	cameraToWorldMatrix := cameraController.CameraToWorldMatrix(pathTracer.GetSceneCamera())
+
+	adaptiveSampling, err := InitAdaptiveSampling(4, 16, 0.05)
+	test_helpers.AssertNilError(t, err)
+	parameters, err := InitParameters(1, 3, 0, 0, 5, 7, UnbiasedEstimator, tone_mapping.InitDefault(),
+		DefaultTileSize, 0, sampler.IndependentSamplerType, DefaultRussianRouletteDepth, adaptiveSampling,
+		DefaultPasses)
+	test_helpers.AssertNilError(t, err)
+
+	radiance, rays := controller.traceFirstRays(pathTracer, parameters, cameraToWorldMatrix, 2, 3, 0,
+		sampler.InitIndependent(0))
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{0, 0, 0}, radiance))
+	test_helpers.AssertEqual(t, 4, rays)
+}
+
+// TestController_RunRadiance_Passes tests that the passes continue the rays of the previous passes, so two passes of
+// one ray render the same image as one pass of two rays.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_RunRadiance_Passes(t *testing.T) {
+	controller := Controller{}
+	lambertian, err := material.InitLambertian([]float64{0.5, 0.5, 0.5})
+	test_helpers.AssertNilError(t, err)
+	floor := buildTriangleMesh(t, [][]float64{{-20, -20, -1}, {20, -20, -1}, {20, 20, -1}, {-20, -20, -1},
+		{20, 20, -1}, {-20, 20, -1}}, []float64{0, 0, 1}, 0, lambertian)
+	lightWall := buildLightWallScene(t)
+	pathTracer, err := Init([]*object.Object{floor}, lightWall.GetPixelScreen(), lightWall.GetSceneCamera(),
+		lightWall.GetLights())
+	test_helpers.AssertNilError(t, err)
+
+	onePassParameters, err := InitParameters(2, 3, 0, 0, 5, 7, UnbiasedEstimator, tone_mapping.InitDefault(),
+		DefaultTileSize, 5, sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, 1)
+	test_helpers.AssertNilError(t, err)
+	onePassRadiance, err := controller.RunRadiance(context.Background(), pathTracer, onePassParameters)
+	test_helpers.AssertNilError(t, err)
+
+	twoPassesParameters, err := InitParameters(1, 3, 0, 0, 5, 7, UnbiasedEstimator, tone_mapping.InitDefault(),
+		DefaultTileSize, 5, sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, 2)
+	test_helpers.AssertNilError(t, err)
+	twoPassesRadiance, err := controller.RunRadiance(context.Background(), pathTracer, twoPassesParameters)
+	test_helpers.AssertNilError(t, err)
+
+	mathHelper := math_helper.Init(0.00001)
+	for lineIndex, line := range onePassRadiance.GetRadiances() {
+		for columnIndex, radiance := range line {
+			for colorIndex := 0; colorIndex < 3; colorIndex++ {
+				test_helpers.AssertEqual(t, true, mathHelper.IsEqualWithTolerance(float64(radiance[colorIndex]),
+					float64(twoPassesRadiance.GetRadiances()[lineIndex][columnIndex][colorIndex])))
+			}
+		}
+	}
+}
+
+// TestController_RunProgressive tests that a progressive render completes all its passes, and keeps the error of a
+// path tracing that cannot run.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_RunProgressive(t *testing.T) {
+	controller := Controller{}
+	pathTracer := buildLightWallScene(t)
+	parameters, err := InitParameters(1, 1, 0, 0, 5, 7, UnbiasedEstimator, tone_mapping.InitDefault(),
+		DefaultTileSize, 0, sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, 3)
+	test_helpers.AssertNilError(t, err)
+	progressiveRender := InitProgressiveRender(pathTracer, parameters)
+	test_helpers.AssertEqual(t, false, progressiveRender.IsDone())
+
+	err = controller.RunProgressive(context.Background(), pathTracer, parameters, progressiveRender)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, progressiveRender.IsDone())
+	test_helpers.AssertEqual(t, 3, progressiveRender.GetCompletedPasses())
+	test_helpers.AssertEqual(t, 1.0, progressiveRender.GetProgress().GetFraction())
+	test_helpers.AssertNilError(t, progressiveRender.GetError())
+	radiance, err := progressiveRender.GetEstimate().GetRadiance(2, 2)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float32{2, 1, 0.5}, radiance))
+
+	windowParameters, err := InitParameters(1, 1, 0, 0, 6, 7, UnbiasedEstimator, tone_mapping.InitDefault(),
+		DefaultTileSize, 0, sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, 3)
+	test_helpers.AssertNilError(t, err)
+	failedRender := InitProgressiveRender(pathTracer, windowParameters)
+	err = controller.RunProgressive(context.Background(), pathTracer, windowParameters, failedRender)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, true, failedRender.IsDone())
+	test_helpers.AssertEqual(t, err, failedRender.GetError())
+	test_helpers.AssertEqual(t, 0, failedRender.GetCompletedPasses())
+}
+
+// TestController_RunProgressive_Canceled tests that a canceled path tracing stops before its passes and keeps the error
+// of the context.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_RunProgressive_Canceled(t *testing.T) {
+	controller := Controller{}
+	pathTracer := buildLightWallScene(t)
+	parameters, err := InitParameters(1, 1, 0, 0, 5, 7, UnbiasedEstimator, tone_mapping.InitDefault(),
+		DefaultTileSize, 0, sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, 3)
+	test_helpers.AssertNilError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	progressiveRender := InitProgressiveRender(pathTracer, parameters)
+	err = controller.RunProgressive(ctx, pathTracer, parameters, progressiveRender)
+	test_helpers.AssertEqual(t, context.Canceled, err)
+	test_helpers.AssertEqual(t, context.Canceled, progressiveRender.GetError())
+	test_helpers.AssertEqual(t, true, progressiveRender.IsDone())
+	test_helpers.AssertEqual(t, 0, progressiveRender.GetCompletedPasses())
+
+	_, err = controller.RunRadiance(ctx, pathTracer, parameters)
+	test_helpers.AssertEqual(t, context.Canceled, err)
+}
+
+// TestController_RunRadiance_Seed tests that a seed renders the same image with any sampler, number of workers and
+// tile size, and that other seeds render other images.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_RunRadiance_Seed(t *testing.T) {
+	controller := Controller{}
+	lambertian, err := material.InitLambertian([]float64{0.5, 0.5, 0.5})
+	test_helpers.AssertNilError(t, err)
+	floor := buildTriangleMesh(t, [][]float64{{-20, -20, -1}, {20, -20, -1}, {20, 20, -1}, {-20, -20, -1},
+		{20, 20, -1}, {-20, 20, -1}}, []float64{0, 0, 1}, 0, lambertian)
+	lightWall := buildLightWallScene(t)
+	pathTracer, err := Init([]*object.Object{floor}, lightWall.GetPixelScreen(), lightWall.GetSceneCamera(),
+		lightWall.GetLights())
+	test_helpers.AssertNilError(t, err)
+
+	defer os.Setenv("NUMBER_OF_THREADS", os.Getenv("NUMBER_OF_THREADS"))
+	samplerTypes := []string{sampler.IndependentSamplerType, sampler.StratifiedSamplerType,
+		sampler.HaltonSamplerType, sampler.SobolSamplerType}
+	var firstRadianceMatrix *radiance_matrix.RadianceMatrix
+	for _, estimator := range []string{UnbiasedEstimator, LegacyEstimator} {
+		for _, samplerType := range samplerTypes {
+			for runIndex, numberOfThreads := range []string{"1", "3", "8"} {
+				test_helpers.AssertNilError(t, os.Setenv("NUMBER_OF_THREADS", numberOfThreads))
+				parameters, err := InitParameters(3, 3, 0, 0, 5, 7, estimator, tone_mapping.InitDefault(),
+					runIndex+1, 42, samplerType, DefaultRussianRouletteDepth, nil, DefaultPasses)
+				test_helpers.AssertNilError(t, err)
+				radianceMatrix, err := controller.RunRadiance(context.Background(), pathTracer, parameters)
+				test_helpers.AssertNilError(t, err)
+				if runIndex == 0 {
+					firstRadianceMatrix = radianceMatrix
+				}
+				test_helpers.AssertEqual(t, true, firstRadianceMatrix.IsEqual(radianceMatrix))
+			}
+
+			parameters, err := InitParameters(3, 3, 0, 0, 5, 7, estimator, tone_mapping.InitDefault(),
+				DefaultTileSize, 43, samplerType, DefaultRussianRouletteDepth, nil, DefaultPasses)
+			test_helpers.AssertNilError(t, err)
+			radianceMatrix, err := controller.RunRadiance(context.Background(), pathTracer, parameters)
+			test_helpers.AssertNilError(t, err)
+			test_helpers.AssertEqual(t, false, firstRadianceMatrix.IsEqual(radianceMatrix))
+		}
+	}
+}
+
+// TestController_RunRadiance_Sampler tests that every sampler converges to the radiance of a diffuse floor lit by a
+// light wall, and that the low discrepancy samplers have less error than independent samples.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_RunRadiance_Sampler(t *testing.T) {
+	controller := Controller{}
+	lambertian, err := material.InitLambertian([]float64{0.5, 0.5, 0.5})
+	test_helpers.AssertNilError(t, err)
+	floor := buildTriangleMesh(t, [][]float64{{-20, -20, -1}, {20, -20, -1}, {20, 20, -1}, {-20, -20, -1},
+		{20, 20, -1}, {-20, 20, -1}}, []float64{0, 0, 1}, 0, lambertian)
+	lightWall := buildLightWallScene(t)
+	pathTracer, err := Init([]*object.Object{floor}, lightWall.GetPixelScreen(), lightWall.GetSceneCamera(),
+		lightWall.GetLights())
+	test_helpers.AssertNilError(t, err)
+
+	findRadiances := func(samplerType string, raysPerPixel int) [][][]float32 {
+		parameters, err := InitParameters(raysPerPixel, 1, 0, 0, 5, 7, UnbiasedEstimator,
+			tone_mapping.InitDefault(), DefaultTileSize, 7, samplerType, DefaultRussianRouletteDepth, nil,
+			DefaultPasses)
+		test_helpers.AssertNilError(t, err)
+		radianceMatrix, err := controller.RunRadiance(context.Background(), pathTracer, parameters)
+		test_helpers.AssertNilError(t, err)
+		return radianceMatrix.GetRadiances()
+	}
+	findError := func(radiances, referenceRadiances [][][]float32) float64 {
+		squaredError := 0.0
+		for lineIndex, line := range radiances {
+			for columnIndex, radiance := range line {
+				difference := float64(radiance[0] - referenceRadiances[lineIndex][columnIndex][0])
+				squaredError += difference * difference
+			}
+		}
+		return squaredError
+	}
+
+	referenceRadiances := findRadiances(sampler.SobolSamplerType, 4096)
+	independentError := findError(findRadiances(sampler.IndependentSamplerType, 64), referenceRadiances)
+	for _, samplerType := range []string{sampler.StratifiedSamplerType, sampler.HaltonSamplerType,
+		sampler.SobolSamplerType} {
+		samplerError := findError(findRadiances(samplerType, 64), referenceRadiances)
+		test_helpers.AssertEqual(t, true, samplerError < independentError)
+	}
+}
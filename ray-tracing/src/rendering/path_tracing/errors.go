@@ -39,3 +39,84 @@ func raysError(raysPerPixel, recursions int) error {
 		raysPerPixel, recursions)
 	return errors.New(errorMessage)
 }
+
+// estimatorError is the error where the estimator of the color of the rays is unknown.
+//
+// Parameters:
+// 	estimator - The estimator.
+//
+// Returns:
+//  An Error.
+//
+func estimatorError(estimator string) error {
+	errorMessage := fmt.Sprintf("Invalid estimator %s. Expected %s or %s", estimator, LegacyEstimator,
+		UnbiasedEstimator)
+	return errors.New(errorMessage)
+}
+
+// tileSizeError is the error where the size of the tiles of pixels is not positive.
+//
+// Parameters:
+// 	tileSize - The size of the tiles.
+//
+// Returns:
+//  An Error.
+//
+func tileSizeError(tileSize int) error {
+	errorMessage := fmt.Sprintf("Invalid tile size %d. Expected at least 1", tileSize)
+	return errors.New(errorMessage)
+}
+
+// russianRouletteDepthError is the error where the number of bounces before Russian roulette is negative.
+//
+// Parameters:
+// 	russianRouletteDepth - The number of bounces before Russian roulette.
+//
+// Returns:
+//  An Error.
+//
+func russianRouletteDepthError(russianRouletteDepth int) error {
+	errorMessage := fmt.Sprintf("Invalid Russian roulette depth %d. Expected at least 0", russianRouletteDepth)
+	return errors.New(errorMessage)
+}
+
+// adaptiveSamplesError is the error where the bounds of the number of rays of the adaptive sampling are invalid.
+//
+// Parameters:
+// 	minimumSamples - The number of rays every pixel traces before checking if it converged.
+// 	maximumSamples - The most rays a pixel traces.
+//
+// Returns:
+//  An Error.
+//
+func adaptiveSamplesError(minimumSamples, maximumSamples int) error {
+	errorMessage := fmt.Sprintf("Invalid adaptive samples from %d to %d. Expected 2 <= minimum <= maximum",
+		minimumSamples, maximumSamples)
+	return errors.New(errorMessage)
+}
+
+// noiseThresholdError is the error where the noise threshold of the adaptive sampling is not positive.
+//
+// Parameters:
+// 	noiseThreshold - The noise threshold.
+//
+// Returns:
+//  An Error.
+//
+func noiseThresholdError(noiseThreshold float64) error {
+	errorMessage := fmt.Sprintf("Invalid noise threshold %v. Expected more than 0", noiseThreshold)
+	return errors.New(errorMessage)
+}
+
+// passesError is the error where the number of passes is not positive.
+//
+// Parameters:
+// 	passes - The number of passes.
+//
+// Returns:
+//  An Error.
+//
+func passesError(passes int) error {
+	errorMessage := fmt.Sprintf("Invalid number of passes %d. Expected at least 1", passes)
+	return errors.New(errorMessage)
+}
@@ -0,0 +1,106 @@
+package path_tracing
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/light"
+)
+
+// LightDistribution is a class for choosing the triangles of the lights with probability proportional to their area.
+//
+// Members:
+// 	lightIndexes    - The index of the light of every triangle.
+// 	triangleIndexes - The index of every triangle on the object of its light.
+// 	cumulativeAreas - The sum of the areas of the triangles up to every triangle, inclusive.
+// 	totalArea       - The sum of the areas of all triangles.
+//
+type LightDistribution struct {
+	lightIndexes    []int
+	triangleIndexes []int
+	cumulativeAreas []float64
+	totalArea       float64
+}
+
+// GetLightIndexes gets the index of the light of every triangle.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The light indexes.
+//
+func (distribution *LightDistribution) GetLightIndexes() []int {
+	return distribution.lightIndexes
+}
+
+// GetTriangleIndexes gets the index of every triangle on the object of its light.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The triangle indexes.
+//
+func (distribution *LightDistribution) GetTriangleIndexes() []int {
+	return distribution.triangleIndexes
+}
+
+// GetCumulativeAreas gets the sum of the areas of the triangles up to every triangle.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The cumulative areas.
+//
+func (distribution *LightDistribution) GetCumulativeAreas() []float64 {
+	return distribution.cumulativeAreas
+}
+
+// GetTotalArea gets the sum of the areas of all triangles.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The total area.
+//
+func (distribution *LightDistribution) GetTotalArea() float64 {
+	return distribution.totalArea
+}
+
+// IsEmpty checks if there is no triangle to sample.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	If the LightDistribution is empty.
+//
+func (distribution *LightDistribution) IsEmpty() bool {
+	return len(distribution.cumulativeAreas) == 0
+}
+
+// InitLightDistribution initializes a LightDistribution with the triangles of the lights that have area.
+//
+// Parameters:
+// 	lights - The list of light objects.
+//
+// Returns:
+// 	A LightDistribution.
+//
+func InitLightDistribution(lights []*light.Light) *LightDistribution {
+	controller := Controller{}
+	distribution := &LightDistribution{}
+	for lightIndex, currentLight := range lights {
+		for triangleIndex := range currentLight.GetLightObject().GetTriangles() {
+			_, area := controller.findTriangleGeometry(currentLight.GetLightObject(), triangleIndex)
+			if area <= 0 {
+				continue
+			}
+			distribution.totalArea += area
+			distribution.lightIndexes = append(distribution.lightIndexes, lightIndex)
+			distribution.triangleIndexes = append(distribution.triangleIndexes, triangleIndex)
+			distribution.cumulativeAreas = append(distribution.cumulativeAreas, distribution.totalArea)
+		}
+	}
+	return distribution
+}
@@ -0,0 +1,48 @@
+package path_tracing
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/light"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"reflect"
+	"testing"
+)
+
+// TestLightDistribution_Init tests the instantiation of a LightDistribution.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestLightDistribution_Init(t *testing.T) {
+	firstLightObject := buildTriangleMesh(t, [][]float64{{0, 0, 0}, {2, 0, 0}, {0, 2, 0}, {0, 0, 1}, {1, 0, 1},
+		{2, 0, 1}}, []float64{0, 0, 1}, 0, nil)
+	secondLightObject := buildTriangleMesh(t, [][]float64{{0, 0, 5}, {0, 3, 5}, {0, 0, 7}}, []float64{1, 0, 0}, 0,
+		nil)
+	firstLight, err := light.Init(1, firstLightObject, []float64{1, 1, 1})
+	test_helpers.AssertNilError(t, err)
+	secondLight, err := light.Init(1, secondLightObject, []float64{1, 1, 1})
+	test_helpers.AssertNilError(t, err)
+
+	distribution := InitLightDistribution([]*light.Light{firstLight, secondLight})
+	test_helpers.AssertEqual(t, false, distribution.IsEmpty())
+	test_helpers.AssertEqual(t, 5.0, distribution.GetTotalArea())
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]int{0, 1}, distribution.GetLightIndexes()))
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]int{0, 0}, distribution.GetTriangleIndexes()))
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{2, 5}, distribution.GetCumulativeAreas()))
+}
+
+// TestLightDistribution_Init_Empty tests the instantiation of a LightDistribution without lights.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestLightDistribution_Init_Empty(t *testing.T) {
+	distribution := InitLightDistribution([]*light.Light{})
+	test_helpers.AssertEqual(t, true, distribution.IsEmpty())
+	test_helpers.AssertEqual(t, 0.0, distribution.GetTotalArea())
+}
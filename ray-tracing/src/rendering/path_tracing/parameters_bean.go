@@ -0,0 +1,280 @@
+package path_tracing
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/sampler"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tone_mapping"
+)
+
+// LegacyEstimator is the estimator that sums the colors of every bounce, kept to reproduce older renders.
+const LegacyEstimator = "legacy"
+
+// UnbiasedEstimator is the Monte Carlo estimator of the rendering equation that weights every bounce by the path
+// throughput.
+const UnbiasedEstimator = "unbiased"
+
+// DefaultTileSize is the number of lines and columns of the tiles of pixels the workers render when the request does
+// not choose one.
+const DefaultTileSize = 16
+
+// MaxRecursions is the safety cap on the number of recursions of each ray, so Russian roulette decides the length of
+// the paths without any path bouncing forever.
+const MaxRecursions = 64
+
+// DefaultRussianRouletteDepth is the number of bounces every path makes before Russian roulette can end it when the
+// request does not choose one.
+const DefaultRussianRouletteDepth = 3
+
+// DefaultPasses is the number of passes of rays every pixel traces when the request does not choose one.
+const DefaultPasses = 1
+
+// Parameters is a class for the parameters of a path tracing run.
+//
+// Members:
+// 	raysPerPixel         - The number of rays per pixel.
+// 	recursions           - The number recursions of each ray.
+// 	windowStartLine      - The starting line index of the window of the screen to use the path tracing.
+// 	windowStartColumn    - The starting column index of the window of the screen to use the path tracing.
+// 	windowEndLine        - The ending line index of the window of the screen to use the path tracing.
+// 	windowEndColumn      - The ending column index of the window of the screen to use the path tracing.
+// 	estimator            - The estimator of the color of the rays, LegacyEstimator or UnbiasedEstimator.
+// 	toneMapping          - The post process that converts the radiance of the pixels to colors.
+// 	tileSize             - The number of lines and columns of the tiles of pixels the workers render.
+// 	seed                 - The seed of the random numbers, the same seed renders the same image.
+// 	samplerType          - The type of the Sampler of the numbers of the paths.
+// 	russianRouletteDepth - The number of bounces every path makes before Russian roulette can end it.
+// 	adaptiveSampling     - The settings of the adaptive sampling of the pixels, nil for a fixed number of rays.
+// 	passes               - The number of passes of rays every pixel traces, averaged into the image.
+//
+type Parameters struct {
+	raysPerPixel         int
+	recursions           int
+	windowStartLine      int
+	windowStartColumn    int
+	windowEndLine        int
+	windowEndColumn      int
+	estimator            string
+	toneMapping          *tone_mapping.ToneMapping
+	tileSize             int
+	seed                 int64
+	samplerType          string
+	russianRouletteDepth int
+	adaptiveSampling     *AdaptiveSampling
+	passes               int
+}
+
+// GetRaysPerPixel gets the number of rays per pixel.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of rays per pixel.
+//
+func (parameters *Parameters) GetRaysPerPixel() int {
+	return parameters.raysPerPixel
+}
+
+// GetRecursions gets the number recursions of each ray.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number recursions of each ray.
+//
+func (parameters *Parameters) GetRecursions() int {
+	return parameters.recursions
+}
+
+// GetWindowStartLine gets the starting line index of the window.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The starting line index of the window.
+//
+func (parameters *Parameters) GetWindowStartLine() int {
+	return parameters.windowStartLine
+}
+
+// GetWindowStartColumn gets the starting column index of the window.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The starting column index of the window.
+//
+func (parameters *Parameters) GetWindowStartColumn() int {
+	return parameters.windowStartColumn
+}
+
+// GetWindowEndLine gets the ending line index of the window.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The ending line index of the window.
+//
+func (parameters *Parameters) GetWindowEndLine() int {
+	return parameters.windowEndLine
+}
+
+// GetWindowEndColumn gets the ending column index of the window.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The ending column index of the window.
+//
+func (parameters *Parameters) GetWindowEndColumn() int {
+	return parameters.windowEndColumn
+}
+
+// GetEstimator gets the estimator of the color of the rays.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The estimator.
+//
+func (parameters *Parameters) GetEstimator() string {
+	return parameters.estimator
+}
+
+// GetToneMapping gets the post process that converts the radiance of the pixels to colors.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The ToneMapping.
+//
+func (parameters *Parameters) GetToneMapping() *tone_mapping.ToneMapping {
+	return parameters.toneMapping
+}
+
+// GetTileSize gets the number of lines and columns of the tiles of pixels the workers render.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The size of the tiles.
+//
+func (parameters *Parameters) GetTileSize() int {
+	return parameters.tileSize
+}
+
+// GetSeed gets the seed of the random numbers.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The seed.
+//
+func (parameters *Parameters) GetSeed() int64 {
+	return parameters.seed
+}
+
+// GetSampler gets the type of the Sampler of the numbers of the paths.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The type of the Sampler.
+//
+func (parameters *Parameters) GetSampler() string {
+	return parameters.samplerType
+}
+
+// GetRussianRouletteDepth gets the number of bounces every path makes before Russian roulette can end it.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of bounces.
+//
+func (parameters *Parameters) GetRussianRouletteDepth() int {
+	return parameters.russianRouletteDepth
+}
+
+// GetAdaptiveSampling gets the settings of the adaptive sampling of the pixels.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The AdaptiveSampling, nil when every pixel traces the number of rays per pixel.
+//
+func (parameters *Parameters) GetAdaptiveSampling() *AdaptiveSampling {
+	return parameters.adaptiveSampling
+}
+
+// GetPasses gets the number of passes of rays every pixel traces.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of passes.
+//
+func (parameters *Parameters) GetPasses() int {
+	return parameters.passes
+}
+
+// InitParameters initializes the Parameters of a path tracing run.
+//
+// Parameters:
+// 	raysPerPixel         - The number of rays per pixel.
+// 	recursions           - The number recursions of each ray.
+// 	windowStartLine      - The starting line index of the window of the screen to use the path tracing.
+// 	windowStartColumn    - The starting column index of the window of the screen to use the path tracing.
+// 	windowEndLine        - The ending line index of the window of the screen to use the path tracing.
+// 	windowEndColumn      - The ending column index of the window of the screen to use the path tracing.
+// 	estimator            - The estimator of the color of the rays, LegacyEstimator or UnbiasedEstimator.
+// 	toneMapping          - The post process that converts the radiance of the pixels to colors.
+// 	tileSize             - The number of lines and columns of the tiles of pixels the workers render.
+// 	seed                 - The seed of the random numbers.
+// 	samplerType          - The type of the Sampler of the numbers of the paths.
+// 	russianRouletteDepth - The number of bounces every path makes before Russian roulette can end it, only used by
+// 	                       the UnbiasedEstimator.
+// 	adaptiveSampling     - The settings of the adaptive sampling of the pixels, nil for a fixed number of rays.
+// 	passes               - The number of passes of rays every pixel traces, each one with the rays per pixel or
+// 	                       the adaptive sampling.
+//
+// Returns:
+// 	The Parameters.
+// 	An error.
+//
+func InitParameters(raysPerPixel, recursions, windowStartLine, windowStartColumn, windowEndLine, windowEndColumn int,
+	estimator string, toneMapping *tone_mapping.ToneMapping, tileSize int, seed int64, samplerType string,
+	russianRouletteDepth int, adaptiveSampling *AdaptiveSampling, passes int) (*Parameters, error) {
+	if estimator != LegacyEstimator && estimator != UnbiasedEstimator {
+		return nil, estimatorError(estimator)
+	}
+	if tileSize < 1 {
+		return nil, tileSizeError(tileSize)
+	}
+	if _, err := sampler.Init(samplerType, raysPerPixel, seed); err != nil {
+		return nil, err
+	}
+	if russianRouletteDepth < 0 {
+		return nil, russianRouletteDepthError(russianRouletteDepth)
+	}
+	if passes < 1 {
+		return nil, passesError(passes)
+	}
+	return &Parameters{raysPerPixel: raysPerPixel, recursions: recursions, windowStartLine: windowStartLine,
+		windowStartColumn: windowStartColumn, windowEndLine: windowEndLine, windowEndColumn: windowEndColumn,
+		estimator: estimator, toneMapping: toneMapping, tileSize: tileSize, seed: seed,
+		samplerType: samplerType, russianRouletteDepth: russianRouletteDepth, adaptiveSampling: adaptiveSampling,
+		passes: passes}, nil
+}
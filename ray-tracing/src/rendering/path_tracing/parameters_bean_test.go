@@ -0,0 +1,120 @@
+package path_tracing
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/sampler"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tone_mapping"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestParameters_Init tests the instantiation of the Parameters.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestParameters_Init(t *testing.T) {
+	adaptiveSampling, err := InitAdaptiveSampling(4, 64, 0.01)
+	test_helpers.AssertNilError(t, err)
+	parameters, err := InitParameters(10, 3, 1, 2, 30, 40, UnbiasedEstimator, tone_mapping.InitDefault(), 8,
+		-7, sampler.HaltonSamplerType, 5, adaptiveSampling, 2)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 10, parameters.GetRaysPerPixel())
+	test_helpers.AssertEqual(t, 3, parameters.GetRecursions())
+	test_helpers.AssertEqual(t, 1, parameters.GetWindowStartLine())
+	test_helpers.AssertEqual(t, 2, parameters.GetWindowStartColumn())
+	test_helpers.AssertEqual(t, 30, parameters.GetWindowEndLine())
+	test_helpers.AssertEqual(t, 40, parameters.GetWindowEndColumn())
+	test_helpers.AssertEqual(t, UnbiasedEstimator, parameters.GetEstimator())
+	test_helpers.AssertEqual(t, true, tone_mapping.InitDefault().IsEqual(parameters.GetToneMapping()))
+	test_helpers.AssertEqual(t, 8, parameters.GetTileSize())
+	test_helpers.AssertEqual(t, int64(-7), parameters.GetSeed())
+	test_helpers.AssertEqual(t, sampler.HaltonSamplerType, parameters.GetSampler())
+	test_helpers.AssertEqual(t, 5, parameters.GetRussianRouletteDepth())
+	test_helpers.AssertEqual(t, true, adaptiveSampling.IsEqual(parameters.GetAdaptiveSampling()))
+	test_helpers.AssertEqual(t, 2, parameters.GetPasses())
+}
+
+// TestParameters_Init_EstimatorError tests the instantiation of the Parameters with an unknown estimator.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestParameters_Init_EstimatorError(t *testing.T) {
+	estimator := "biased"
+	expectedErrorMessage := fmt.Sprintf("Invalid estimator %s. Expected %s or %s", estimator, LegacyEstimator,
+		UnbiasedEstimator)
+	_, err := InitParameters(10, 3, 0, 0, 30, 40, estimator, tone_mapping.InitDefault(),
+		DefaultTileSize, 0, sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, DefaultPasses)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestParameters_Init_TileSizeError tests the instantiation of the Parameters with tiles without pixels.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestParameters_Init_TileSizeError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid tile size %d. Expected at least 1", 0)
+	_, err := InitParameters(10, 3, 0, 0, 30, 40, LegacyEstimator, tone_mapping.InitDefault(), 0, 0,
+		sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, DefaultPasses)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestParameters_Init_SamplerError tests the instantiation of the Parameters with an unknown sampler.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestParameters_Init_SamplerError(t *testing.T) {
+	_, err := InitParameters(10, 3, 0, 0, 30, 40, LegacyEstimator, tone_mapping.InitDefault(), DefaultTileSize, 0,
+		"random", DefaultRussianRouletteDepth, nil, DefaultPasses)
+	test_helpers.AssertNotNilError(t, err)
+}
+
+// TestParameters_Init_RussianRouletteDepthError tests the instantiation of the Parameters with a negative number of
+// bounces before Russian roulette.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestParameters_Init_RussianRouletteDepthError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid Russian roulette depth %d. Expected at least 0", -1)
+	_, err := InitParameters(10, 3, 0, 0, 30, 40, UnbiasedEstimator, tone_mapping.InitDefault(), DefaultTileSize, 0,
+		sampler.IndependentSamplerType, -1, nil, DefaultPasses)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestParameters_Init_PassesError tests the instantiation of the Parameters without passes.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestParameters_Init_PassesError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid number of passes %d. Expected at least 1", 0)
+	_, err := InitParameters(10, 3, 0, 0, 30, 40, UnbiasedEstimator, tone_mapping.InitDefault(), DefaultTileSize, 0,
+		sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, 0)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
@@ -0,0 +1,158 @@
+package path_tracing
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tile"
+	"time"
+)
+
+// Progress is a class for a snapshot of the progress of a ProgressiveRender.
+//
+// Members:
+// 	renderedTiles   - The number of rendered tiles over all passes.
+// 	totalTiles      - The number of tiles of all passes.
+// 	completedPasses - The number of completed passes.
+// 	totalPasses     - The number of passes of the render.
+// 	tracedSamples   - The number of primary rays traced over all passes.
+// 	elapsedTime     - The time since the first pass started.
+// 	renderedTile    - The tile whose rendering made this snapshot, nil when no tile was just rendered.
+//
+type Progress struct {
+	renderedTiles   int
+	totalTiles      int
+	completedPasses int
+	totalPasses     int
+	tracedSamples   int64
+	elapsedTime     time.Duration
+	renderedTile    *tile.Tile
+}
+
+// GetRenderedTiles gets the number of rendered tiles over all passes.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of rendered tiles.
+//
+func (progress *Progress) GetRenderedTiles() int {
+	return progress.renderedTiles
+}
+
+// GetTotalTiles gets the number of tiles of all passes.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of tiles.
+//
+func (progress *Progress) GetTotalTiles() int {
+	return progress.totalTiles
+}
+
+// GetCompletedPasses gets the number of completed passes.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of completed passes.
+//
+func (progress *Progress) GetCompletedPasses() int {
+	return progress.completedPasses
+}
+
+// GetTotalPasses gets the number of passes of the render.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of passes.
+//
+func (progress *Progress) GetTotalPasses() int {
+	return progress.totalPasses
+}
+
+// GetTracedSamples gets the number of primary rays traced over all passes.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of primary rays.
+//
+func (progress *Progress) GetTracedSamples() int64 {
+	return progress.tracedSamples
+}
+
+// GetElapsedTime gets the time since the first pass started.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The elapsed time.
+//
+func (progress *Progress) GetElapsedTime() time.Duration {
+	return progress.elapsedTime
+}
+
+// GetRenderedTile gets the tile whose rendering made this snapshot.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The tile, nil when no tile was just rendered.
+//
+func (progress *Progress) GetRenderedTile() *tile.Tile {
+	return progress.renderedTile
+}
+
+// GetFraction gets the fraction of the tiles of all passes already rendered.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The fraction, from 0 to 1.
+//
+func (progress *Progress) GetFraction() float64 {
+	if progress.totalTiles == 0 {
+		return 0
+	}
+	return float64(progress.renderedTiles) / float64(progress.totalTiles)
+}
+
+// GetSamplesPerSecond gets the mean number of primary rays traced per second.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of primary rays per second, 0 before any time elapsed.
+//
+func (progress *Progress) GetSamplesPerSecond() float64 {
+	if progress.elapsedTime <= 0 {
+		return 0
+	}
+	return float64(progress.tracedSamples) / progress.elapsedTime.Seconds()
+}
+
+// GetEstimatedTimeRemaining estimates the time until all passes complete, from the mean time per rendered tile.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The estimated remaining time.
+// 	If there is an estimate, which needs at least one rendered tile.
+//
+func (progress *Progress) GetEstimatedTimeRemaining() (time.Duration, bool) {
+	if progress.renderedTiles == 0 {
+		return 0, false
+	}
+	remainingTiles := progress.totalTiles - progress.renderedTiles
+	return time.Duration(int64(progress.elapsedTime) * int64(remainingTiles) / int64(progress.renderedTiles)), true
+}
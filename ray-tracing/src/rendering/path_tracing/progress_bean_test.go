@@ -0,0 +1,52 @@
+package path_tracing
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+	"time"
+)
+
+// TestProgress_GetFraction tests the fraction of the rendered tiles.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestProgress_GetFraction(t *testing.T) {
+	test_helpers.AssertEqual(t, 0.0, (&Progress{}).GetFraction())
+	test_helpers.AssertEqual(t, 0.25, (&Progress{renderedTiles: 2, totalTiles: 8}).GetFraction())
+}
+
+// TestProgress_GetSamplesPerSecond tests the mean number of primary rays traced per second.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestProgress_GetSamplesPerSecond(t *testing.T) {
+	test_helpers.AssertEqual(t, 0.0, (&Progress{tracedSamples: 10}).GetSamplesPerSecond())
+	progress := &Progress{tracedSamples: 300, elapsedTime: 2 * time.Second}
+	test_helpers.AssertEqual(t, 150.0, progress.GetSamplesPerSecond())
+}
+
+// TestProgress_GetEstimatedTimeRemaining tests the estimate of the time until all passes complete.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestProgress_GetEstimatedTimeRemaining(t *testing.T) {
+	_, hasEstimate := (&Progress{totalTiles: 8, elapsedTime: time.Second}).GetEstimatedTimeRemaining()
+	test_helpers.AssertEqual(t, false, hasEstimate)
+
+	progress := &Progress{renderedTiles: 2, totalTiles: 8, elapsedTime: 3 * time.Second}
+	remainingTime, hasEstimate := progress.GetEstimatedTimeRemaining()
+	test_helpers.AssertEqual(t, true, hasEstimate)
+	test_helpers.AssertEqual(t, 9*time.Second, remainingTime)
+}
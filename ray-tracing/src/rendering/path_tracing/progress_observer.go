@@ -0,0 +1,31 @@
+package path_tracing
+
+// ProgressObserver is the interface for the listeners of the progress of a ProgressiveRender.
+// The ProgressiveRender calls its observers from the goroutines of the workers, one call at a time and in order, so
+// the observers must return quickly and must not block the render.
+//
+// Methods:
+// 	OnProgress - Receives the progress after a tile is rendered.
+// 	OnDone     - Receives the end of the render.
+//
+type ProgressObserver interface {
+	// OnProgress receives the progress after a tile is rendered.
+	//
+	// Parameters:
+	// 	progress - The Progress of the render.
+	//
+	// Returns:
+	// 	none
+	//
+	OnProgress(progress *Progress)
+
+	// OnDone receives the end of the render, after all passes or by an error.
+	//
+	// Parameters:
+	// 	err - The error that stopped the render, nil when all passes completed.
+	//
+	// Returns:
+	// 	none
+	//
+	OnDone(err error)
+}
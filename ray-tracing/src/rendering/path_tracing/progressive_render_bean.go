@@ -0,0 +1,306 @@
+package path_tracing
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/radiance_matrix"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/screen"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tile"
+	"sync"
+	"time"
+)
+
+// ProgressiveRender is a class for a path tracing that accumulates passes of rays, whose current estimate can be read
+// while the passes run. It is safe to read from other goroutines, and notifies its ProgressObservers of every rendered
+// tile.
+//
+// Members:
+// 	pixelScreen     - The Screen of the render.
+// 	totalPasses     - The number of passes of the render.
+// 	radianceSums    - The sum of the radiance of every pixel over the completed passes.
+// 	completedPasses - The number of completed passes.
+// 	totalTiles      - The number of tiles of all passes.
+// 	renderedTiles   - The number of rendered tiles over all passes.
+// 	tracedSamples   - The number of primary rays traced over all passes.
+// 	startTime       - The time the first pass started.
+// 	stopTime        - The time the render stopped, zero while it runs.
+// 	err             - The error that stopped the render, if any.
+// 	mutex           - The lock of the mutable members.
+// 	observers       - The ProgressObservers of the render.
+// 	observersMutex  - The lock of the observers, held while they are notified so they receive the events in order.
+//
+type ProgressiveRender struct {
+	pixelScreen     *screen.Screen
+	totalPasses     int
+	radianceSums    [][][]float64
+	completedPasses int
+	totalTiles      int
+	renderedTiles   int
+	tracedSamples   int64
+	startTime       time.Time
+	stopTime        time.Time
+	err             error
+	mutex           sync.RWMutex
+	observers       []ProgressObserver
+	observersMutex  sync.Mutex
+}
+
+// GetTotalPasses gets the number of passes of the render.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of passes.
+//
+func (progressiveRender *ProgressiveRender) GetTotalPasses() int {
+	return progressiveRender.totalPasses
+}
+
+// GetCompletedPasses gets the number of completed passes.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of completed passes.
+//
+func (progressiveRender *ProgressiveRender) GetCompletedPasses() int {
+	progressiveRender.mutex.RLock()
+	defer progressiveRender.mutex.RUnlock()
+	return progressiveRender.completedPasses
+}
+
+// GetError gets the error that stopped the render.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The error, nil while the render runs or after it finished.
+//
+func (progressiveRender *ProgressiveRender) GetError() error {
+	progressiveRender.mutex.RLock()
+	defer progressiveRender.mutex.RUnlock()
+	return progressiveRender.err
+}
+
+// GetProgress gets a snapshot of the progress of the render.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The Progress, without a rendered tile.
+//
+func (progressiveRender *ProgressiveRender) GetProgress() *Progress {
+	progressiveRender.mutex.RLock()
+	defer progressiveRender.mutex.RUnlock()
+	return progressiveRender.buildProgress(nil)
+}
+
+// AddObserver adds a ProgressObserver to the render, which must not be called from a ProgressObserver.
+//
+// Parameters:
+// 	observer - The ProgressObserver.
+//
+// Returns:
+// 	none
+//
+func (progressiveRender *ProgressiveRender) AddObserver(observer ProgressObserver) {
+	progressiveRender.observersMutex.Lock()
+	defer progressiveRender.observersMutex.Unlock()
+	progressiveRender.observers = append(progressiveRender.observers, observer)
+}
+
+// RemoveObserver removes a ProgressObserver from the render, which must not be called from a ProgressObserver.
+//
+// Parameters:
+// 	observer - The ProgressObserver.
+//
+// Returns:
+// 	none
+//
+func (progressiveRender *ProgressiveRender) RemoveObserver(observer ProgressObserver) {
+	progressiveRender.observersMutex.Lock()
+	defer progressiveRender.observersMutex.Unlock()
+	for observerIndex, currentObserver := range progressiveRender.observers {
+		if currentObserver == observer {
+			progressiveRender.observers = append(progressiveRender.observers[:observerIndex],
+				progressiveRender.observers[observerIndex+1:]...)
+			return
+		}
+	}
+}
+
+// IsDone checks if the render stopped, either after all passes or by an error.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	If the render stopped.
+//
+func (progressiveRender *ProgressiveRender) IsDone() bool {
+	progressiveRender.mutex.RLock()
+	defer progressiveRender.mutex.RUnlock()
+	return progressiveRender.err != nil || progressiveRender.completedPasses == progressiveRender.totalPasses
+}
+
+// GetEstimate gets the current estimate of the radiance, the mean of the completed passes.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	A new RadianceMatrix, black before the first pass.
+//
+func (progressiveRender *ProgressiveRender) GetEstimate() *radiance_matrix.RadianceMatrix {
+	progressiveRender.mutex.RLock()
+	defer progressiveRender.mutex.RUnlock()
+	estimate := radiance_matrix.Init(progressiveRender.pixelScreen)
+	if progressiveRender.completedPasses == 0 {
+		return estimate
+	}
+	radiance := make([]float64, 3)
+	for lineIndex, line := range progressiveRender.radianceSums {
+		for columnIndex, radianceSum := range line {
+			for colorIndex := 0; colorIndex < 3; colorIndex++ {
+				radiance[colorIndex] = radianceSum[colorIndex] / float64(progressiveRender.completedPasses)
+			}
+			_ = estimate.SetRadiance(lineIndex, columnIndex, radiance)
+		}
+	}
+	return estimate
+}
+
+// buildProgress builds a snapshot of the progress of the render, with the lock of the members held.
+//
+// Parameters:
+// 	renderedTile - The tile whose rendering made the snapshot, nil when no tile was just rendered.
+//
+// Returns:
+// 	The Progress.
+//
+func (progressiveRender *ProgressiveRender) buildProgress(renderedTile *tile.Tile) *Progress {
+	var elapsedTime time.Duration
+	if !progressiveRender.startTime.IsZero() {
+		if progressiveRender.stopTime.IsZero() {
+			elapsedTime = time.Since(progressiveRender.startTime)
+		} else {
+			elapsedTime = progressiveRender.stopTime.Sub(progressiveRender.startTime)
+		}
+	}
+	return &Progress{renderedTiles: progressiveRender.renderedTiles, totalTiles: progressiveRender.totalTiles,
+		completedPasses: progressiveRender.completedPasses, totalPasses: progressiveRender.totalPasses,
+		tracedSamples: progressiveRender.tracedSamples, elapsedTime: elapsedTime, renderedTile: renderedTile}
+}
+
+// start sets the number of tiles of each pass before the first pass runs.
+//
+// Parameters:
+// 	numberOfTiles - The number of tiles of each pass.
+//
+// Returns:
+// 	none
+//
+func (progressiveRender *ProgressiveRender) start(numberOfTiles int) {
+	progressiveRender.mutex.Lock()
+	defer progressiveRender.mutex.Unlock()
+	progressiveRender.totalTiles = numberOfTiles * progressiveRender.totalPasses
+	progressiveRender.startTime = time.Now()
+}
+
+// addRenderedTile counts a rendered tile of the current pass and notifies the observers.
+//
+// Parameters:
+// 	renderedTile  - The rendered tile.
+// 	tracedSamples - The number of primary rays traced in the tile.
+//
+// Returns:
+// 	none
+//
+func (progressiveRender *ProgressiveRender) addRenderedTile(renderedTile *tile.Tile, tracedSamples int) {
+	progressiveRender.observersMutex.Lock()
+	defer progressiveRender.observersMutex.Unlock()
+	progressiveRender.mutex.Lock()
+	progressiveRender.renderedTiles++
+	progressiveRender.tracedSamples += int64(tracedSamples)
+	progress := progressiveRender.buildProgress(renderedTile)
+	progressiveRender.mutex.Unlock()
+	for _, observer := range progressiveRender.observers {
+		observer.OnProgress(progress)
+	}
+}
+
+// addPass adds the radiance of a completed pass to the render, notifying the observers when it is the last one.
+//
+// Parameters:
+// 	passRadiance - The radiance of the pass.
+//
+// Returns:
+// 	none
+//
+func (progressiveRender *ProgressiveRender) addPass(passRadiance *radiance_matrix.RadianceMatrix) {
+	progressiveRender.observersMutex.Lock()
+	defer progressiveRender.observersMutex.Unlock()
+	progressiveRender.mutex.Lock()
+	for lineIndex, line := range passRadiance.GetRadiances() {
+		for columnIndex, radiance := range line {
+			for colorIndex := 0; colorIndex < 3; colorIndex++ {
+				progressiveRender.radianceSums[lineIndex][columnIndex][colorIndex] += float64(radiance[colorIndex])
+			}
+		}
+	}
+	progressiveRender.completedPasses++
+	isDone := progressiveRender.completedPasses == progressiveRender.totalPasses
+	if isDone {
+		progressiveRender.stopTime = time.Now()
+	}
+	progressiveRender.mutex.Unlock()
+	if isDone {
+		for _, observer := range progressiveRender.observers {
+			observer.OnDone(nil)
+		}
+	}
+}
+
+// fail stops the render with an error and notifies the observers.
+//
+// Parameters:
+// 	err - The error.
+//
+// Returns:
+// 	none
+//
+func (progressiveRender *ProgressiveRender) fail(err error) {
+	progressiveRender.observersMutex.Lock()
+	defer progressiveRender.observersMutex.Unlock()
+	progressiveRender.mutex.Lock()
+	progressiveRender.err = err
+	progressiveRender.stopTime = time.Now()
+	progressiveRender.mutex.Unlock()
+	for _, observer := range progressiveRender.observers {
+		observer.OnDone(err)
+	}
+}
+
+// InitProgressiveRender initializes a ProgressiveRender without completed passes.
+//
+// Parameters:
+// 	pathTracer - The PathTracer.
+// 	parameters - The parameters of the path tracing.
+//
+// Returns:
+// 	The ProgressiveRender.
+//
+func InitProgressiveRender(pathTracer *PathTracer, parameters *Parameters) *ProgressiveRender {
+	pixelScreen := pathTracer.GetPixelScreen()
+	radianceSums := make([][][]float64, pixelScreen.GetHeight())
+	for lineIndex := range radianceSums {
+		radianceSums[lineIndex] = make([][]float64, pixelScreen.GetWidth())
+		for columnIndex := range radianceSums[lineIndex] {
+			radianceSums[lineIndex][columnIndex] = make([]float64, 3)
+		}
+	}
+	return &ProgressiveRender{pixelScreen: pixelScreen, totalPasses: parameters.GetPasses(),
+		radianceSums: radianceSums}
+}
@@ -0,0 +1,142 @@
+package path_tracing
+
+import (
+	"context"
+	"errors"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/radiance_matrix"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/sampler"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tone_mapping"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"reflect"
+	"testing"
+)
+
+// TestProgressiveRender_GetEstimate tests that the estimate is the mean of the completed passes.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestProgressiveRender_GetEstimate(t *testing.T) {
+	pathTracer := buildLightWallScene(t)
+	parameters, err := InitParameters(1, 1, 0, 0, 5, 7, UnbiasedEstimator, tone_mapping.InitDefault(),
+		DefaultTileSize, 0, sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, 2)
+	test_helpers.AssertNilError(t, err)
+	progressiveRender := InitProgressiveRender(pathTracer, parameters)
+	test_helpers.AssertEqual(t, 2, progressiveRender.GetTotalPasses())
+	test_helpers.AssertEqual(t, true,
+		radiance_matrix.Init(pathTracer.GetPixelScreen()).IsEqual(progressiveRender.GetEstimate()))
+
+	firstPass := radiance_matrix.Init(pathTracer.GetPixelScreen())
+	test_helpers.AssertNilError(t, firstPass.SetRadiance(1, 1, []float64{1, 2, 3}))
+	progressiveRender.addPass(firstPass)
+	test_helpers.AssertEqual(t, false, progressiveRender.IsDone())
+	radiance, err := progressiveRender.GetEstimate().GetRadiance(1, 1)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float32{1, 2, 3}, radiance))
+
+	secondPass := radiance_matrix.Init(pathTracer.GetPixelScreen())
+	test_helpers.AssertNilError(t, secondPass.SetRadiance(1, 1, []float64{3, 0, 1}))
+	progressiveRender.addPass(secondPass)
+	test_helpers.AssertEqual(t, true, progressiveRender.IsDone())
+	test_helpers.AssertEqual(t, 2, progressiveRender.GetCompletedPasses())
+	radiance, err = progressiveRender.GetEstimate().GetRadiance(1, 1)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float32{2, 1, 2}, radiance))
+}
+
+// recordingObserver is a ProgressObserver that records its events.
+//
+// Members:
+// 	progresses - The received Progresses.
+// 	doneErrors - The received ends of the render.
+//
+type recordingObserver struct {
+	progresses []*Progress
+	doneErrors []error
+}
+
+// OnProgress records the progress after a tile is rendered.
+//
+// Parameters:
+// 	progress - The Progress of the render.
+//
+// Returns:
+// 	none
+//
+func (observer *recordingObserver) OnProgress(progress *Progress) {
+	observer.progresses = append(observer.progresses, progress)
+}
+
+// OnDone records the end of the render.
+//
+// Parameters:
+// 	err - The error that stopped the render.
+//
+// Returns:
+// 	none
+//
+func (observer *recordingObserver) OnDone(err error) {
+	observer.doneErrors = append(observer.doneErrors, err)
+}
+
+// TestProgressiveRender_AddObserver tests that the observers receive every rendered tile in order and the end of the
+// render.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestProgressiveRender_AddObserver(t *testing.T) {
+	controller := Controller{}
+	pathTracer := buildLightWallScene(t)
+	parameters, err := InitParameters(2, 1, 0, 0, 5, 7, UnbiasedEstimator, tone_mapping.InitDefault(), 2, 0,
+		sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, 2)
+	test_helpers.AssertNilError(t, err)
+	progressiveRender := InitProgressiveRender(pathTracer, parameters)
+	observer := &recordingObserver{}
+	removedObserver := &recordingObserver{}
+	progressiveRender.AddObserver(observer)
+	progressiveRender.AddObserver(removedObserver)
+	progressiveRender.RemoveObserver(removedObserver)
+
+	err = controller.RunProgressive(context.Background(), pathTracer, parameters, progressiveRender)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 0, len(removedObserver.progresses))
+	test_helpers.AssertEqual(t, 24, len(observer.progresses))
+	for progressIndex, progress := range observer.progresses {
+		test_helpers.AssertEqual(t, progressIndex+1, progress.GetRenderedTiles())
+		test_helpers.AssertEqual(t, 24, progress.GetTotalTiles())
+		test_helpers.AssertEqual(t, 2, progress.GetTotalPasses())
+		test_helpers.AssertEqual(t, true, progress.GetRenderedTile() != nil)
+	}
+	test_helpers.AssertEqual(t, int64(140), progressiveRender.GetProgress().GetTracedSamples())
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]error{nil}, observer.doneErrors))
+}
+
+// TestProgressiveRender_AddObserver_Error tests that the observers receive the error that stopped the render.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestProgressiveRender_AddObserver_Error(t *testing.T) {
+	pathTracer := buildLightWallScene(t)
+	parameters, err := InitParameters(1, 1, 0, 0, 5, 7, UnbiasedEstimator, tone_mapping.InitDefault(),
+		DefaultTileSize, 0, sampler.IndependentSamplerType, DefaultRussianRouletteDepth, nil, 2)
+	test_helpers.AssertNilError(t, err)
+	progressiveRender := InitProgressiveRender(pathTracer, parameters)
+	observer := &recordingObserver{}
+	progressiveRender.AddObserver(observer)
+
+	renderError := errors.New("error")
+	progressiveRender.fail(renderError)
+	test_helpers.AssertEqual(t, 0, len(observer.progresses))
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]error{renderError}, observer.doneErrors))
+}
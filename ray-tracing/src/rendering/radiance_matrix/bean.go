@@ -0,0 +1,159 @@
+package radiance_matrix
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/screen"
+	"reflect"
+)
+
+// RadianceMatrix is a class for the high dynamic range radiance of the pixels of a screen.
+//
+// Members:
+// 	radiances - The RGB radiances matrix.
+//
+type RadianceMatrix struct {
+	radiances [][][]float32
+}
+
+// Lines gets the lines of the RadianceMatrix.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The lines of the RadianceMatrix.
+//
+func (radianceMatrix *RadianceMatrix) Lines() int {
+	return len(radianceMatrix.radiances)
+}
+
+// Columns gets the columns of the RadianceMatrix.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The columns of the RadianceMatrix.
+//
+func (radianceMatrix *RadianceMatrix) Columns() int {
+	return len(radianceMatrix.radiances[0])
+}
+
+// GetRadiances gets the radiances of the RadianceMatrix.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The radiances of the RadianceMatrix.
+//
+func (radianceMatrix *RadianceMatrix) GetRadiances() [][][]float32 {
+	return radianceMatrix.radiances
+}
+
+// GetRadiance gets the radiance of a pixel of the RadianceMatrix.
+//
+// Parameters:
+// 	lineIndex   - The line index of the pixel.
+// 	columnIndex - The column index of the pixel.
+//
+// Returns:
+// 	The RGB radiance.
+// 	An error.
+//
+func (radianceMatrix *RadianceMatrix) GetRadiance(lineIndex, columnIndex int) ([]float32, error) {
+	if !radianceMatrix.isInside(lineIndex, columnIndex) {
+		return nil, indexError(radianceMatrix, lineIndex, columnIndex)
+	}
+	return radianceMatrix.radiances[lineIndex][columnIndex], nil
+}
+
+// SetRadiance sets the radiance of a pixel of the RadianceMatrix.
+//
+// Parameters:
+// 	lineIndex   - The line index of the pixel.
+// 	columnIndex - The column index of the pixel.
+// 	radiance    - The RGB radiance.
+//
+// Returns:
+// 	An error.
+//
+func (radianceMatrix *RadianceMatrix) SetRadiance(lineIndex, columnIndex int, radiance []float64) error {
+	if !radianceMatrix.isInside(lineIndex, columnIndex) {
+		return indexError(radianceMatrix, lineIndex, columnIndex)
+	}
+	if len(radiance) != 3 {
+		return nonRGBRadianceError(radiance)
+	}
+	for colorIndex := 0; colorIndex < 3; colorIndex++ {
+		radianceMatrix.radiances[lineIndex][columnIndex][colorIndex] = float32(radiance[colorIndex])
+	}
+	return nil
+}
+
+// AddRadiance adds a radiance to the radiance of a pixel of the RadianceMatrix.
+//
+// Parameters:
+// 	lineIndex   - The line index of the pixel.
+// 	columnIndex - The column index of the pixel.
+// 	radiance    - The RGB radiance.
+//
+// Returns:
+// 	An error.
+//
+func (radianceMatrix *RadianceMatrix) AddRadiance(lineIndex, columnIndex int, radiance []float64) error {
+	if !radianceMatrix.isInside(lineIndex, columnIndex) {
+		return indexError(radianceMatrix, lineIndex, columnIndex)
+	}
+	if len(radiance) != 3 {
+		return nonRGBRadianceError(radiance)
+	}
+	for colorIndex := 0; colorIndex < 3; colorIndex++ {
+		radianceMatrix.radiances[lineIndex][columnIndex][colorIndex] += float32(radiance[colorIndex])
+	}
+	return nil
+}
+
+// isInside checks if a pixel is inside the RadianceMatrix.
+//
+// Parameters:
+// 	lineIndex   - The line index of the pixel.
+// 	columnIndex - The column index of the pixel.
+//
+// Returns:
+// 	If the pixel is inside the RadianceMatrix.
+//
+func (radianceMatrix *RadianceMatrix) isInside(lineIndex, columnIndex int) bool {
+	return lineIndex >= 0 && lineIndex < radianceMatrix.Lines() && columnIndex >= 0 &&
+		columnIndex < radianceMatrix.Columns()
+}
+
+// IsEqual checks if two radiance matrices are equal.
+//
+// Parameters:
+// 	other - the other matrix.
+//
+// Returns:
+// 	If the two radiance matrices are equal.
+//
+func (radianceMatrix *RadianceMatrix) IsEqual(other *RadianceMatrix) bool {
+	return reflect.DeepEqual(radianceMatrix.GetRadiances(), other.GetRadiances())
+}
+
+// Init initializes a RadianceMatrix with no radiance.
+//
+// Parameters:
+// 	targetScreen - The screen of the RadianceMatrix.
+//
+// Returns:
+// 	A RadianceMatrix.
+//
+func Init(targetScreen *screen.Screen) *RadianceMatrix {
+	radiances := make([][][]float32, targetScreen.GetHeight())
+	for lineIndex := 0; lineIndex < targetScreen.GetHeight(); lineIndex++ {
+		radiances[lineIndex] = make([][]float32, targetScreen.GetWidth())
+		for columnIndex := 0; columnIndex < targetScreen.GetWidth(); columnIndex++ {
+			radiances[lineIndex][columnIndex] = make([]float32, 3)
+		}
+	}
+	return &RadianceMatrix{radiances: radiances}
+}
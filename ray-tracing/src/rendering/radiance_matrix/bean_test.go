@@ -0,0 +1,83 @@
+package radiance_matrix
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/screen"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"reflect"
+	"testing"
+)
+
+// TestRadianceMatrix_Init tests the instantiation of a RadianceMatrix.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRadianceMatrix_Init(t *testing.T) {
+	targetScreen, err := screen.Init(2, 1)
+	test_helpers.AssertNilError(t, err)
+
+	radianceMatrix := Init(targetScreen)
+	expectedRadianceMatrix := &RadianceMatrix{radiances: [][][]float32{{{0, 0, 0}, {0, 0, 0}}}}
+	test_helpers.AssertEqual(t, true, expectedRadianceMatrix.IsEqual(radianceMatrix))
+	test_helpers.AssertEqual(t, 1, radianceMatrix.Lines())
+	test_helpers.AssertEqual(t, 2, radianceMatrix.Columns())
+}
+
+// TestRadianceMatrix_SetRadiance tests setting and adding the radiance of a pixel of a RadianceMatrix.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRadianceMatrix_SetRadiance(t *testing.T) {
+	targetScreen, err := screen.Init(2, 1)
+	test_helpers.AssertNilError(t, err)
+
+	radianceMatrix := Init(targetScreen)
+	test_helpers.AssertNilError(t, radianceMatrix.SetRadiance(0, 1, []float64{2.5, 0.25, 0}))
+	test_helpers.AssertNilError(t, radianceMatrix.AddRadiance(0, 1, []float64{1, 1, 16}))
+	radiance, err := radianceMatrix.GetRadiance(0, 1)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float32{3.5, 1.25, 16}, radiance))
+}
+
+// TestRadianceMatrix_SetRadiance_IndexError tests accessing a pixel out of a RadianceMatrix.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRadianceMatrix_SetRadiance_IndexError(t *testing.T) {
+	targetScreen, err := screen.Init(2, 1)
+	test_helpers.AssertNilError(t, err)
+
+	radianceMatrix := Init(targetScreen)
+	test_helpers.AssertNotNilError(t, radianceMatrix.SetRadiance(1, 0, []float64{1, 1, 1}))
+	test_helpers.AssertNotNilError(t, radianceMatrix.AddRadiance(0, -1, []float64{1, 1, 1}))
+	_, err = radianceMatrix.GetRadiance(0, 2)
+	test_helpers.AssertNotNilError(t, err)
+}
+
+// TestRadianceMatrix_SetRadiance_NonRGBRadianceError tests setting a radiance without 3 values.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRadianceMatrix_SetRadiance_NonRGBRadianceError(t *testing.T) {
+	targetScreen, err := screen.Init(2, 1)
+	test_helpers.AssertNilError(t, err)
+
+	radianceMatrix := Init(targetScreen)
+	test_helpers.AssertNotNilError(t, radianceMatrix.SetRadiance(0, 0, []float64{1, 1}))
+	test_helpers.AssertNotNilError(t, radianceMatrix.AddRadiance(0, 0, []float64{1, 1, 1, 1}))
+}
@@ -0,0 +1,309 @@
+package radiance_matrix
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/color_matrix"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/screen"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tone_mapping"
+	"io"
+	"math"
+)
+
+// The image formats a RadianceMatrix can be encoded to, named by their media types.
+const (
+	PfmFormat = "image/x-portable-floatmap"
+	ExrFormat = "image/x-exr"
+)
+
+// exrMagicNumber is the magic number OpenEXR files start with.
+const exrMagicNumber = 20000630
+
+// exrFloatPixelType is the OpenEXR pixel type of 32 bit floats.
+const exrFloatPixelType = 2
+
+// exrChannels are the names of the channels of the OpenEXR images, in the alphabetical order the format requires.
+var exrChannels = []string{"B", "G", "R"}
+
+// Controller is a class for controlling radiance matrices.
+//
+// Members:
+// 	none
+//
+type Controller struct {}
+
+// ToColorMatrix converts a RadianceMatrix to a ColorMatrix.
+//
+// Parameters:
+// 	radianceMatrix - The RadianceMatrix.
+// 	toneMapping    - The ToneMapping that converts the radiances to displayable colors.
+//
+// Returns:
+// 	The ColorMatrix.
+//
+func (*Controller) ToColorMatrix(radianceMatrix *RadianceMatrix,
+	toneMapping *tone_mapping.ToneMapping) *color_matrix.ColorMatrix {
+	toneMappingController := tone_mapping.Controller{}
+	targetScreen, _ := screen.Init(radianceMatrix.Columns(), radianceMatrix.Lines())
+	colorMatrix := color_matrix.Init(targetScreen)
+	radiance := make([]float64, 3)
+	for lineIndex, line := range radianceMatrix.GetRadiances() {
+		for columnIndex, pixelRadiance := range line {
+			for colorIndex := 0; colorIndex < 3; colorIndex++ {
+				radiance[colorIndex] = float64(pixelRadiance[colorIndex])
+			}
+			color := toneMappingController.Apply(toneMapping, radiance)
+			rgbColor := make([]int, 3)
+			for colorIndex := 0; colorIndex < 3; colorIndex++ {
+				rgbColor[colorIndex] = int(math.Min(255, math.Floor(color[colorIndex]*255)))
+			}
+			_ = colorMatrix.SetColor(lineIndex, columnIndex, rgbColor)
+		}
+	}
+	return colorMatrix
+}
+
+// EncodePfm encodes a RadianceMatrix as a little endian color PFM image, which stores the bottom line first.
+//
+// Parameters:
+// 	radianceMatrix - The RadianceMatrix.
+// 	writer         - The writer of the image.
+//
+// Returns:
+// 	An error.
+//
+func (*Controller) EncodePfm(radianceMatrix *RadianceMatrix, writer io.Writer) error {
+	bufferedWriter := bufio.NewWriter(writer)
+	_, err := fmt.Fprintf(bufferedWriter, "PF\n%d %d\n-1.0\n", radianceMatrix.Columns(), radianceMatrix.Lines())
+	if err != nil {
+		return err
+	}
+	radiances := radianceMatrix.GetRadiances()
+	for lineIndex := len(radiances) - 1; lineIndex >= 0; lineIndex-- {
+		for _, radiance := range radiances[lineIndex] {
+			err = binary.Write(bufferedWriter, binary.LittleEndian, radiance)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return bufferedWriter.Flush()
+}
+
+// DecodePfm decodes a color PFM image, of either byte order, as a RadianceMatrix.
+//
+// Parameters:
+// 	reader - The reader of the image.
+//
+// Returns:
+// 	The RadianceMatrix.
+// 	An error.
+//
+func (*Controller) DecodePfm(reader io.Reader) (*RadianceMatrix, error) {
+	bufferedReader := bufio.NewReader(reader)
+	var identifier string
+	var columns, lines int
+	var scale float64
+	_, err := fmt.Fscan(bufferedReader, &identifier, &columns, &lines, &scale)
+	if err != nil {
+		return nil, invalidPfmError("unreadable header")
+	}
+	if identifier != "PF" {
+		return nil, invalidPfmError("not a color PFM image")
+	}
+	// A single whitespace character separates the header from the pixels.
+	if _, err = bufferedReader.ReadByte(); err != nil {
+		return nil, invalidPfmError("missing pixels")
+	}
+	targetScreen, err := screen.Init(columns, lines)
+	if err != nil || scale == 0 {
+		return nil, invalidPfmError("invalid size or scale")
+	}
+
+	var byteOrder binary.ByteOrder = binary.BigEndian
+	if scale < 0 {
+		byteOrder = binary.LittleEndian
+	}
+	radianceMatrix := Init(targetScreen)
+	pixel := make([]float32, 3)
+	radiance := make([]float64, 3)
+	for lineIndex := lines - 1; lineIndex >= 0; lineIndex-- {
+		for columnIndex := 0; columnIndex < columns; columnIndex++ {
+			if err = binary.Read(bufferedReader, byteOrder, pixel); err != nil {
+				return nil, invalidPfmError("missing pixels")
+			}
+			for colorIndex := 0; colorIndex < 3; colorIndex++ {
+				radiance[colorIndex] = float64(pixel[colorIndex])
+			}
+			_ = radianceMatrix.SetRadiance(lineIndex, columnIndex, radiance)
+		}
+	}
+	return radianceMatrix, nil
+}
+
+// writeExrAttribute writes an attribute of the header of an OpenEXR image.
+//
+// Parameters:
+// 	header        - The header.
+// 	name          - The name of the attribute.
+// 	attributeType - The type of the attribute.
+// 	value         - The value of the attribute.
+//
+// Returns:
+// 	none
+//
+func (*Controller) writeExrAttribute(header *bytes.Buffer, name, attributeType string, value []byte) {
+	header.WriteString(name)
+	header.WriteByte(0)
+	header.WriteString(attributeType)
+	header.WriteByte(0)
+	_ = binary.Write(header, binary.LittleEndian, int32(len(value)))
+	header.Write(value)
+}
+
+// buildExrHeader builds the magic number, version and header of an uncompressed scan line OpenEXR image.
+//
+// Parameters:
+// 	radianceMatrix - The RadianceMatrix.
+//
+// Returns:
+// 	The header.
+//
+func (controller *Controller) buildExrHeader(radianceMatrix *RadianceMatrix) []byte {
+	header := &bytes.Buffer{}
+	_ = binary.Write(header, binary.LittleEndian, []int32{exrMagicNumber, 2})
+
+	channels := &bytes.Buffer{}
+	for _, channel := range exrChannels {
+		channels.WriteString(channel)
+		channels.WriteByte(0)
+		_ = binary.Write(channels, binary.LittleEndian, []int32{exrFloatPixelType, 0, 1, 1})
+	}
+	channels.WriteByte(0)
+	controller.writeExrAttribute(header, "channels", "chlist", channels.Bytes())
+	controller.writeExrAttribute(header, "compression", "compression", []byte{0})
+
+	window := &bytes.Buffer{}
+	_ = binary.Write(window, binary.LittleEndian, []int32{0, 0, int32(radianceMatrix.Columns() - 1),
+		int32(radianceMatrix.Lines() - 1)})
+	controller.writeExrAttribute(header, "dataWindow", "box2i", window.Bytes())
+	controller.writeExrAttribute(header, "displayWindow", "box2i", window.Bytes())
+	controller.writeExrAttribute(header, "lineOrder", "lineOrder", []byte{0})
+
+	floatValue := &bytes.Buffer{}
+	_ = binary.Write(floatValue, binary.LittleEndian, float32(1))
+	controller.writeExrAttribute(header, "pixelAspectRatio", "float", floatValue.Bytes())
+	controller.writeExrAttribute(header, "screenWindowCenter", "v2f", make([]byte, 8))
+	controller.writeExrAttribute(header, "screenWindowWidth", "float", floatValue.Bytes())
+	header.WriteByte(0)
+	return header.Bytes()
+}
+
+// EncodeExr encodes a RadianceMatrix as an uncompressed scan line OpenEXR image with 32 bit float channels.
+//
+// Parameters:
+// 	radianceMatrix - The RadianceMatrix.
+// 	writer         - The writer of the image.
+//
+// Returns:
+// 	An error.
+//
+func (controller *Controller) EncodeExr(radianceMatrix *RadianceMatrix, writer io.Writer) error {
+	bufferedWriter := bufio.NewWriter(writer)
+	header := controller.buildExrHeader(radianceMatrix)
+	_, err := bufferedWriter.Write(header)
+	if err != nil {
+		return err
+	}
+
+	lineDataSize := radianceMatrix.Columns() * len(exrChannels) * 4
+	lineChunkSize := 8 + lineDataSize
+	offsetTableSize := 8 * radianceMatrix.Lines()
+	offsets := make([]uint64, radianceMatrix.Lines())
+	for lineIndex := range offsets {
+		offsets[lineIndex] = uint64(len(header) + offsetTableSize + lineIndex*lineChunkSize)
+	}
+	err = binary.Write(bufferedWriter, binary.LittleEndian, offsets)
+	if err != nil {
+		return err
+	}
+
+	lineData := make([]float32, radianceMatrix.Columns()*len(exrChannels))
+	for lineIndex, line := range radianceMatrix.GetRadiances() {
+		for channelIndex := range exrChannels {
+			colorIndex := len(exrChannels) - 1 - channelIndex
+			for columnIndex, radiance := range line {
+				lineData[channelIndex*len(line)+columnIndex] = radiance[colorIndex]
+			}
+		}
+		err = binary.Write(bufferedWriter, binary.LittleEndian, []int32{int32(lineIndex), int32(lineDataSize)})
+		if err != nil {
+			return err
+		}
+		err = binary.Write(bufferedWriter, binary.LittleEndian, lineData)
+		if err != nil {
+			return err
+		}
+	}
+	return bufferedWriter.Flush()
+}
+
+// Encode encodes a RadianceMatrix as a high dynamic range image.
+//
+// Parameters:
+// 	radianceMatrix - The RadianceMatrix.
+// 	format         - The media type of the image format.
+// 	writer         - The writer of the image.
+//
+// Returns:
+// 	An error.
+//
+func (controller *Controller) Encode(radianceMatrix *RadianceMatrix, format string, writer io.Writer) error {
+	switch format {
+	case PfmFormat:
+		return controller.EncodePfm(radianceMatrix, writer)
+	case ExrFormat:
+		return controller.EncodeExr(radianceMatrix, writer)
+	}
+	return unsupportedImageFormatError(format)
+}
+
+// Crop copies the radiance of a window of a RadianceMatrix to a RadianceMatrix of the size of the window.
+//
+// Parameters:
+// 	radianceMatrix - The RadianceMatrix.
+// 	startLine      - The first line of the window.
+// 	startColumn    - The first column of the window.
+// 	endLine        - The line after the last line of the window.
+// 	endColumn      - The column after the last column of the window.
+//
+// Returns:
+// 	The RadianceMatrix of the window.
+// 	An error.
+//
+func (*Controller) Crop(radianceMatrix *RadianceMatrix, startLine, startColumn, endLine,
+	endColumn int) (*RadianceMatrix, error) {
+	if !radianceMatrix.isInside(startLine, startColumn) {
+		return nil, indexError(radianceMatrix, startLine, startColumn)
+	}
+	if !radianceMatrix.isInside(endLine-1, endColumn-1) {
+		return nil, indexError(radianceMatrix, endLine-1, endColumn-1)
+	}
+	targetScreen, err := screen.Init(endColumn-startColumn, endLine-startLine)
+	if err != nil {
+		return nil, err
+	}
+	croppedRadianceMatrix := Init(targetScreen)
+	radiance := make([]float64, 3)
+	for lineIndex := startLine; lineIndex < endLine; lineIndex++ {
+		for columnIndex := startColumn; columnIndex < endColumn; columnIndex++ {
+			for colorIndex, colorRadiance := range radianceMatrix.radiances[lineIndex][columnIndex] {
+				radiance[colorIndex] = float64(colorRadiance)
+			}
+			_ = croppedRadianceMatrix.SetRadiance(lineIndex-startLine, columnIndex-startColumn, radiance)
+		}
+	}
+	return croppedRadianceMatrix, nil
+}
@@ -0,0 +1,206 @@
+package radiance_matrix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tone_mapping"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"reflect"
+	"testing"
+)
+
+// buildTestRadianceMatrix builds a RadianceMatrix with 2 lines and 2 columns for testing.
+//
+// Parameters:
+//  none
+//
+// Returns:
+//  A RadianceMatrix.
+//
+func buildTestRadianceMatrix() *RadianceMatrix {
+	return &RadianceMatrix{radiances: [][][]float32{
+		{{1, 0.5, -1}, {2, 3, 4}},
+		{{0.25, 0, 0}, {5, 6, 7}},
+	}}
+}
+
+// TestController_ToColorMatrix tests the conversion of a RadianceMatrix to a ColorMatrix.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ToColorMatrix(t *testing.T) {
+	controller := Controller{}
+	colorMatrix := controller.ToColorMatrix(buildTestRadianceMatrix(), tone_mapping.InitDefault())
+	expectedColors := [][][]int{{{255, 127, 0}, {255, 255, 255}}, {{63, 0, 0}, {255, 255, 255}}}
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(expectedColors, colorMatrix.GetColors()))
+}
+
+// TestController_ToColorMatrix_ToneMapping tests the conversion of a RadianceMatrix to a ColorMatrix with tone mapping.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ToColorMatrix_ToneMapping(t *testing.T) {
+	controller := Controller{}
+	toneMapping, err := tone_mapping.Init(-1, tone_mapping.ReinhardOperator, tone_mapping.LinearTransferFunction)
+	test_helpers.AssertNilError(t, err)
+	colorMatrix := controller.ToColorMatrix(buildTestRadianceMatrix(), toneMapping)
+	expectedColors := [][][]int{{{85, 51, 0}, {127, 153, 170}}, {{28, 0, 0}, {182, 191, 198}}}
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual(expectedColors, colorMatrix.GetColors()))
+}
+
+// TestController_Encode_Pfm tests encoding a RadianceMatrix as a PFM image.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Encode_Pfm(t *testing.T) {
+	controller := Controller{}
+	var buffer bytes.Buffer
+	test_helpers.AssertNilError(t, controller.Encode(buildTestRadianceMatrix(), PfmFormat, &buffer))
+
+	header := []byte("PF\n2 2\n-1.0\n")
+	test_helpers.AssertEqual(t, true, bytes.HasPrefix(buffer.Bytes(), header))
+	pixels := make([]float32, 12)
+	err := binary.Read(bytes.NewReader(buffer.Bytes()[len(header):]), binary.LittleEndian, pixels)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float32{0.25, 0, 0, 5, 6, 7, 1, 0.5, -1, 2, 3, 4},
+		pixels))
+}
+
+// TestController_DecodePfm tests decoding the PFM images encoded from a RadianceMatrix, in both byte orders.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_DecodePfm(t *testing.T) {
+	controller := Controller{}
+	var buffer bytes.Buffer
+	test_helpers.AssertNilError(t, controller.EncodePfm(buildTestRadianceMatrix(), &buffer))
+	radianceMatrix, err := controller.DecodePfm(&buffer)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, buildTestRadianceMatrix().IsEqual(radianceMatrix))
+
+	buffer.Reset()
+	buffer.WriteString("PF\n2 2\n1.0\n")
+	test_helpers.AssertNilError(t, binary.Write(&buffer, binary.BigEndian,
+		[]float32{0.25, 0, 0, 5, 6, 7, 1, 0.5, -1, 2, 3, 4}))
+	radianceMatrix, err = controller.DecodePfm(&buffer)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, buildTestRadianceMatrix().IsEqual(radianceMatrix))
+}
+
+// TestController_DecodePfm_InvalidPfmError tests decoding images that are not valid color PFM images.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_DecodePfm_InvalidPfmError(t *testing.T) {
+	controller := Controller{}
+	for _, image := range []string{"", "Pf\n2 2\n-1.0\n", "PF\n0 2\n-1.0\n", "PF\n2 2\n-1.0\n\x00\x00"} {
+		_, err := controller.DecodePfm(bytes.NewReader([]byte(image)))
+		test_helpers.AssertNotNilError(t, err)
+	}
+}
+
+// TestController_Encode_Exr tests encoding a RadianceMatrix as an OpenEXR image.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Encode_Exr(t *testing.T) {
+	controller := Controller{}
+	radianceMatrix := buildTestRadianceMatrix()
+	var buffer bytes.Buffer
+	test_helpers.AssertNilError(t, controller.Encode(radianceMatrix, ExrFormat, &buffer))
+
+	image := buffer.Bytes()
+	test_helpers.AssertEqual(t, true, bytes.HasPrefix(image, []byte{0x76, 0x2f, 0x31, 0x01, 2, 0, 0, 0}))
+	test_helpers.AssertEqual(t, true, bytes.Contains(image, []byte("channels\x00chlist\x00")))
+	test_helpers.AssertEqual(t, true, bytes.Contains(image, []byte("dataWindow\x00box2i\x00")))
+
+	headerSize := len(controller.buildExrHeader(radianceMatrix))
+	lineChunkSize := 8 + 2*3*4
+	test_helpers.AssertEqual(t, headerSize+2*8+2*lineChunkSize, len(image))
+
+	offsets := make([]uint64, 2)
+	reader := bytes.NewReader(image[headerSize:])
+	test_helpers.AssertNilError(t, binary.Read(reader, binary.LittleEndian, offsets))
+	for lineIndex, offset := range offsets {
+		lineHeader := make([]int32, 2)
+		lineData := make([]float32, 6)
+		reader = bytes.NewReader(image[offset:])
+		test_helpers.AssertNilError(t, binary.Read(reader, binary.LittleEndian, lineHeader))
+		test_helpers.AssertNilError(t, binary.Read(reader, binary.LittleEndian, lineData))
+		test_helpers.AssertEqual(t, true, reflect.DeepEqual([]int32{int32(lineIndex), 24}, lineHeader))
+
+		line := radianceMatrix.GetRadiances()[lineIndex]
+		expectedLineData := []float32{line[0][2], line[1][2], line[0][1], line[1][1], line[0][0], line[1][0]}
+		test_helpers.AssertEqual(t, true, reflect.DeepEqual(expectedLineData, lineData))
+	}
+}
+
+// TestController_Encode_UnsupportedImageFormatError tests encoding a RadianceMatrix to an unsupported format.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Encode_UnsupportedImageFormatError(t *testing.T) {
+	controller := Controller{}
+	var buffer bytes.Buffer
+	test_helpers.AssertNotNilError(t, controller.Encode(buildTestRadianceMatrix(), "image/png", &buffer))
+}
+
+// TestController_Crop tests copying a window of a RadianceMatrix.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Crop(t *testing.T) {
+	controller := Controller{}
+	radianceMatrix, err := controller.Crop(buildTestRadianceMatrix(), 0, 1, 2, 2)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([][][]float32{{{2, 3, 4}}, {{5, 6, 7}}},
+		radianceMatrix.GetRadiances()))
+}
+
+// TestController_Crop_Error tests copying windows that are not inside a RadianceMatrix.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Crop_Error(t *testing.T) {
+	controller := Controller{}
+	for _, window := range [][]int{{-1, 0, 1, 1}, {0, 0, 3, 2}, {1, 1, 1, 2}} {
+		_, err := controller.Crop(buildTestRadianceMatrix(), window[0], window[1], window[2], window[3])
+		test_helpers.AssertNotNilError(t, err)
+	}
+}
@@ -0,0 +1,62 @@
+package radiance_matrix
+
+import (
+	"errors"
+	"fmt"
+)
+
+// indexError is the error where we try to access an index out of the limits of the RadianceMatrix.
+//
+// Parameters:
+//	radianceMatrix - The RadianceMatrix.
+//	lineIndex      - The index of the line.
+//	columnIndex    - The index of the column.
+//
+// Returns:
+//  An Error.
+//
+func indexError(radianceMatrix *RadianceMatrix, lineIndex, columnIndex int) error {
+	errorMessage := fmt.Sprintf(
+		"Index out of limits of the radiance matrix. Expected from 0 0 to %v %v and got %v %v.",
+		radianceMatrix.Lines(), radianceMatrix.Columns(), lineIndex, columnIndex)
+	return errors.New(errorMessage)
+}
+
+// nonRGBRadianceError is the error where the radiance does not have 3 values.
+//
+// Parameters:
+//	radiance - The non RGB radiance.
+//
+// Returns:
+//  An Error.
+//
+func nonRGBRadianceError(radiance []float64) error {
+	errorMessage := fmt.Sprintf("Non RGB radiance: %v.", radiance)
+	return errors.New(errorMessage)
+}
+
+// unsupportedImageFormatError is the error where a RadianceMatrix can not be encoded to an image format.
+//
+// Parameters:
+//	format - The media type of the image format.
+//
+// Returns:
+//  An Error.
+//
+func unsupportedImageFormatError(format string) error {
+	errorMessage := fmt.Sprintf("Unsupported image format: %s.", format)
+	return errors.New(errorMessage)
+}
+
+// invalidPfmError is the error where an image is not a valid color PFM image.
+//
+// Parameters:
+//	reason - The reason the image is invalid.
+//
+// Returns:
+//  An Error.
+//
+func invalidPfmError(reason string) error {
+	errorMessage := fmt.Sprintf("Invalid PFM image: %s.", reason)
+	return errors.New(errorMessage)
+}
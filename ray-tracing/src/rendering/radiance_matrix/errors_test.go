@@ -0,0 +1,73 @@
+package radiance_matrix
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestRadianceMatrix_IndexError tests the error where we try to access an index out of the limits of the
+// RadianceMatrix.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRadianceMatrix_IndexError(t *testing.T) {
+	radianceMatrix := &RadianceMatrix{radiances: [][][]float32{{{1, 2, 3}, {4, 5, 6}}}}
+	expectedErrorMessage := fmt.Sprintf(
+		"Index out of limits of the radiance matrix. Expected from 0 0 to %v %v and got %v %v.",
+		radianceMatrix.Lines(), radianceMatrix.Columns(), -1, -1)
+	err := indexError(radianceMatrix, -1, -1)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestRadianceMatrix_NonRGBRadianceError tests the error where the radiance does not have 3 values.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRadianceMatrix_NonRGBRadianceError(t *testing.T) {
+	radiance := []float64{1}
+	expectedErrorMessage := fmt.Sprintf("Non RGB radiance: %v.", radiance)
+	err := nonRGBRadianceError(radiance)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestRadianceMatrix_UnsupportedImageFormatError tests the error where a RadianceMatrix can not be encoded to an
+// image format.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRadianceMatrix_UnsupportedImageFormatError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Unsupported image format: %s.", "image/png")
+	err := unsupportedImageFormatError("image/png")
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestRadianceMatrix_InvalidPfmError tests the error where an image is not a valid color PFM image.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRadianceMatrix_InvalidPfmError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid PFM image: %s.", "missing pixels")
+	err := invalidPfmError("missing pixels")
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
@@ -3,9 +3,11 @@ package ray
 import (
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/line"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/point_repository"
+	"math"
 )
 
 // Controller is a class for controlling ray intersections.
@@ -92,3 +94,57 @@ func (*Controller) IntersectRayTriangle(ray *line.Line, targetTriangle *triangle
 
 	return 0, nil, false, nil
 }
+
+// IntersectRaySphere calculates the intersections between a ray and a sphere.
+//
+// Parameters:
+// 	ray          - The line.
+//  targetSphere - The target sphere.
+//  repository   - The point repository that has the center of the sphere.
+//
+// Returns:
+//  The line t parameters (A + tV) of the intersections, in increasing order.
+//  A flag checking if has intersection.
+//  An error.
+//
+func (*Controller) IntersectRaySphere(ray *line.Line, targetSphere *sphere.Sphere,
+	repository *point_repository.PointRepository) ([]float64, bool, error) {
+
+	if ray.Dimension() != 3 || repository.PointsDimension() != 3 {
+		return nil, false, non3DRayPointsError(ray, repository)
+	}
+
+	center, err := repository.GetPoint(targetSphere.GetCenterPointIndex())
+	if err != nil {
+		return nil, false, err
+	}
+
+	EPSILON := 0.0000001
+
+	pointController := point.Controller{}
+	vectorController := vector.Controller{}
+
+	rayVector := ray.GetVectorDirector()
+	centerToOrigin, _ := pointController.ExtractVector(center, ray.GetStartingPoint())
+
+	// |O + tV - C|^2 = r^2 => (V.V)t^2 + 2(V.(O - C))t + (O - C).(O - C) - r^2 = 0
+	a, _ := vectorController.DotProduct(rayVector, rayVector)
+	halfB, _ := vectorController.DotProduct(rayVector, centerToOrigin)
+	centerToOriginSquaredNorm, _ := vectorController.DotProduct(centerToOrigin, centerToOrigin)
+	c := centerToOriginSquaredNorm - targetSphere.GetRadius()*targetSphere.GetRadius()
+
+	discriminant := halfB*halfB - a*c
+	if a < EPSILON || discriminant < 0 {
+		return nil, false, nil
+	}
+	squareRootDiscriminant := math.Sqrt(discriminant)
+
+	lineParametricParameters := make([]float64, 0, 2)
+	for _, lineParametricParameter := range []float64{(-halfB - squareRootDiscriminant) / a,
+		(-halfB + squareRootDiscriminant) / a} {
+		if lineParametricParameter > EPSILON && lineParametricParameter < 1/EPSILON {
+			lineParametricParameters = append(lineParametricParameters, lineParametricParameter)
+		}
+	}
+	return lineParametricParameters, len(lineParametricParameters) > 0, nil
+}
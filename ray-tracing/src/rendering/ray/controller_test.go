@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/line"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/point_repository"
@@ -281,3 +282,136 @@ func TestController_IntersectRayTriangle_RayParallelToTriangle(t *testing.T) {
 	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64(nil), barycentricCoordinates))
 	test_helpers.AssertEqual(t, false, hasIntersection)
 }
+
+// buildSampleRay builds a tridimensional ray for testing.
+//
+// Parameters:
+//  t         - Test instance.
+//  origin    - The coordinates of the starting point.
+//  direction - The coordinates of the vector director.
+//
+// Returns:
+//  A ray.
+//
+func buildSampleRay(t *testing.T, origin, direction []float64) *line.Line {
+	rayStartingPoint, err := point.Init(3)
+	test_helpers.AssertNilError(t, err)
+	rayVectorDirector, err := vector.Init(3)
+	test_helpers.AssertNilError(t, err)
+	for coordinateIndex := 0; coordinateIndex < 3; coordinateIndex++ {
+		err = rayStartingPoint.SetCoordinate(coordinateIndex, origin[coordinateIndex])
+		test_helpers.AssertNilError(t, err)
+		err = rayVectorDirector.SetCoordinate(coordinateIndex, direction[coordinateIndex])
+		test_helpers.AssertNilError(t, err)
+	}
+	ray, err := line.Init(rayStartingPoint, rayVectorDirector)
+	test_helpers.AssertNilError(t, err)
+	return ray
+}
+
+// TestController_IntersectRaySphere tests the intersection between a ray and a sphere.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_IntersectRaySphere(t *testing.T) {
+	repository := buildSamplePointRepository(t)
+	targetSphere := sphere.Init(0, 1)
+
+	rayController := Controller{}
+	parametricParameters, hasIntersection, err := rayController.IntersectRaySphere(
+		buildSampleRay(t, []float64{-2, 0, 0}, []float64{2, 0, 0}), targetSphere, repository)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, hasIntersection)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{1.5, 2.5}, parametricParameters))
+}
+
+// TestController_IntersectRaySphere_Inside tests the intersection between a sphere and a ray that starts inside it.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_IntersectRaySphere_Inside(t *testing.T) {
+	repository := buildSamplePointRepository(t)
+	targetSphere := sphere.Init(0, 1)
+
+	rayController := Controller{}
+	parametricParameters, hasIntersection, err := rayController.IntersectRaySphere(
+		buildSampleRay(t, []float64{2, 0, 0}, []float64{0, 0, 0.5}), targetSphere, repository)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, hasIntersection)
+	test_helpers.AssertEqual(t, true, reflect.DeepEqual([]float64{2}, parametricParameters))
+}
+
+// TestController_IntersectRaySphere_Miss tests a ray that does not intersect a sphere.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_IntersectRaySphere_Miss(t *testing.T) {
+	repository := buildSamplePointRepository(t)
+	targetSphere := sphere.Init(0, 1)
+
+	rayController := Controller{}
+	_, hasIntersection, err := rayController.IntersectRaySphere(
+		buildSampleRay(t, []float64{-2, 2, 0}, []float64{1, 0, 0}), targetSphere, repository)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, false, hasIntersection)
+
+	_, hasIntersection, err = rayController.IntersectRaySphere(
+		buildSampleRay(t, []float64{-2, 0, 0}, []float64{-1, 0, 0}), targetSphere, repository)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, false, hasIntersection)
+}
+
+// TestController_IntersectRaySphere_Non3D tests the intersection between a sphere and a ray not on the third
+// dimension.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_IntersectRaySphere_Non3D(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf(
+		"Non 3D ray or repository. Ray dimension: %d and point repository dimension: %d.", 2, 3)
+
+	rayStartingPoint, err := point.Init(2)
+	test_helpers.AssertNilError(t, err)
+	rayVectorDirector, err := vector.Init(2)
+	test_helpers.AssertNilError(t, err)
+	ray, err := line.Init(rayStartingPoint, rayVectorDirector)
+	test_helpers.AssertNilError(t, err)
+
+	rayController := Controller{}
+	_, _, err = rayController.IntersectRaySphere(ray, sphere.Init(0, 1), buildSamplePointRepository(t))
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestController_IntersectRaySphere_InvalidCenter tests the intersection with a sphere whose center is not on the
+// repository.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_IntersectRaySphere_InvalidCenter(t *testing.T) {
+	rayController := Controller{}
+	_, hasIntersection, err := rayController.IntersectRaySphere(
+		buildSampleRay(t, []float64{-2, 0, 0}, []float64{1, 0, 0}), sphere.Init(7, 1), buildSamplePointRepository(t))
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, false, hasIntersection)
+}
@@ -0,0 +1,129 @@
+package sampler
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/random_source"
+	"math/bits"
+)
+
+// Controller is a class for the operations shared by the samplers.
+//
+// Members:
+// 	none
+//
+type Controller struct {}
+
+// deriveUint32 derives a pseudo random 32 bit number from a seed and the indexes of a pixel and a dimension.
+//
+// Parameters:
+// 	seed    - The seed.
+// 	indexes - The indexes.
+//
+// Returns:
+// 	The number.
+//
+func (*Controller) deriveUint32(seed int64, indexes ...int) uint32 {
+	randomSourceController := random_source.Controller{}
+	return uint32(uint64(randomSourceController.DeriveSeed(seed, indexes...)) >> 32)
+}
+
+// uint32ToFloat converts a 32 bit number to a number in [0,1).
+//
+// Parameters:
+// 	value - The number.
+//
+// Returns:
+// 	The number divided by 2^32.
+//
+func (*Controller) uint32ToFloat(value uint32) float64 {
+	return float64(value) / (1 << 32)
+}
+
+// permute finds the element of a pseudo random permutation of [0,length) at an index, without building the
+// permutation, as proposed by Andrew Kensler in Correlated Multi-Jittered Sampling.
+//
+// Parameters:
+// 	index           - The index in [0,length).
+// 	length          - The length of the permutation.
+// 	permutationSeed - The seed that chooses the permutation.
+//
+// Returns:
+// 	The element of the permutation.
+//
+func (*Controller) permute(index, length, permutationSeed uint32) uint32 {
+	mask := length - 1
+	mask |= mask >> 1
+	mask |= mask >> 2
+	mask |= mask >> 4
+	mask |= mask >> 8
+	mask |= mask >> 16
+	for {
+		index ^= permutationSeed
+		index *= 0xe170893d
+		index ^= permutationSeed >> 16
+		index ^= (index & mask) >> 4
+		index ^= permutationSeed >> 8
+		index *= 0x0929eb3f
+		index ^= permutationSeed >> 23
+		index ^= (index & mask) >> 1
+		index *= 1 | permutationSeed>>27
+		index *= 0x6935fa69
+		index ^= (index & mask) >> 11
+		index *= 0x74dcb303
+		index ^= (index & mask) >> 2
+		index *= 0x9e501cc3
+		index ^= (index & mask) >> 2
+		index *= 0xc860a3df
+		index &= mask
+		index ^= index >> 5
+		if index < length {
+			break
+		}
+	}
+	return (index + permutationSeed) % length
+}
+
+// radicalInverse mirrors the digits of an index in a base around the decimal point.
+//
+// Parameters:
+// 	base  - The base.
+// 	index - The index.
+//
+// Returns:
+// 	The radical inverse in [0,1).
+//
+func (*Controller) radicalInverse(base, index uint64) float64 {
+	inverseBase := 1 / float64(base)
+	reversedDigits := uint64(0)
+	inverseBasePower := 1.0
+	for index > 0 {
+		nextIndex := index / base
+		reversedDigits = reversedDigits*base + index - nextIndex*base
+		inverseBasePower *= inverseBase
+		index = nextIndex
+	}
+	radicalInverse := float64(reversedDigits) * inverseBasePower
+	if radicalInverse >= 1 {
+		return 1 - 1e-16
+	}
+	return radicalInverse
+}
+
+// nestedUniformScramble applies a pseudo random Owen scrambling to the binary digits of a number, as proposed by Brent
+// Burley in Practical Hash-based Owen Scrambling.
+//
+// Parameters:
+// 	value         - The number, whose most significant bit is the first binary digit.
+// 	scramblerSeed - The seed that chooses the scrambling.
+//
+// Returns:
+// 	The scrambled number.
+//
+func (*Controller) nestedUniformScramble(value, scramblerSeed uint32) uint32 {
+	value = bits.Reverse32(value)
+	value += scramblerSeed
+	value ^= value * 0x6c50b47c
+	value ^= value * 0xb82f1e52
+	value ^= value * 0xc7afe638
+	value ^= value * 0x8d22f6e6
+	return bits.Reverse32(value)
+}
@@ -0,0 +1,67 @@
+package sampler
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/math_helper"
+	"testing"
+)
+
+// TestController_Permute tests that the permutations contain every index once.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Permute(t *testing.T) {
+	controller := Controller{}
+	for _, length := range []uint32{1, 2, 7, 16, 100} {
+		for _, permutationSeed := range []uint32{0, 1, 0xdeadbeef} {
+			found := make([]bool, length)
+			for index := uint32(0); index < length; index++ {
+				element := controller.permute(index, length, permutationSeed)
+				test_helpers.AssertEqual(t, true, element < length)
+				test_helpers.AssertEqual(t, false, found[element])
+				found[element] = true
+			}
+		}
+	}
+}
+
+// TestController_RadicalInverse tests the radical inverse.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_RadicalInverse(t *testing.T) {
+	controller := Controller{}
+	test_helpers.AssertEqual(t, 0.0, controller.radicalInverse(2, 0))
+	test_helpers.AssertEqual(t, 0.5, controller.radicalInverse(2, 1))
+	test_helpers.AssertEqual(t, 0.25, controller.radicalInverse(2, 2))
+	test_helpers.AssertEqual(t, 0.75, controller.radicalInverse(2, 3))
+	test_helpers.AssertEqual(t, 0.375, controller.radicalInverse(2, 6))
+	test_helpers.AssertEqual(t, true, math_helper.Init(1e-12).IsEqualWithTolerance(7.0/9,
+		controller.radicalInverse(3, 5)))
+}
+
+// TestController_NestedUniformScramble tests that the scrambling keeps the numbers that share their first binary
+// digits together.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_NestedUniformScramble(t *testing.T) {
+	controller := Controller{}
+	for value := uint32(0); value < 64; value++ {
+		firstScrambled := controller.nestedUniformScramble(value<<26, 0x1234)
+		secondScrambled := controller.nestedUniformScramble(value<<26|0x3ffffff, 0x1234)
+		test_helpers.AssertEqual(t, firstScrambled>>26, secondScrambled>>26)
+	}
+}
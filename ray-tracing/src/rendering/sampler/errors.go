@@ -0,0 +1,20 @@
+package sampler
+
+import (
+	"errors"
+	"fmt"
+)
+
+// samplerTypeError is the error where the type of a Sampler is unknown.
+//
+// Parameters:
+//	samplerType - The type of the Sampler.
+//
+// Returns:
+//  An Error.
+//
+func samplerTypeError(samplerType string) error {
+	errorMessage := fmt.Sprintf("Invalid sampler %s. Expected %s, %s, %s or %s.", samplerType,
+		IndependentSamplerType, StratifiedSamplerType, HaltonSamplerType, SobolSamplerType)
+	return errors.New(errorMessage)
+}
@@ -0,0 +1,23 @@
+package sampler
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestSampler_SamplerTypeError tests the sampler type error.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestSampler_SamplerTypeError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid sampler %s. Expected %s, %s, %s or %s.", "random",
+		IndependentSamplerType, StratifiedSamplerType, HaltonSamplerType, SobolSamplerType)
+	err := samplerTypeError("random")
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
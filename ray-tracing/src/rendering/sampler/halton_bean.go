@@ -0,0 +1,92 @@
+package sampler
+
+// haltonBases are the prime bases of the dimensions of the Halton sequence. Higher bases give poorly distributed
+// points for few samples, so the later dimensions are independent random numbers.
+var haltonBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53}
+
+// Halton is a class for a Sampler that uses the Halton sequence, with the index of the sample as the index on the
+// sequence. Every pixel applies its own random rotation to each dimension, so the pixels are not correlated.
+//
+// Members:
+// 	seed        - The seed of the Sampler.
+// 	lineIndex   - The line index of the current pixel.
+// 	columnIndex - The column index of the current pixel.
+// 	sampleIndex - The index of the current sample.
+// 	dimension   - The next dimension of the current sample.
+// 	fallback    - The source of the dimensions beyond the bases.
+//
+type Halton struct {
+	seed        int64
+	lineIndex   int
+	columnIndex int
+	sampleIndex int
+	dimension   int
+	fallback    *Independent
+}
+
+// StartPixelSample starts the numbers of a sample of a pixel.
+//
+// Parameters:
+// 	lineIndex   - The line index of the pixel.
+// 	columnIndex - The column index of the pixel.
+// 	sampleIndex - The index of the sample in the pixel.
+//
+// Returns:
+// 	none
+//
+func (halton *Halton) StartPixelSample(lineIndex, columnIndex, sampleIndex int) {
+	halton.lineIndex = lineIndex
+	halton.columnIndex = columnIndex
+	halton.sampleIndex = sampleIndex
+	halton.dimension = 0
+	halton.fallback.StartPixelSample(lineIndex, columnIndex, sampleIndex)
+}
+
+// Get1D gets the next dimension of the sample.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	A number in [0,1).
+//
+func (halton *Halton) Get1D() float64 {
+	if halton.dimension >= len(haltonBases) {
+		return halton.fallback.Get1D()
+	}
+	controller := Controller{}
+	rotation := controller.uint32ToFloat(controller.deriveUint32(halton.seed, halton.lineIndex, halton.columnIndex,
+		halton.dimension))
+	number := controller.radicalInverse(haltonBases[halton.dimension], uint64(halton.sampleIndex)) + rotation
+	halton.dimension++
+	if number >= 1 {
+		number--
+	}
+	return number
+}
+
+// Get2D gets the next two dimensions of the sample.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	A number in [0,1).
+// 	A number in [0,1).
+//
+func (halton *Halton) Get2D() (float64, float64) {
+	firstNumber := halton.Get1D()
+	return firstNumber, halton.Get1D()
+}
+
+// InitHalton initializes a Halton Sampler.
+//
+// Parameters:
+// 	seed - The seed of the Sampler.
+//
+// Returns:
+// 	The Halton Sampler.
+//
+func InitHalton(seed int64) *Halton {
+	return &Halton{seed: seed, fallback: InitIndependent(seed)}
+}
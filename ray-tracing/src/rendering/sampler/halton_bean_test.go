@@ -0,0 +1,48 @@
+package sampler
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"math"
+	"sort"
+	"testing"
+)
+
+// TestHalton_Get1D tests that the first samples of a pixel are evenly spaced on the first dimension, up to the
+// rotation of the pixel.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestHalton_Get1D(t *testing.T) {
+	halton := InitHalton(9)
+	samplesPerPixel := 8
+	numbers := make([]float64, samplesPerPixel)
+	for sampleIndex := 0; sampleIndex < samplesPerPixel; sampleIndex++ {
+		halton.StartPixelSample(4, 4, sampleIndex)
+		numbers[sampleIndex] = halton.Get1D()
+	}
+	sort.Float64s(numbers)
+	for index := 1; index < samplesPerPixel; index++ {
+		test_helpers.AssertEqual(t, true, math.Abs(numbers[index]-numbers[index-1]-0.125) < 1e-9)
+	}
+}
+
+// TestHalton_Get1D_Fallback tests the dimensions beyond the bases of the Halton sequence.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestHalton_Get1D_Fallback(t *testing.T) {
+	halton := InitHalton(9)
+	halton.StartPixelSample(0, 0, 0)
+	for dimension := 0; dimension < len(haltonBases)+4; dimension++ {
+		number := halton.Get1D()
+		test_helpers.AssertEqual(t, true, number >= 0 && number < 1)
+	}
+}
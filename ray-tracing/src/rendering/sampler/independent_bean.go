@@ -0,0 +1,74 @@
+package sampler
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/utils/random_source"
+	"math/rand"
+)
+
+// Independent is a class for a Sampler whose numbers are uniform and independent from each other.
+//
+// Members:
+// 	seed         - The seed of the Sampler.
+// 	randomSource - The source of the random numbers, seeded again for every sample.
+// 	generator    - The generator of the random numbers.
+//
+type Independent struct {
+	seed         int64
+	randomSource *random_source.RandomSource
+	generator    *rand.Rand
+}
+
+// StartPixelSample starts the numbers of a sample of a pixel.
+//
+// Parameters:
+// 	lineIndex   - The line index of the pixel.
+// 	columnIndex - The column index of the pixel.
+// 	sampleIndex - The index of the sample in the pixel.
+//
+// Returns:
+// 	none
+//
+func (independent *Independent) StartPixelSample(lineIndex, columnIndex, sampleIndex int) {
+	randomSourceController := random_source.Controller{}
+	independent.randomSource.Seed(randomSourceController.DeriveSeed(independent.seed, lineIndex, columnIndex,
+		sampleIndex))
+}
+
+// Get1D gets the next dimension of the sample.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	A number in [0,1).
+//
+func (independent *Independent) Get1D() float64 {
+	return independent.generator.Float64()
+}
+
+// Get2D gets the next two dimensions of the sample.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	A number in [0,1).
+// 	A number in [0,1).
+//
+func (independent *Independent) Get2D() (float64, float64) {
+	firstNumber := independent.generator.Float64()
+	return firstNumber, independent.generator.Float64()
+}
+
+// InitIndependent initializes an Independent Sampler.
+//
+// Parameters:
+// 	seed - The seed of the Sampler.
+//
+// Returns:
+// 	The Independent Sampler.
+//
+func InitIndependent(seed int64) *Independent {
+	randomSource := random_source.Init(seed)
+	return &Independent{seed: seed, randomSource: randomSource, generator: rand.New(randomSource)}
+}
@@ -0,0 +1,87 @@
+package sampler
+
+// The types of samplers a path tracing can use.
+const (
+	// IndependentSamplerType draws uniform random numbers.
+	IndependentSamplerType = "independent"
+	// StratifiedSamplerType jitters the samples of a pixel inside shuffled strata.
+	StratifiedSamplerType = "stratified"
+	// HaltonSamplerType uses the Halton sequence, rotated for every pixel.
+	HaltonSamplerType = "halton"
+	// SobolSamplerType uses the Sobol sequence with Owen scrambling.
+	SobolSamplerType = "sobol"
+)
+
+// Sampler is the interface for the generators of the numbers in [0,1) used to sample the paths of a pixel.
+// Every number asked after starting a sample is a new dimension of the sample, so the paths must ask for the numbers
+// in the same order for the samples of a pixel to be well distributed on each dimension.
+// A Sampler holds state and must not be shared between goroutines.
+//
+// Methods:
+// 	StartPixelSample - Starts the numbers of a sample of a pixel.
+// 	Get1D            - Gets the next dimension of the sample.
+// 	Get2D            - Gets the next two dimensions of the sample.
+//
+type Sampler interface {
+	// StartPixelSample starts the numbers of a sample of a pixel, which only depend on the pixel, the index of the
+	// sample and the seed of the Sampler.
+	//
+	// Parameters:
+	// 	lineIndex   - The line index of the pixel.
+	// 	columnIndex - The column index of the pixel.
+	// 	sampleIndex - The index of the sample in the pixel.
+	//
+	// Returns:
+	// 	none
+	//
+	StartPixelSample(lineIndex, columnIndex, sampleIndex int)
+
+	// Get1D gets the next dimension of the sample.
+	//
+	// Parameters:
+	// 	none
+	//
+	// Returns:
+	// 	A number in [0,1).
+	//
+	Get1D() float64
+
+	// Get2D gets the next two dimensions of the sample, which are well distributed together.
+	//
+	// Parameters:
+	// 	none
+	//
+	// Returns:
+	// 	A number in [0,1).
+	// 	A number in [0,1).
+	//
+	Get2D() (float64, float64)
+}
+
+// Init initializes a Sampler.
+//
+// Parameters:
+// 	samplerType     - The type of the Sampler.
+// 	samplesPerPixel - The number of samples of every pixel, at least 1.
+// 	seed            - The seed of the Sampler.
+//
+// Returns:
+// 	The Sampler.
+// 	An error.
+//
+func Init(samplerType string, samplesPerPixel int, seed int64) (Sampler, error) {
+	if samplesPerPixel < 1 {
+		samplesPerPixel = 1
+	}
+	switch samplerType {
+	case IndependentSamplerType:
+		return InitIndependent(seed), nil
+	case StratifiedSamplerType:
+		return InitStratified(samplesPerPixel, seed), nil
+	case HaltonSamplerType:
+		return InitHalton(seed), nil
+	case SobolSamplerType:
+		return InitSobol(seed), nil
+	}
+	return nil, samplerTypeError(samplerType)
+}
@@ -0,0 +1,79 @@
+package sampler
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"reflect"
+	"testing"
+)
+
+// TestSampler_Init tests the instantiation of every type of Sampler.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestSampler_Init(t *testing.T) {
+	expectedTypes := map[string]reflect.Type{
+		IndependentSamplerType: reflect.TypeOf(&Independent{}),
+		StratifiedSamplerType:  reflect.TypeOf(&Stratified{}),
+		HaltonSamplerType:      reflect.TypeOf(&Halton{}),
+		SobolSamplerType:       reflect.TypeOf(&Sobol{}),
+	}
+	for samplerType, expectedType := range expectedTypes {
+		pixelSampler, err := Init(samplerType, 0, 3)
+		test_helpers.AssertNilError(t, err)
+		test_helpers.AssertEqual(t, expectedType, reflect.TypeOf(pixelSampler))
+	}
+}
+
+// TestSampler_Init_SamplerTypeError tests the instantiation of a Sampler of an unknown type.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestSampler_Init_SamplerTypeError(t *testing.T) {
+	_, err := Init("random", 4, 3)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, samplerTypeError("random").Error(), err.Error())
+}
+
+// TestSampler_Determinism tests that every type of Sampler gives the same numbers for the same sample, no matter the
+// samples drawn before it, and numbers in [0,1).
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestSampler_Determinism(t *testing.T) {
+	for _, samplerType := range []string{IndependentSamplerType, StratifiedSamplerType, HaltonSamplerType,
+		SobolSamplerType} {
+		pixelSampler, err := Init(samplerType, 8, 11)
+		test_helpers.AssertNilError(t, err)
+		drawSample := func(lineIndex, columnIndex, sampleIndex int) []float64 {
+			pixelSampler.StartPixelSample(lineIndex, columnIndex, sampleIndex)
+			numbers := make([]float64, 0)
+			for dimension := 0; dimension < 20; dimension++ {
+				numbers = append(numbers, pixelSampler.Get1D())
+				firstNumber, secondNumber := pixelSampler.Get2D()
+				numbers = append(numbers, firstNumber, secondNumber)
+			}
+			for _, number := range numbers {
+				test_helpers.AssertEqual(t, true, number >= 0 && number < 1)
+			}
+			return numbers
+		}
+
+		firstNumbers := drawSample(2, 3, 5)
+		drawSample(4, 1, 0)
+		test_helpers.AssertEqual(t, true, reflect.DeepEqual(firstNumbers, drawSample(2, 3, 5)))
+		test_helpers.AssertEqual(t, false, reflect.DeepEqual(firstNumbers, drawSample(3, 2, 5)))
+		test_helpers.AssertEqual(t, false, reflect.DeepEqual(firstNumbers, drawSample(2, 3, 6)))
+	}
+}
@@ -0,0 +1,143 @@
+package sampler
+
+import (
+	"math/bits"
+)
+
+// sobolSecondDirections are the direction numbers of the second dimension of the Sobol sequence. The first dimension
+// is the index with its bits reversed.
+var sobolSecondDirections = buildSobolSecondDirections()
+
+// buildSobolSecondDirections builds the direction numbers of the second dimension of the Sobol sequence.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The direction numbers, one for each bit of the index.
+//
+func buildSobolSecondDirections() []uint32 {
+	directions := make([]uint32, 32)
+	directions[0] = 1 << 31
+	for bitIndex := 1; bitIndex < len(directions); bitIndex++ {
+		directions[bitIndex] = directions[bitIndex-1] ^ (directions[bitIndex-1] >> 1)
+	}
+	return directions
+}
+
+// Sobol is a class for a Sampler that uses the first two dimensions of the Sobol sequence with Owen scrambling, as
+// proposed by Brent Burley in Practical Hash-based Owen Scrambling. Every pair of dimensions shuffles the order of the
+// points and scrambles them with its own seeds, so the pairs and the pixels are not correlated while each pair keeps
+// the stratification of the Sobol sequence.
+//
+// Members:
+// 	seed        - The seed of the Sampler.
+// 	lineIndex   - The line index of the current pixel.
+// 	columnIndex - The column index of the current pixel.
+// 	sampleIndex - The index of the current sample.
+// 	dimension   - The next dimension of the current sample.
+//
+type Sobol struct {
+	seed        int64
+	lineIndex   int
+	columnIndex int
+	sampleIndex int
+	dimension   int
+}
+
+// StartPixelSample starts the numbers of a sample of a pixel.
+//
+// Parameters:
+// 	lineIndex   - The line index of the pixel.
+// 	columnIndex - The column index of the pixel.
+// 	sampleIndex - The index of the sample in the pixel.
+//
+// Returns:
+// 	none
+//
+func (sobol *Sobol) StartPixelSample(lineIndex, columnIndex, sampleIndex int) {
+	sobol.lineIndex = lineIndex
+	sobol.columnIndex = columnIndex
+	sobol.sampleIndex = sampleIndex
+	sobol.dimension = 0
+}
+
+// findSecondDimension finds the second dimension of a point of the Sobol sequence.
+//
+// Parameters:
+// 	index - The index of the point.
+//
+// Returns:
+// 	The second dimension, as a fraction of 2^32.
+//
+func (*Sobol) findSecondDimension(index uint32) uint32 {
+	number := uint32(0)
+	for bitIndex := 0; index != 0; bitIndex++ {
+		if index&1 == 1 {
+			number ^= sobolSecondDirections[bitIndex]
+		}
+		index >>= 1
+	}
+	return number
+}
+
+// findShuffledIndex finds the index on the Sobol sequence of the current sample, shuffled for the next dimension.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The index.
+// 	The seed for scrambling the point.
+//
+func (sobol *Sobol) findShuffledIndex() (uint32, uint32) {
+	controller := Controller{}
+	dimensionSeed := controller.deriveUint32(sobol.seed, sobol.lineIndex, sobol.columnIndex, sobol.dimension)
+	return controller.nestedUniformScramble(uint32(sobol.sampleIndex), dimensionSeed), dimensionSeed
+}
+
+// Get1D gets the next dimension of the sample.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	A number in [0,1).
+//
+func (sobol *Sobol) Get1D() float64 {
+	controller := Controller{}
+	index, dimensionSeed := sobol.findShuffledIndex()
+	sobol.dimension++
+	number := controller.nestedUniformScramble(bits.Reverse32(index), dimensionSeed^0x9e3779b9)
+	return controller.uint32ToFloat(number)
+}
+
+// Get2D gets the next two dimensions of the sample.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	A number in [0,1).
+// 	A number in [0,1).
+//
+func (sobol *Sobol) Get2D() (float64, float64) {
+	controller := Controller{}
+	index, dimensionSeed := sobol.findShuffledIndex()
+	sobol.dimension += 2
+	firstNumber := controller.nestedUniformScramble(bits.Reverse32(index), dimensionSeed^0x9e3779b9)
+	secondNumber := controller.nestedUniformScramble(sobol.findSecondDimension(index), dimensionSeed^0x7f4a7c15)
+	return controller.uint32ToFloat(firstNumber), controller.uint32ToFloat(secondNumber)
+}
+
+// InitSobol initializes a Sobol Sampler.
+//
+// Parameters:
+// 	seed - The seed of the Sampler.
+//
+// Returns:
+// 	The Sobol Sampler.
+//
+func InitSobol(seed int64) *Sobol {
+	return &Sobol{seed: seed}
+}
@@ -0,0 +1,62 @@
+package sampler
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestSobol_Get2D tests that the first 16 samples of a pixel put one point in every elementary interval of area 1/16,
+// on every pair of dimensions.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestSobol_Get2D(t *testing.T) {
+	sobol := InitSobol(13)
+	samplesPerPixel := 16
+	for pairIndex := 0; pairIndex < 3; pairIndex++ {
+		firstNumbers := make([]float64, samplesPerPixel)
+		secondNumbers := make([]float64, samplesPerPixel)
+		for sampleIndex := 0; sampleIndex < samplesPerPixel; sampleIndex++ {
+			sobol.StartPixelSample(6, 2, sampleIndex)
+			for skippedPair := 0; skippedPair < pairIndex; skippedPair++ {
+				sobol.Get2D()
+			}
+			firstNumbers[sampleIndex], secondNumbers[sampleIndex] = sobol.Get2D()
+		}
+		for firstDivisions := 1; firstDivisions <= samplesPerPixel; firstDivisions *= 2 {
+			secondDivisions := samplesPerPixel / firstDivisions
+			found := make(map[int]bool)
+			for sampleIndex := 0; sampleIndex < samplesPerPixel; sampleIndex++ {
+				interval := int(firstNumbers[sampleIndex]*float64(firstDivisions))*secondDivisions +
+					int(secondNumbers[sampleIndex]*float64(secondDivisions))
+				test_helpers.AssertEqual(t, false, found[interval])
+				found[interval] = true
+			}
+		}
+	}
+}
+
+// TestSobol_Get1D tests that the first 8 samples of a pixel fall in different eighths of a dimension.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestSobol_Get1D(t *testing.T) {
+	sobol := InitSobol(13)
+	samplesPerPixel := 8
+	found := make([]bool, samplesPerPixel)
+	for sampleIndex := 0; sampleIndex < samplesPerPixel; sampleIndex++ {
+		sobol.StartPixelSample(6, 2, sampleIndex)
+		sobol.Get1D()
+		interval := int(sobol.Get1D() * float64(samplesPerPixel))
+		test_helpers.AssertEqual(t, false, found[interval])
+		found[interval] = true
+	}
+}
@@ -0,0 +1,143 @@
+package sampler
+
+import "math"
+
+// Stratified is a class for a Sampler that splits every dimension in as many strata as samples of a pixel, and jitters
+// each sample inside its own stratum. The strata are shuffled for every pixel and dimension, so the dimensions are not
+// correlated. The pairs of dimensions are also stratified on a grid of as many cells as samples of a pixel. Samples
+// beyond the number of samples of a pixel reuse the strata.
+//
+// Members:
+// 	samplesPerPixel - The number of samples of every pixel.
+// 	gridColumns     - The number of columns of the grid of the pairs of dimensions.
+// 	gridLines       - The number of lines of the grid of the pairs of dimensions.
+// 	seed            - The seed of the Sampler.
+// 	lineIndex       - The line index of the current pixel.
+// 	columnIndex     - The column index of the current pixel.
+// 	sampleIndex     - The index of the current sample.
+// 	dimension       - The next dimension of the current sample.
+// 	jitter          - The source of the jitter inside the strata.
+//
+type Stratified struct {
+	samplesPerPixel int
+	gridColumns     int
+	gridLines       int
+	seed            int64
+	lineIndex       int
+	columnIndex     int
+	sampleIndex     int
+	dimension       int
+	jitter          *Independent
+}
+
+// StartPixelSample starts the numbers of a sample of a pixel.
+//
+// Parameters:
+// 	lineIndex   - The line index of the pixel.
+// 	columnIndex - The column index of the pixel.
+// 	sampleIndex - The index of the sample in the pixel.
+//
+// Returns:
+// 	none
+//
+func (stratified *Stratified) StartPixelSample(lineIndex, columnIndex, sampleIndex int) {
+	stratified.lineIndex = lineIndex
+	stratified.columnIndex = columnIndex
+	stratified.sampleIndex = sampleIndex
+	stratified.dimension = 0
+	stratified.jitter.StartPixelSample(lineIndex, columnIndex, sampleIndex)
+}
+
+// findStratum finds the shuffled stratum of the current sample on the next dimension.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The index of the stratum.
+//
+func (stratified *Stratified) findStratum() int {
+	controller := Controller{}
+	permutationSeed := controller.deriveUint32(stratified.seed, stratified.lineIndex, stratified.columnIndex,
+		stratified.dimension)
+	stratified.dimension++
+	return int(controller.permute(uint32(stratified.sampleIndex%stratified.samplesPerPixel),
+		uint32(stratified.samplesPerPixel), permutationSeed))
+}
+
+// Get1D gets the next dimension of the sample.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	A number in [0,1).
+//
+func (stratified *Stratified) Get1D() float64 {
+	stratum := stratified.findStratum()
+	return (float64(stratum) + stratified.jitter.Get1D()) / float64(stratified.samplesPerPixel)
+}
+
+// Get2D gets the next two dimensions of the sample with the correlated multi-jittered sampling proposed by Andrew
+// Kensler. Every sample falls in its own cell of the grid and in its own stratum of each dimension.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	A number in [0,1).
+// 	A number in [0,1).
+//
+func (stratified *Stratified) Get2D() (float64, float64) {
+	controller := Controller{}
+	permutationSeed := controller.deriveUint32(stratified.seed, stratified.lineIndex, stratified.columnIndex,
+		stratified.dimension)
+	stratified.dimension += 2
+
+	gridColumns := uint32(stratified.gridColumns)
+	gridLines := uint32(stratified.gridLines)
+	cellIndex := controller.permute(uint32(stratified.sampleIndex%stratified.samplesPerPixel),
+		uint32(stratified.samplesPerPixel), permutationSeed*0x51633e2d)
+	column := cellIndex % gridColumns
+	line := cellIndex / gridColumns
+	shuffledColumn := controller.permute(column, gridColumns, permutationSeed*0x68bc21eb)
+	shuffledLine := controller.permute(line, gridLines, permutationSeed*0x02e5be93)
+
+	firstNumber := (float64(column) + (float64(shuffledLine)+stratified.jitter.Get1D())/float64(gridLines)) /
+		float64(gridColumns)
+	secondNumber := (float64(line) + (float64(shuffledColumn)+stratified.jitter.Get1D())/float64(gridColumns)) /
+		float64(gridLines)
+	return firstNumber, secondNumber
+}
+
+// findGridColumns finds the number of columns of the grid of the pairs of dimensions, the largest divisor of the
+// number of samples that is not above its square root, so the grid has no empty cell and is square for squares.
+//
+// Parameters:
+// 	samplesPerPixel - The number of samples of every pixel.
+//
+// Returns:
+// 	The number of columns.
+//
+func findGridColumns(samplesPerPixel int) int {
+	gridColumns := int(math.Sqrt(float64(samplesPerPixel)))
+	for samplesPerPixel%gridColumns != 0 {
+		gridColumns--
+	}
+	return gridColumns
+}
+
+// InitStratified initializes a Stratified Sampler.
+//
+// Parameters:
+// 	samplesPerPixel - The number of samples of every pixel, at least 1.
+// 	seed            - The seed of the Sampler.
+//
+// Returns:
+// 	The Stratified Sampler.
+//
+func InitStratified(samplesPerPixel int, seed int64) *Stratified {
+	gridColumns := findGridColumns(samplesPerPixel)
+	return &Stratified{samplesPerPixel: samplesPerPixel, gridColumns: gridColumns,
+		gridLines: samplesPerPixel / gridColumns, seed: seed, jitter: InitIndependent(seed)}
+}
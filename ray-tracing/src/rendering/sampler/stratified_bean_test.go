@@ -0,0 +1,67 @@
+package sampler
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestStratified_Get1D tests that the samples of a pixel fall in different strata.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestStratified_Get1D(t *testing.T) {
+	samplesPerPixel := 7
+	stratified := InitStratified(samplesPerPixel, 5)
+	for dimension := 0; dimension < 4; dimension++ {
+		found := make([]bool, samplesPerPixel)
+		for sampleIndex := 0; sampleIndex < samplesPerPixel; sampleIndex++ {
+			stratified.StartPixelSample(1, 2, sampleIndex)
+			for skippedDimension := 0; skippedDimension < dimension; skippedDimension++ {
+				stratified.Get1D()
+			}
+			stratum := int(stratified.Get1D() * float64(samplesPerPixel))
+			test_helpers.AssertEqual(t, false, found[stratum])
+			found[stratum] = true
+		}
+	}
+}
+
+// TestStratified_Get2D tests that the samples of every pixel fall in different strata of both dimensions and in
+// different cells of the grid, which is square when the number of samples is a square.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestStratified_Get2D(t *testing.T) {
+	for samplesPerPixel, expectedGridColumns := range map[int]int{1: 1, 4: 2, 7: 1, 12: 3, 16: 4, 64: 8} {
+		stratified := InitStratified(samplesPerPixel, 5)
+		expectedGridLines := samplesPerPixel / expectedGridColumns
+		for pixelIndex := 0; pixelIndex < 50; pixelIndex++ {
+			firstFound := make([]bool, samplesPerPixel)
+			secondFound := make([]bool, samplesPerPixel)
+			cellsFound := make([]bool, samplesPerPixel)
+			for sampleIndex := 0; sampleIndex < samplesPerPixel; sampleIndex++ {
+				stratified.StartPixelSample(pixelIndex, 3, sampleIndex)
+				stratified.Get1D()
+				firstNumber, secondNumber := stratified.Get2D()
+				firstStratum := int(firstNumber * float64(samplesPerPixel))
+				secondStratum := int(secondNumber * float64(samplesPerPixel))
+				cell := int(secondNumber*float64(expectedGridLines))*expectedGridColumns +
+					int(firstNumber*float64(expectedGridColumns))
+				test_helpers.AssertEqual(t, false, firstFound[firstStratum])
+				test_helpers.AssertEqual(t, false, secondFound[secondStratum])
+				test_helpers.AssertEqual(t, false, cellsFound[cell])
+				firstFound[firstStratum] = true
+				secondFound[secondStratum] = true
+				cellsFound[cell] = true
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package scene_validation
+
+// Issue is a class for a part of a scene that can not be rendered.
+//
+// Members:
+// 	path    - The path of the part of the scene, as objects[0].triangles[2].
+// 	message - What is wrong with the part of the scene.
+//
+type Issue struct {
+	path    string
+	message string
+}
+
+// GetPath gets the path of the part of the scene.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The path of the part of the scene.
+//
+func (issue *Issue) GetPath() string {
+	return issue.path
+}
+
+// GetMessage gets what is wrong with the part of the scene.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The description of the Issue.
+//
+func (issue *Issue) GetMessage() string {
+	return issue.message
+}
+
+// Error gets the Issue as "path: message".
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The description of the Issue with its path.
+//
+func (issue *Issue) Error() string {
+	if issue.path == "" {
+		return issue.message
+	}
+	return issue.path + ": " + issue.message
+}
+
+// Init initializes an Issue.
+//
+// Parameters:
+// 	path    - The path of the part of the scene.
+// 	message - What is wrong with the part of the scene.
+//
+// Returns:
+// 	An Issue.
+//
+func Init(path, message string) *Issue {
+	return &Issue{path: path, message: message}
+}
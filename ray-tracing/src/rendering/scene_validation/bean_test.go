@@ -0,0 +1,34 @@
+package scene_validation
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestIssue_Init tests the instantiation of an Issue.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestIssue_Init(t *testing.T) {
+	issue := Init("objects[0].normals[1]", "zero-length normal")
+	test_helpers.AssertEqual(t, "objects[0].normals[1]", issue.GetPath())
+	test_helpers.AssertEqual(t, "zero-length normal", issue.GetMessage())
+	test_helpers.AssertEqual(t, "objects[0].normals[1]: zero-length normal", issue.Error())
+}
+
+// TestIssue_Error_EmptyPath tests the description of an Issue of the whole scene.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestIssue_Error_EmptyPath(t *testing.T) {
+	issue := Init("", "empty scene")
+	test_helpers.AssertEqual(t, "empty scene", issue.Error())
+}
@@ -0,0 +1,290 @@
+package scene_validation
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/camera"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"math"
+)
+
+// Controller is a class for checking that scenes can be rendered before their path tracing starts.
+//
+// Members:
+// 	none
+//
+type Controller struct{}
+
+// isFinite checks if a value is neither NaN nor infinite.
+//
+// Parameters:
+// 	value - The value.
+//
+// Returns:
+// 	If the value is finite.
+//
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
+
+// areFinite checks if all coordinates are neither NaN nor infinite.
+//
+// Parameters:
+// 	coordinates - The coordinates.
+//
+// Returns:
+// 	If all coordinates are finite.
+//
+func areFinite(coordinates []float64) bool {
+	for _, coordinate := range coordinates {
+		if !isFinite(coordinate) {
+			return false
+		}
+	}
+	return true
+}
+
+// pointCoordinates copies all coordinates of a point.
+//
+// Parameters:
+// 	currentPoint - The point.
+//
+// Returns:
+// 	The coordinates of the point.
+//
+func pointCoordinates(currentPoint *point.Point) []float64 {
+	coordinates := make([]float64, currentPoint.Dimension())
+	for coordinateIndex := range coordinates {
+		coordinates[coordinateIndex], _ = currentPoint.GetCoordinate(coordinateIndex)
+	}
+	return coordinates
+}
+
+// prefixIssues places the paths of issues under the path of the part of the scene that has them.
+//
+// Parameters:
+// 	prefix - The path of the part of the scene.
+// 	issues - The issues with paths relative to the part of the scene.
+//
+// Returns:
+// 	The issues with paths from the scene.
+//
+func prefixIssues(prefix string, issues []*Issue) []*Issue {
+	prefixedIssues := make([]*Issue, len(issues))
+	for issueIndex, issue := range issues {
+		prefixedIssues[issueIndex] = Init(prefix+"."+issue.GetPath(), issue.GetMessage())
+	}
+	return prefixedIssues
+}
+
+// validatePoints checks that the points of an Object are finite.
+//
+// Parameters:
+// 	sceneObject - The Object.
+//
+// Returns:
+// 	The issues of the points.
+//
+func (*Controller) validatePoints(sceneObject *object.Object) []*Issue {
+	var issues []*Issue
+	repository := sceneObject.GetRepository()
+	for pointIndex := 0; pointIndex < repository.NumberOfPoints(); pointIndex++ {
+		currentPoint, _ := repository.GetPoint(pointIndex)
+		coordinates := pointCoordinates(currentPoint)
+		if !areFinite(coordinates) {
+			issues = append(issues, Init(fmt.Sprintf("repository.points[%d]", pointIndex),
+				fmt.Sprintf("coordinates must be finite, got %v", coordinates)))
+		}
+	}
+	return issues
+}
+
+// validateNormals checks that the normals of an Object are finite and have a direction.
+//
+// Parameters:
+// 	sceneObject - The Object.
+//
+// Returns:
+// 	The issues of the normals.
+//
+func (*Controller) validateNormals(sceneObject *object.Object) []*Issue {
+	var issues []*Issue
+	vectorController := vector.Controller{}
+	for normalIndex, normal := range sceneObject.GetNormals() {
+		normalPath := fmt.Sprintf("normals[%d]", normalIndex)
+		coordinates := normal.CopyAllCoordinates()
+		if !areFinite(coordinates) {
+			issues = append(issues, Init(normalPath, fmt.Sprintf("coordinates must be finite, got %v", coordinates)))
+		} else if vectorController.Norm(normal) == 0 {
+			issues = append(issues, Init(normalPath, "zero-length normal"))
+		}
+	}
+	return issues
+}
+
+// validateTriangleIndexes checks that the indexes of a triangle are points and normals of its Object.
+//
+// Parameters:
+// 	sceneObject   - The Object.
+// 	triangleIndex - The index of the triangle.
+//
+// Returns:
+// 	The issues of the indexes.
+//
+func (*Controller) validateTriangleIndexes(sceneObject *object.Object, triangleIndex int) []*Issue {
+	var issues []*Issue
+	currentTriangle := sceneObject.GetTriangles()[triangleIndex]
+	for vertexIndex := 0; vertexIndex < 3; vertexIndex++ {
+		pointIndex, _ := currentTriangle.GetVertexIndex(vertexIndex)
+		if pointIndex < 0 || pointIndex >= sceneObject.GetRepository().NumberOfPoints() {
+			issues = append(issues, Init(
+				fmt.Sprintf("triangles[%d].verticesIndices[%d]", triangleIndex, vertexIndex),
+				fmt.Sprintf("index %d out of range", pointIndex)))
+		}
+		normalIndex, _ := currentTriangle.GetVertexNormalIndex(vertexIndex)
+		if normalIndex < 0 || normalIndex >= len(sceneObject.GetNormals()) {
+			issues = append(issues, Init(
+				fmt.Sprintf("triangles[%d].verticesNormalsIndices[%d]", triangleIndex, vertexIndex),
+				fmt.Sprintf("index %d out of range", normalIndex)))
+		}
+	}
+	return issues
+}
+
+// validateTriangleArea checks that a triangle whose vertices are points of its Object is not degenerate.
+//
+// Parameters:
+// 	sceneObject     - The Object.
+// 	currentTriangle - The triangle.
+//
+// Returns:
+// 	What makes the triangle degenerate, or an empty string.
+//
+func (*Controller) validateTriangleArea(sceneObject *object.Object, currentTriangle *triangle.Triangle) string {
+	vertices := make([]*point.Point, 3)
+	for vertexIndex := range vertices {
+		pointIndex, _ := currentTriangle.GetVertexIndex(vertexIndex)
+		for previousIndex := 0; previousIndex < vertexIndex; previousIndex++ {
+			previousPointIndex, _ := currentTriangle.GetVertexIndex(previousIndex)
+			if previousPointIndex == pointIndex {
+				return fmt.Sprintf("degenerate triangle, point %d is more than one vertex", pointIndex)
+			}
+		}
+		vertices[vertexIndex], _ = sceneObject.GetRepository().GetPoint(pointIndex)
+	}
+
+	pointController := point.Controller{}
+	vectorController := vector.Controller{}
+	firstEdge, _ := pointController.ExtractVector(vertices[0], vertices[1])
+	secondEdge, _ := pointController.ExtractVector(vertices[0], vertices[2])
+	faceNormal, _ := vectorController.CrossProduct(firstEdge, secondEdge)
+	if vectorController.Norm(faceNormal) == 0 {
+		return "degenerate triangle, its vertices are collinear"
+	}
+	return ""
+}
+
+// validateTriangles checks that the triangles of an Object index its points and normals and have an area.
+//
+// Parameters:
+// 	sceneObject - The Object.
+//
+// Returns:
+// 	The issues of the triangles.
+//
+func (controller *Controller) validateTriangles(sceneObject *object.Object) []*Issue {
+	var issues []*Issue
+	for triangleIndex, currentTriangle := range sceneObject.GetTriangles() {
+		indexIssues := controller.validateTriangleIndexes(sceneObject, triangleIndex)
+		issues = append(issues, indexIssues...)
+		if len(indexIssues) > 0 {
+			continue
+		}
+		degeneracy := controller.validateTriangleArea(sceneObject, currentTriangle)
+		if degeneracy != "" {
+			issues = append(issues, Init(fmt.Sprintf("triangles[%d]", triangleIndex), degeneracy))
+		}
+	}
+	return issues
+}
+
+// validateSpheres checks that the spheres of an Object are centered on its points and have a finite radius.
+//
+// Parameters:
+// 	sceneObject - The Object.
+//
+// Returns:
+// 	The issues of the spheres.
+//
+func (*Controller) validateSpheres(sceneObject *object.Object) []*Issue {
+	var issues []*Issue
+	for sphereIndex, currentSphere := range sceneObject.GetSpheres() {
+		centerPointIndex := currentSphere.GetCenterPointIndex()
+		if centerPointIndex < 0 || centerPointIndex >= sceneObject.GetRepository().NumberOfPoints() {
+			issues = append(issues, Init(fmt.Sprintf("spheres[%d].centerPointIndex", sphereIndex),
+				fmt.Sprintf("index %d out of range", centerPointIndex)))
+		}
+		if !isFinite(currentSphere.GetRadius()) {
+			issues = append(issues, Init(fmt.Sprintf("spheres[%d].radius", sphereIndex),
+				fmt.Sprintf("radius must be finite, got %v", currentSphere.GetRadius())))
+		}
+	}
+	return issues
+}
+
+// ValidateObject checks that the triangles and spheres of an Object only index its points and normals, that its
+// triangles are not degenerate, that its normals are not zero-length and that none of its values is NaN or infinite.
+//
+// Parameters:
+// 	sceneObject - The Object.
+//
+// Returns:
+// 	The issues of the Object, with paths relative to it.
+//
+func (controller *Controller) ValidateObject(sceneObject *object.Object) []*Issue {
+	issues := controller.validatePoints(sceneObject)
+	issues = append(issues, controller.validateNormals(sceneObject)...)
+	issues = append(issues, controller.validateTriangles(sceneObject)...)
+	return append(issues, controller.validateSpheres(sceneObject)...)
+}
+
+// ValidateCamera checks that none of the values of a Camera is NaN or infinite and that its vectors have a direction.
+//
+// Parameters:
+// 	sceneCamera - The Camera.
+//
+// Returns:
+// 	The issues of the Camera, with paths relative to it.
+//
+func (*Controller) ValidateCamera(sceneCamera *camera.Camera) []*Issue {
+	position := pointCoordinates(sceneCamera.GetPosition())
+	var issues []*Issue
+	if !areFinite(position) {
+		issues = append(issues, Init("position", fmt.Sprintf("coordinates must be finite, got %v", position)))
+	}
+	vectorController := vector.Controller{}
+	vectorsByPath := []struct {
+		path   string
+		vector *vector.Vector
+	}{{"look", sceneCamera.GetLook()}, {"up", sceneCamera.GetUp()}, {"right", sceneCamera.GetRight()}}
+	for _, cameraVector := range vectorsByPath {
+		coordinates := cameraVector.vector.CopyAllCoordinates()
+		if !areFinite(coordinates) {
+			issues = append(issues, Init(cameraVector.path,
+				fmt.Sprintf("coordinates must be finite, got %v", coordinates)))
+		} else if vectorController.Norm(cameraVector.vector) == 0 {
+			issues = append(issues, Init(cameraVector.path, "zero-length vector"))
+		}
+	}
+	if !isFinite(sceneCamera.GetFieldOfView()) {
+		issues = append(issues, Init("fieldOfView",
+			fmt.Sprintf("value must be finite, got %v", sceneCamera.GetFieldOfView())))
+	}
+	if !isFinite(sceneCamera.GetDistanceToScreen()) {
+		issues = append(issues, Init("distanceToScreen",
+			fmt.Sprintf("value must be finite, got %v", sceneCamera.GetDistanceToScreen())))
+	}
+	return issues
+}
@@ -0,0 +1,228 @@
+package scene_validation
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/point"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/sphere"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/triangle"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/geometry/vector"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/camera"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/object"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/point_repository"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"math"
+	"testing"
+)
+
+// buildPoint builds a point with its coordinates.
+//
+// Parameters:
+//  t           - Test instance.
+//  coordinates - The coordinates of the point.
+//
+// Returns:
+//  A point.
+//
+func buildPoint(t *testing.T, coordinates []float64) *point.Point {
+	builtPoint, err := point.Init(len(coordinates))
+	test_helpers.AssertNilError(t, err)
+	for coordinateIndex, coordinate := range coordinates {
+		test_helpers.AssertNilError(t, builtPoint.SetCoordinate(coordinateIndex, coordinate))
+	}
+	return builtPoint
+}
+
+// buildVector builds a vector with its coordinates.
+//
+// Parameters:
+//  t           - Test instance.
+//  coordinates - The coordinates of the vector.
+//
+// Returns:
+//  A vector.
+//
+func buildVector(t *testing.T, coordinates []float64) *vector.Vector {
+	builtVector, err := vector.Init(len(coordinates))
+	test_helpers.AssertNilError(t, err)
+	for coordinateIndex, coordinate := range coordinates {
+		test_helpers.AssertNilError(t, builtVector.SetCoordinate(coordinateIndex, coordinate))
+	}
+	return builtVector
+}
+
+// buildObject builds a white diffuse object.
+//
+// Parameters:
+//  t         - Test instance.
+//  points    - The coordinates of the points of the object.
+//  normals   - The coordinates of the normals of the object.
+//  triangles - The triangles of the object.
+//  spheres   - The spheres of the object.
+//
+// Returns:
+//  An object.
+//
+func buildObject(t *testing.T, points, normals [][]float64, triangles []*triangle.Triangle,
+	spheres []*sphere.Sphere) *object.Object {
+	repositoryPoints := make([]*point.Point, len(points))
+	for pointIndex, coordinates := range points {
+		repositoryPoints[pointIndex] = buildPoint(t, coordinates)
+	}
+	repository, err := point_repository.Init(repositoryPoints, 3)
+	test_helpers.AssertNilError(t, err)
+	objectNormals := make([]*vector.Vector, len(normals))
+	for normalIndex, coordinates := range normals {
+		objectNormals[normalIndex] = buildVector(t, coordinates)
+	}
+	builtObject, err := object.Init("test", repository, triangles, spheres, objectNormals, []float64{1, 1, 1}, 0,
+		0, 0, 1, 1, nil)
+	test_helpers.AssertNilError(t, err)
+	return builtObject
+}
+
+// buildTriangle builds a triangle with its indexes.
+//
+// Parameters:
+//  t                      - Test instance.
+//  verticesIndexes        - The indexes of the points of the vertices.
+//  verticesNormalsIndexes - The indexes of the normals of the vertices.
+//
+// Returns:
+//  A triangle.
+//
+func buildTriangle(t *testing.T, verticesIndexes, verticesNormalsIndexes []int) *triangle.Triangle {
+	builtTriangle, err := triangle.Init(verticesIndexes, verticesNormalsIndexes)
+	test_helpers.AssertNilError(t, err)
+	return builtTriangle
+}
+
+// buildCamera builds a camera at the origin looking along the x axis.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  A camera.
+//
+func buildCamera(t *testing.T) *camera.Camera {
+	sceneCamera, err := camera.Init(buildPoint(t, []float64{0, 0, 0}), buildVector(t, []float64{1, 0, 0}),
+		buildVector(t, []float64{0, 0, 1}), buildVector(t, []float64{0, -1, 0}), 60, 1)
+	test_helpers.AssertNilError(t, err)
+	return sceneCamera
+}
+
+// assertIssues asserts that the issues have the expected descriptions, in order.
+//
+// Parameters:
+//  t              - Test instance.
+//  issues         - The issues.
+//  expectedIssues - The expected descriptions of the issues.
+//
+// Returns:
+//  none
+//
+func assertIssues(t *testing.T, issues []*Issue, expectedIssues ...string) {
+	test_helpers.AssertEqual(t, len(expectedIssues), len(issues))
+	for issueIndex, issue := range issues {
+		test_helpers.AssertEqual(t, expectedIssues[issueIndex], issue.Error())
+	}
+}
+
+// TestController_ValidateObject tests checking a valid object.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ValidateObject(t *testing.T) {
+	controller := Controller{}
+	triangles := []*triangle.Triangle{buildTriangle(t, []int{0, 1, 2}, []int{0, 0, 0})}
+	spheres := []*sphere.Sphere{sphere.Init(0, 1)}
+	sceneObject := buildObject(t, [][]float64{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}}, [][]float64{{0, 0, 1}}, triangles,
+		spheres)
+	assertIssues(t, controller.ValidateObject(sceneObject))
+}
+
+// TestController_ValidateObject_IndexesOutOfRange tests checking an object whose triangles and spheres index points
+// and normals that it does not have.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ValidateObject_IndexesOutOfRange(t *testing.T) {
+	controller := Controller{}
+	triangles := []*triangle.Triangle{buildTriangle(t, []int{0, 3, 2}, []int{0, 0, -1})}
+	spheres := []*sphere.Sphere{sphere.Init(5, 1)}
+	sceneObject := buildObject(t, [][]float64{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}}, [][]float64{{0, 0, 1}}, triangles,
+		spheres)
+	assertIssues(t, controller.ValidateObject(sceneObject),
+		"triangles[0].verticesIndices[1]: index 3 out of range",
+		"triangles[0].verticesNormalsIndices[2]: index -1 out of range",
+		"spheres[0].centerPointIndex: index 5 out of range")
+}
+
+// TestController_ValidateObject_Degenerate tests checking an object with degenerate triangles and a zero-length
+// normal.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ValidateObject_Degenerate(t *testing.T) {
+	controller := Controller{}
+	triangles := []*triangle.Triangle{buildTriangle(t, []int{0, 1, 0}, []int{0, 0, 0}),
+		buildTriangle(t, []int{0, 1, 2}, []int{0, 0, 0})}
+	sceneObject := buildObject(t, [][]float64{{0, 0, 0}, {1, 0, 0}, {2, 0, 0}}, [][]float64{{0, 0, 0}}, triangles,
+		nil)
+	assertIssues(t, controller.ValidateObject(sceneObject),
+		"normals[0]: zero-length normal",
+		"triangles[0]: degenerate triangle, point 0 is more than one vertex",
+		"triangles[1]: degenerate triangle, its vertices are collinear")
+}
+
+// TestController_ValidateObject_NotFinite tests checking an object with NaN and infinite values.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ValidateObject_NotFinite(t *testing.T) {
+	controller := Controller{}
+	triangles := []*triangle.Triangle{buildTriangle(t, []int{0, 1, 2}, []int{0, 0, 0})}
+	spheres := []*sphere.Sphere{sphere.Init(0, math.Inf(1))}
+	sceneObject := buildObject(t, [][]float64{{0, 0, 0}, {1, math.NaN(), 0}, {0, 1, 0}},
+		[][]float64{{0, math.Inf(-1), 1}}, triangles, spheres)
+	assertIssues(t, controller.ValidateObject(sceneObject),
+		"repository.points[1]: coordinates must be finite, got [1 NaN 0]",
+		"normals[0]: coordinates must be finite, got [0 -Inf 1]",
+		"spheres[0].radius: radius must be finite, got +Inf")
+}
+
+// TestController_ValidateCamera tests checking a camera with values that are not finite and a vector without a
+// direction.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_ValidateCamera(t *testing.T) {
+	controller := Controller{}
+	assertIssues(t, controller.ValidateCamera(buildCamera(t)))
+	sceneCamera, err := camera.Init(buildPoint(t, []float64{math.NaN(), 0, 0}), buildVector(t, []float64{0, 0, 0}),
+		buildVector(t, []float64{0, 0, 1}), buildVector(t, []float64{0, -1, 0}), math.Inf(1), 1)
+	test_helpers.AssertNilError(t, err)
+	assertIssues(t, controller.ValidateCamera(sceneCamera),
+		"position: coordinates must be finite, got [NaN 0 0]",
+		"look: zero-length vector",
+		"fieldOfView: value must be finite, got +Inf")
+}
@@ -0,0 +1,108 @@
+package tile
+
+// Tile is a class for a rectangle of pixels of a screen rendered as a unit of work.
+//
+// Members:
+// 	startLine   - The index of the first line of the Tile.
+// 	startColumn - The index of the first column of the Tile.
+// 	endLine     - The index after the last line of the Tile.
+// 	endColumn   - The index after the last column of the Tile.
+//
+type Tile struct {
+	startLine   int
+	startColumn int
+	endLine     int
+	endColumn   int
+}
+
+// GetStartLine gets the index of the first line of the Tile.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The index of the first line.
+//
+func (tile *Tile) GetStartLine() int {
+	return tile.startLine
+}
+
+// GetStartColumn gets the index of the first column of the Tile.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The index of the first column.
+//
+func (tile *Tile) GetStartColumn() int {
+	return tile.startColumn
+}
+
+// GetEndLine gets the index after the last line of the Tile.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The index after the last line.
+//
+func (tile *Tile) GetEndLine() int {
+	return tile.endLine
+}
+
+// GetEndColumn gets the index after the last column of the Tile.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The index after the last column.
+//
+func (tile *Tile) GetEndColumn() int {
+	return tile.endColumn
+}
+
+// NumberOfPixels gets the number of pixels of the Tile.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The number of pixels.
+//
+func (tile *Tile) NumberOfPixels() int {
+	return (tile.endLine - tile.startLine) * (tile.endColumn - tile.startColumn)
+}
+
+// IsEqual checks if two tiles are equal.
+//
+// Parameters:
+// 	other - The other Tile.
+//
+// Returns:
+// 	If the tiles are equal.
+//
+func (tile *Tile) IsEqual(other *Tile) bool {
+	return tile.startLine == other.startLine && tile.startColumn == other.startColumn &&
+		tile.endLine == other.endLine && tile.endColumn == other.endColumn
+}
+
+// Init initializes a Tile.
+//
+// Parameters:
+// 	startLine   - The index of the first line of the Tile.
+// 	startColumn - The index of the first column of the Tile.
+// 	endLine     - The index after the last line of the Tile.
+// 	endColumn   - The index after the last column of the Tile.
+//
+// Returns:
+// 	A Tile.
+// 	An error.
+//
+func Init(startLine, startColumn, endLine, endColumn int) (*Tile, error) {
+	if startLine < 0 || startColumn < 0 || endLine <= startLine || endColumn <= startColumn {
+		return nil, boundsError(startLine, startColumn, endLine, endColumn)
+	}
+	return &Tile{startLine: startLine, startColumn: startColumn, endLine: endLine, endColumn: endColumn}, nil
+}
@@ -0,0 +1,43 @@
+package tile
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestTile_Init tests the instantiation of a Tile.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestTile_Init(t *testing.T) {
+	currentTile, err := Init(1, 2, 4, 8)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 1, currentTile.GetStartLine())
+	test_helpers.AssertEqual(t, 2, currentTile.GetStartColumn())
+	test_helpers.AssertEqual(t, 4, currentTile.GetEndLine())
+	test_helpers.AssertEqual(t, 8, currentTile.GetEndColumn())
+	test_helpers.AssertEqual(t, 18, currentTile.NumberOfPixels())
+	test_helpers.AssertEqual(t, true, currentTile.IsEqual(&Tile{startLine: 1, startColumn: 2, endLine: 4,
+		endColumn: 8}))
+}
+
+// TestTile_Init_BoundsError tests the instantiation of a Tile with invalid bounds.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestTile_Init_BoundsError(t *testing.T) {
+	_, err := Init(-1, 0, 2, 2)
+	test_helpers.AssertNotNilError(t, err)
+	_, err = Init(0, 0, 0, 2)
+	test_helpers.AssertNotNilError(t, err)
+	_, err = Init(0, 3, 2, 2)
+	test_helpers.AssertNotNilError(t, err)
+}
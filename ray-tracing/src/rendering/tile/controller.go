@@ -0,0 +1,47 @@
+package tile
+
+// Controller is a class for controlling tiles.
+//
+// Members:
+// 	none
+//
+type Controller struct {}
+
+// SplitWindow splits a window of a screen in square tiles, line by line. The tiles at the right and bottom borders may
+// be smaller.
+//
+// Parameters:
+// 	startLine   - The index of the first line of the window.
+// 	startColumn - The index of the first column of the window.
+// 	endLine     - The index after the last line of the window.
+// 	endColumn   - The index after the last column of the window.
+// 	tileSize    - The number of lines and columns of the tiles.
+//
+// Returns:
+// 	The tiles, none for an empty window.
+// 	An error.
+//
+func (*Controller) SplitWindow(startLine, startColumn, endLine, endColumn, tileSize int) ([]*Tile, error) {
+	if tileSize < 1 {
+		return nil, sizeError(tileSize)
+	}
+	tiles := make([]*Tile, 0)
+	for tileStartLine := startLine; tileStartLine < endLine; tileStartLine += tileSize {
+		tileEndLine := tileStartLine + tileSize
+		if tileEndLine > endLine {
+			tileEndLine = endLine
+		}
+		for tileStartColumn := startColumn; tileStartColumn < endColumn; tileStartColumn += tileSize {
+			tileEndColumn := tileStartColumn + tileSize
+			if tileEndColumn > endColumn {
+				tileEndColumn = endColumn
+			}
+			currentTile, err := Init(tileStartLine, tileStartColumn, tileEndLine, tileEndColumn)
+			if err != nil {
+				return nil, err
+			}
+			tiles = append(tiles, currentTile)
+		}
+	}
+	return tiles, nil
+}
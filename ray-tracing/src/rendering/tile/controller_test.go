@@ -0,0 +1,64 @@
+package tile
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestController_SplitWindow tests splitting a window in tiles, with smaller tiles at the borders.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_SplitWindow(t *testing.T) {
+	controller := Controller{}
+	tiles, err := controller.SplitWindow(1, 2, 6, 5, 2)
+	test_helpers.AssertNilError(t, err)
+	expectedTiles := []*Tile{
+		{startLine: 1, startColumn: 2, endLine: 3, endColumn: 4},
+		{startLine: 1, startColumn: 4, endLine: 3, endColumn: 5},
+		{startLine: 3, startColumn: 2, endLine: 5, endColumn: 4},
+		{startLine: 3, startColumn: 4, endLine: 5, endColumn: 5},
+		{startLine: 5, startColumn: 2, endLine: 6, endColumn: 4},
+		{startLine: 5, startColumn: 4, endLine: 6, endColumn: 5},
+	}
+	test_helpers.AssertEqual(t, len(expectedTiles), len(tiles))
+	numberOfPixels := 0
+	for tileIndex, currentTile := range tiles {
+		test_helpers.AssertEqual(t, true, expectedTiles[tileIndex].IsEqual(currentTile))
+		numberOfPixels += currentTile.NumberOfPixels()
+	}
+	test_helpers.AssertEqual(t, 15, numberOfPixels)
+}
+
+// TestController_SplitWindow_Empty tests splitting an empty window.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_SplitWindow_Empty(t *testing.T) {
+	controller := Controller{}
+	tiles, err := controller.SplitWindow(3, 3, 3, 10, 4)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 0, len(tiles))
+}
+
+// TestController_SplitWindow_SizeError tests splitting a window in tiles without pixels.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_SplitWindow_SizeError(t *testing.T) {
+	controller := Controller{}
+	_, err := controller.SplitWindow(0, 0, 4, 4, 0)
+	test_helpers.AssertNotNilError(t, err)
+}
@@ -0,0 +1,36 @@
+package tile
+
+import (
+	"errors"
+	"fmt"
+)
+
+// boundsError is the error where a Tile has no pixels or starts before the screen.
+//
+// Parameters:
+//	startLine   - The index of the first line of the Tile.
+//	startColumn - The index of the first column of the Tile.
+//	endLine     - The index after the last line of the Tile.
+//	endColumn   - The index after the last column of the Tile.
+//
+// Returns:
+//  An Error.
+//
+func boundsError(startLine, startColumn, endLine, endColumn int) error {
+	errorMessage := fmt.Sprintf("Invalid tile bounds: from %d %d to %d %d.", startLine, startColumn, endLine,
+		endColumn)
+	return errors.New(errorMessage)
+}
+
+// sizeError is the error where the size of the tiles is not positive.
+//
+// Parameters:
+//	tileSize - The size of the tiles.
+//
+// Returns:
+//  An Error.
+//
+func sizeError(tileSize int) error {
+	errorMessage := fmt.Sprintf("Invalid tile size: %d.", tileSize)
+	return errors.New(errorMessage)
+}
@@ -0,0 +1,37 @@
+package tile
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestTile_BoundsError tests the error where a Tile has no pixels or starts before the screen.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestTile_BoundsError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid tile bounds: from %d %d to %d %d.", 2, 3, 1, 5)
+	err := boundsError(2, 3, 1, 5)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestTile_SizeError tests the error where the size of the tiles is not positive.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestTile_SizeError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid tile size: %d.", 0)
+	err := sizeError(0)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
@@ -0,0 +1,114 @@
+package tone_mapping
+
+// The operators that compress the radiance to the displayable range.
+const (
+	// LinearOperator keeps the radiance, clamping it to [0,1].
+	LinearOperator = "linear"
+	// ReinhardOperator maps every channel c to c/(1+c).
+	ReinhardOperator = "reinhard"
+	// AcesOperator is the fit of the ACES filmic curve by Krzysztof Narkowicz.
+	AcesOperator = "aces"
+)
+
+// The transfer functions that encode the tone mapped values.
+const (
+	// LinearTransferFunction keeps the tone mapped values.
+	LinearTransferFunction = "linear"
+	// SrgbTransferFunction applies the sRGB gamma curve.
+	SrgbTransferFunction = "srgb"
+)
+
+// ToneMapping is a class for the post process that converts radiance to displayable colors.
+//
+// Members:
+// 	exposure         - The exposure in stops, the radiance is multiplied by 2 to the exposure.
+// 	operator         - The tone mapping operator, LinearOperator, ReinhardOperator or AcesOperator.
+// 	transferFunction - The transfer function, LinearTransferFunction or SrgbTransferFunction.
+//
+type ToneMapping struct {
+	exposure         float64
+	operator         string
+	transferFunction string
+}
+
+// GetExposure gets the exposure in stops.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The exposure.
+//
+func (toneMapping *ToneMapping) GetExposure() float64 {
+	return toneMapping.exposure
+}
+
+// GetOperator gets the tone mapping operator.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The operator.
+//
+func (toneMapping *ToneMapping) GetOperator() string {
+	return toneMapping.operator
+}
+
+// GetTransferFunction gets the transfer function.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	The transfer function.
+//
+func (toneMapping *ToneMapping) GetTransferFunction() string {
+	return toneMapping.transferFunction
+}
+
+// IsEqual checks if two ToneMapping are equal.
+//
+// Parameters:
+// 	other - The other ToneMapping.
+//
+// Returns:
+// 	If the two ToneMapping are equal.
+//
+func (toneMapping *ToneMapping) IsEqual(other *ToneMapping) bool {
+	return toneMapping.exposure == other.exposure && toneMapping.operator == other.operator &&
+		toneMapping.transferFunction == other.transferFunction
+}
+
+// Init initializes a ToneMapping.
+//
+// Parameters:
+// 	exposure         - The exposure in stops.
+// 	operator         - The tone mapping operator.
+// 	transferFunction - The transfer function.
+//
+// Returns:
+// 	A ToneMapping.
+// 	An error.
+//
+func Init(exposure float64, operator, transferFunction string) (*ToneMapping, error) {
+	if operator != LinearOperator && operator != ReinhardOperator && operator != AcesOperator {
+		return nil, operatorError(operator)
+	}
+	if transferFunction != LinearTransferFunction && transferFunction != SrgbTransferFunction {
+		return nil, transferFunctionError(transferFunction)
+	}
+	return &ToneMapping{exposure: exposure, operator: operator, transferFunction: transferFunction}, nil
+}
+
+// InitDefault initializes the ToneMapping that only clamps the radiance, as the renders have always been done.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	A ToneMapping.
+//
+func InitDefault() *ToneMapping {
+	return &ToneMapping{exposure: 0, operator: LinearOperator, transferFunction: LinearTransferFunction}
+}
@@ -0,0 +1,71 @@
+package tone_mapping
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestToneMapping_Init tests the instantiation of a ToneMapping.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestToneMapping_Init(t *testing.T) {
+	toneMapping, err := Init(1.5, AcesOperator, SrgbTransferFunction)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, 1.5, toneMapping.GetExposure())
+	test_helpers.AssertEqual(t, AcesOperator, toneMapping.GetOperator())
+	test_helpers.AssertEqual(t, SrgbTransferFunction, toneMapping.GetTransferFunction())
+	test_helpers.AssertEqual(t, false, toneMapping.IsEqual(InitDefault()))
+}
+
+// TestToneMapping_InitDefault tests the instantiation of the default ToneMapping.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestToneMapping_InitDefault(t *testing.T) {
+	toneMapping, err := Init(0, LinearOperator, LinearTransferFunction)
+	test_helpers.AssertNilError(t, err)
+	test_helpers.AssertEqual(t, true, toneMapping.IsEqual(InitDefault()))
+}
+
+// TestToneMapping_Init_OperatorError tests the instantiation of a ToneMapping with an unknown operator.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestToneMapping_Init_OperatorError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid tone mapping operator %s. Expected %s, %s or %s.", "filmic",
+		LinearOperator, ReinhardOperator, AcesOperator)
+	_, err := Init(0, "filmic", LinearTransferFunction)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestToneMapping_Init_TransferFunctionError tests the instantiation of a ToneMapping with an unknown transfer
+// function.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestToneMapping_Init_TransferFunctionError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid transfer function %s. Expected %s or %s.", "gamma",
+		LinearTransferFunction, SrgbTransferFunction)
+	_, err := Init(0, LinearOperator, "gamma")
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
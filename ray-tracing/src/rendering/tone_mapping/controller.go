@@ -0,0 +1,78 @@
+package tone_mapping
+
+import (
+	"math"
+)
+
+// Controller is a class for controlling tone mappings.
+//
+// Members:
+// 	none
+//
+type Controller struct {}
+
+// mapChannel applies a tone mapping operator to a channel of a radiance. NaN channels, left by broken samples, are
+// mapped to 0 and infinite channels are clamped like any other, so they never reach the conversion of the colors to
+// integers.
+//
+// Parameters:
+// 	operator - The tone mapping operator.
+// 	channel  - The exposed channel.
+//
+// Returns:
+// 	The tone mapped channel in [0,1].
+//
+func (*Controller) mapChannel(operator string, channel float64) float64 {
+	if math.IsNaN(channel) || math.IsInf(channel, -1) {
+		return 0
+	}
+	if math.IsInf(channel, 1) {
+		return 1
+	}
+	channel = math.Max(0, channel)
+	switch operator {
+	case ReinhardOperator:
+		channel = channel / (1 + channel)
+	case AcesOperator:
+		channel = channel * (2.51*channel + 0.03) / (channel*(2.43*channel+0.59) + 0.14)
+	}
+	return math.Min(1, math.Max(0, channel))
+}
+
+// encodeChannel applies a transfer function to a tone mapped channel.
+//
+// Parameters:
+// 	transferFunction - The transfer function.
+// 	channel          - The tone mapped channel in [0,1].
+//
+// Returns:
+// 	The encoded channel in [0,1].
+//
+func (*Controller) encodeChannel(transferFunction string, channel float64) float64 {
+	if transferFunction != SrgbTransferFunction {
+		return channel
+	}
+	if channel <= 0.0031308 {
+		return 12.92 * channel
+	}
+	return 1.055*math.Pow(channel, 1/2.4) - 0.055
+}
+
+// Apply converts a radiance to a displayable color.
+//
+// Parameters:
+// 	toneMapping - The ToneMapping.
+// 	radiance    - The RGB radiance.
+//
+// Returns:
+// 	The RGB color with channels in [0,1].
+//
+func (controller *Controller) Apply(toneMapping *ToneMapping, radiance []float64) []float64 {
+	exposureFactor := math.Pow(2, toneMapping.GetExposure())
+	color := make([]float64, len(radiance))
+	for colorIndex, channel := range radiance {
+		mappedChannel := controller.mapChannel(toneMapping.GetOperator(), channel*exposureFactor)
+		color[colorIndex] = controller.encodeChannel(toneMapping.GetTransferFunction(), mappedChannel)
+	}
+	return color
+}
@@ -0,0 +1,120 @@
+package tone_mapping
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"math"
+	"testing"
+)
+
+// assertColor asserts that a color matches the expected one.
+//
+// Parameters:
+//  t             - Test instance.
+//  expectedColor - The expected color.
+//  color         - The color.
+//
+// Returns:
+//  none
+//
+func assertColor(t *testing.T, expectedColor, color []float64) {
+	test_helpers.AssertEqual(t, len(expectedColor), len(color))
+	for colorIndex := range expectedColor {
+		test_helpers.AssertEqual(t, true, math.Abs(expectedColor[colorIndex]-color[colorIndex]) < 1e-6)
+	}
+}
+
+// TestController_Apply_Linear tests the tone mapping that only clamps the radiance.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Apply_Linear(t *testing.T) {
+	controller := Controller{}
+	assertColor(t, []float64{0, 0.5, 1}, controller.Apply(InitDefault(), []float64{-1, 0.5, 2}))
+}
+
+// TestController_Apply_Exposure tests the exposure of the tone mapping.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Apply_Exposure(t *testing.T) {
+	controller := Controller{}
+	toneMapping, err := Init(2, LinearOperator, LinearTransferFunction)
+	test_helpers.AssertNilError(t, err)
+	assertColor(t, []float64{0.4, 1, 0}, controller.Apply(toneMapping, []float64{0.1, 0.5, 0}))
+}
+
+// TestController_Apply_Reinhard tests the Reinhard operator.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Apply_Reinhard(t *testing.T) {
+	controller := Controller{}
+	toneMapping, err := Init(0, ReinhardOperator, LinearTransferFunction)
+	test_helpers.AssertNilError(t, err)
+	assertColor(t, []float64{0, 0.5, 0.9}, controller.Apply(toneMapping, []float64{0, 1, 9}))
+}
+
+// TestController_Apply_Aces tests the ACES filmic operator, which is monotonic and saturates to white.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Apply_Aces(t *testing.T) {
+	controller := Controller{}
+	toneMapping, err := Init(0, AcesOperator, LinearTransferFunction)
+	test_helpers.AssertNilError(t, err)
+	color := controller.Apply(toneMapping, []float64{0, 0.18, 1, 100})
+	assertColor(t, []float64{0, 0.18 * (2.51*0.18 + 0.03) / (0.18*(2.43*0.18+0.59) + 0.14), 2.54 / 3.16, 1},
+		color)
+	test_helpers.AssertEqual(t, true, color[1] < color[2] && color[2] < color[3])
+}
+
+// TestController_Apply_Srgb tests the sRGB transfer function.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Apply_Srgb(t *testing.T) {
+	controller := Controller{}
+	toneMapping, err := Init(0, LinearOperator, SrgbTransferFunction)
+	test_helpers.AssertNilError(t, err)
+	assertColor(t, []float64{0, 12.92 * 0.002, 1.055*math.Pow(0.5, 1/2.4) - 0.055, 1},
+		controller.Apply(toneMapping, []float64{0, 0.002, 0.5, 1}))
+}
+
+// TestController_Apply_NotFinite tests that NaN radiances are mapped to black and infinite radiances are clamped by
+// every operator.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_Apply_NotFinite(t *testing.T) {
+	controller := Controller{}
+	for _, operator := range []string{LinearOperator, ReinhardOperator, AcesOperator} {
+		toneMapping, err := Init(1, operator, SrgbTransferFunction)
+		test_helpers.AssertNilError(t, err)
+		assertColor(t, []float64{0, 1, 0}, controller.Apply(toneMapping, []float64{math.NaN(), math.Inf(1),
+			math.Inf(-1)}))
+	}
+}
@@ -0,0 +1,34 @@
+package tone_mapping
+
+import (
+	"errors"
+	"fmt"
+)
+
+// operatorError is the error where the tone mapping operator is unknown.
+//
+// Parameters:
+//	operator - The operator.
+//
+// Returns:
+//  An Error.
+//
+func operatorError(operator string) error {
+	errorMessage := fmt.Sprintf("Invalid tone mapping operator %s. Expected %s, %s or %s.", operator,
+		LinearOperator, ReinhardOperator, AcesOperator)
+	return errors.New(errorMessage)
+}
+
+// transferFunctionError is the error where the transfer function is unknown.
+//
+// Parameters:
+//	transferFunction - The transfer function.
+//
+// Returns:
+//  An Error.
+//
+func transferFunctionError(transferFunction string) error {
+	errorMessage := fmt.Sprintf("Invalid transfer function %s. Expected %s or %s.", transferFunction,
+		LinearTransferFunction, SrgbTransferFunction)
+	return errors.New(errorMessage)
+}
@@ -0,0 +1,39 @@
+package tone_mapping
+
+import (
+	"fmt"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestToneMapping_OperatorError tests the error where the tone mapping operator is unknown.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestToneMapping_OperatorError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid tone mapping operator %s. Expected %s, %s or %s.", "hable",
+		LinearOperator, ReinhardOperator, AcesOperator)
+	err := operatorError("hable")
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestToneMapping_TransferFunctionError tests the error where the transfer function is unknown.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestToneMapping_TransferFunctionError(t *testing.T) {
+	expectedErrorMessage := fmt.Sprintf("Invalid transfer function %s. Expected %s or %s.", "rec709",
+		LinearTransferFunction, SrgbTransferFunction)
+	err := transferFunctionError("rec709")
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
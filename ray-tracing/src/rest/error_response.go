@@ -0,0 +1,114 @@
+package rest
+
+import (
+	"errors"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/marshaller"
+	"net/http"
+)
+
+// The codes of the error responses, which clients can rely on instead of the messages.
+const (
+	// invalidRequestCode is the code of a request whose body can not be read.
+	invalidRequestCode = "invalid_request"
+	// invalidSceneCode is the code of a request whose JSON does not describe a valid scene.
+	invalidSceneCode = "invalid_scene"
+	// invalidParametersCode is the code of a valid scene whose parameters can not run its path tracing.
+	invalidParametersCode = "invalid_parameters"
+	// unsupportedFormatCode is the code of a request whose Accept header has no supported format.
+	unsupportedFormatCode = "unsupported_format"
+	// jobNotFoundCode is the code of a request for an unknown Job.
+	jobNotFoundCode = "job_not_found"
+	// jobRunningCode is the code of a request for the result of a Job that is still running.
+	jobRunningCode = "job_running"
+	// jobCanceledCode is the code of a request for the result of a canceled Job.
+	jobCanceledCode = "job_canceled"
+	// renderFailedCode is the code of a path tracing that failed while it ran.
+	renderFailedCode = "render_failed"
+	// workersFailedCode is the code of a distributed path tracing whose workers failed.
+	workersFailedCode = "workers_failed"
+	// internalErrorCode is the code of any other fault of the server.
+	internalErrorCode = "internal_error"
+)
+
+// writeError sends an error response as {"code": ..., "message": ...}.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	statusCode     - The status code of the response.
+// 	code           - The code of the error.
+// 	message        - The description of the error.
+//
+// Returns:
+// 	none
+//
+func writeError(responseWriter http.ResponseWriter, statusCode int, code, message string) {
+	writeJson(responseWriter, statusCode, map[string]interface{}{"code": code, "message": message})
+}
+
+// writeRequestError sends an invalid request as a bad request. The JSON paths of the invalid fields of a
+// marshaller.SchemaError are listed as {"code": ..., "message": ..., "errors": [{"path": ..., "message": ...}]}, and
+// those of a marshaller.ParametersError are sent the same way as an unprocessable entity.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	err            - The error of the request.
+//
+// Returns:
+// 	none
+//
+func writeRequestError(responseWriter http.ResponseWriter, err error) {
+	var schemaError *marshaller.SchemaError
+	var parametersError *marshaller.ParametersError
+	switch {
+	case errors.As(err, &schemaError):
+		writeJson(responseWriter, http.StatusBadRequest, map[string]interface{}{"code": invalidSceneCode,
+			"message": schemaError.Error(), "errors": fieldErrorsToMaps(schemaError.GetFieldErrors())})
+	case errors.As(err, &parametersError):
+		writeJson(responseWriter, http.StatusUnprocessableEntity, map[string]interface{}{"code": invalidParametersCode,
+			"message": parametersError.Error(), "errors": fieldErrorsToMaps(parametersError.GetFieldErrors())})
+	default:
+		writeError(responseWriter, http.StatusBadRequest, invalidRequestCode, err.Error())
+	}
+}
+
+// fieldErrorsToMaps lists invalid fields as {"path": ..., "message": ...}.
+//
+// Parameters:
+// 	fieldErrors - The invalid fields.
+//
+// Returns:
+// 	The invalid fields as maps.
+//
+func fieldErrorsToMaps(fieldErrors []*marshaller.FieldError) []map[string]string {
+	fieldErrorMaps := make([]map[string]string, len(fieldErrors))
+	for fieldErrorIndex, fieldError := range fieldErrors {
+		fieldErrorMaps[fieldErrorIndex] = map[string]string{"path": fieldError.GetPath(),
+			"message": fieldError.GetMessage()}
+	}
+	return fieldErrorMaps
+}
+
+// writeUnsupportedFormatError sends that none of the formats of the Accept header is supported.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+//
+// Returns:
+// 	none
+//
+func writeUnsupportedFormatError(responseWriter http.ResponseWriter) {
+	writeError(responseWriter, http.StatusNotAcceptable, unsupportedFormatCode, "unsupported response format")
+}
+
+// writeInternalError sends a fault of the server, without its details.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	message        - The description of what failed.
+//
+// Returns:
+// 	none
+//
+func writeInternalError(responseWriter http.ResponseWriter, message string) {
+	writeError(responseWriter, http.StatusInternalServerError, internalErrorCode, message)
+}
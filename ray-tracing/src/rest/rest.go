@@ -1,44 +1,177 @@
 package rest
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/distributed"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/marshaller"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/color_matrix"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/job"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/path_tracing"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/radiance_matrix"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/tone_mapping"
 	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
+// jsonFormat is the media type of the JSON color matrix response.
+const jsonFormat = "application/json"
+
+// jobManager is the Manager of the path tracings running in the background of the server.
+var jobManager = job.InitManager(job.DefaultRetention)
+
+// responseFormats are the media types of the path tracing responses, by order of preference for wildcards.
+var responseFormats = []string{jsonFormat, color_matrix.PngFormat, color_matrix.JpegFormat, color_matrix.PpmFormat,
+	radiance_matrix.PfmFormat, radiance_matrix.ExrFormat}
+
+// negotiateResponseFormat chooses the format of the response from the Accept header of the request.
+// The JSON color matrix is used when there is no header, and at the same quality exact media types win over
+// wildcards.
+//
+// Parameters:
+// 	acceptHeader - The Accept header.
+//
+// Returns:
+// 	The media type of the response.
+// 	If any of the accepted media types is supported.
+//
+func negotiateResponseFormat(acceptHeader string) (string, bool) {
+	if strings.TrimSpace(acceptHeader) == "" {
+		return jsonFormat, true
+	}
+
+	chosenFormat := ""
+	chosenQuality := 0.0
+	chosenSpecificity := 0
+	for _, mediaRange := range strings.Split(acceptHeader, ",") {
+		parameters := strings.Split(mediaRange, ";")
+		mediaType := strings.ToLower(strings.TrimSpace(parameters[0]))
+		quality := 1.0
+		for _, parameter := range parameters[1:] {
+			parameter = strings.TrimSpace(parameter)
+			if strings.HasPrefix(parameter, "q=") {
+				parsedQuality, err := strconv.ParseFloat(parameter[2:], 64)
+				if err != nil {
+					parsedQuality = 0
+				}
+				quality = parsedQuality
+			}
+		}
+
+		specificity := 2
+		if mediaType == "*/*" {
+			specificity = 0
+		} else if strings.HasSuffix(mediaType, "/*") {
+			specificity = 1
+		}
+		if quality <= 0 || quality < chosenQuality || (quality == chosenQuality && specificity <= chosenSpecificity) {
+			continue
+		}
+
+		for _, format := range responseFormats {
+			if mediaType == format || specificity == 0 ||
+				(specificity == 1 && strings.HasPrefix(format, mediaType[:len(mediaType)-1])) {
+				chosenFormat = format
+				chosenQuality = quality
+				chosenSpecificity = specificity
+				break
+			}
+		}
+	}
+	return chosenFormat, chosenFormat != ""
+}
+
 // parsePathTracingRequest parses the request for a path tracing run to the corresponding classes.
 //
 // Parameters:
 // 	request - The request.
 //
 // Returns:
+// 	The JSON body of the request.
 // 	The PathTracer.
-// 	The number of rays per pixel.
-// 	The number recursions of each ray.
-// 	The starting line index of the window of the screen to use the path tracing.
-// 	The starting column index of the window of the screen to use the path tracing.
-// 	The ending line index of the window of the screen to use the path tracing.
-// 	The ending column index of the window of the screen to use the path tracing.
-// 	An error.
+// 	The parameters of the path tracing.
+// 	An error, a marshaller.SchemaError when the body does not describe a valid scene, or a
+// 	marshaller.ParametersError when its parameters can not run it.
 //
-func parsePathTracingRequest(request *http.Request) (*path_tracing.PathTracer, int, int, int, int, int, int, error) {
-	var data map[string]interface{}
+func parsePathTracingRequest(request *http.Request) ([]byte, *path_tracing.PathTracer, *path_tracing.Parameters,
+	error) {
 	bodyAsBytes, err := ioutil.ReadAll(request.Body)
 	if err != nil {
-		return nil, 0, 0, 0, 0, 0, 0, errors.New("failed to decode your request")
+		return nil, nil, nil, errors.New("failed to decode your request")
 	}
-	err = json.Unmarshal(bodyAsBytes, &data)
+	marshallerController := &marshaller.Controller{}
+	pathTracer, parameters, err := marshallerController.ParsePathTracingFromJson(bodyAsBytes)
+	return bodyAsBytes, pathTracer, parameters, err
+}
+
+// readValidPathTracingRequest parses the request for a path tracing run and checks that its parameters can run it,
+// sending a bad request for an invalid scene and an unprocessable entity for invalid parameters.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	request        - The request.
+//
+// Returns:
+// 	The JSON body of the request.
+// 	The PathTracer.
+// 	The parameters of the path tracing.
+// 	If the request is valid, otherwise the error was sent.
+//
+func readValidPathTracingRequest(responseWriter http.ResponseWriter, request *http.Request) ([]byte,
+	*path_tracing.PathTracer, *path_tracing.Parameters, bool) {
+	pathTracingJson, pathTracer, parameters, err := parsePathTracingRequest(request)
 	if err != nil {
-		return nil, 0, 0, 0, 0, 0, 0, errors.New("failed to parse your request")
+		writeRequestError(responseWriter, err)
+		return nil, nil, nil, false
 	}
-	marshallerController := &marshaller.Controller{}
-	return marshallerController.ParsePathTracingFromMap(data)
+	pathTracingController := path_tracing.Controller{}
+	err = pathTracingController.ValidateParameters(pathTracer, parameters)
+	if err != nil {
+		writeError(responseWriter, http.StatusUnprocessableEntity, invalidParametersCode, err.Error())
+		return nil, nil, nil, false
+	}
+	return pathTracingJson, pathTracer, parameters, true
 }
 
-// RunPathTracing runs the requested path tracing, sending a matrix of colors as response.
+// encodePathTracingResponse encodes the rendered image in the format of the response.
+//
+// Parameters:
+// 	radianceMatrix - The radiance of the rendered image.
+// 	toneMapping    - The ToneMapping that converts the radiance to colors.
+// 	responseFormat - The media type of the response.
+//
+// Returns:
+// 	The encoded image.
+// 	An error.
+//
+func encodePathTracingResponse(radianceMatrix *radiance_matrix.RadianceMatrix,
+	toneMapping *tone_mapping.ToneMapping, responseFormat string) ([]byte, error) {
+	radianceMatrixController := radiance_matrix.Controller{}
+	var imageBuffer bytes.Buffer
+	switch responseFormat {
+	case radiance_matrix.PfmFormat, radiance_matrix.ExrFormat:
+		err := radianceMatrixController.Encode(radianceMatrix, responseFormat, &imageBuffer)
+		return imageBuffer.Bytes(), err
+	}
+
+	colorMatrix := radianceMatrixController.ToColorMatrix(radianceMatrix, toneMapping)
+	if responseFormat == jsonFormat {
+		marshallerController := &marshaller.Controller{}
+		return marshallerController.ColorMatrixToJson(colorMatrix)
+	}
+	colorMatrixController := color_matrix.Controller{}
+	err := colorMatrixController.Encode(colorMatrix, responseFormat, &imageBuffer)
+	return imageBuffer.Bytes(), err
+}
+
+// RunPathTracing runs the requested path tracing, sending a matrix of colors, an image or a high dynamic range image
+// as response, as requested by the Accept header. With the crop=window query the response has only the pixels of the
+// window of the parameters.
 //
 // Parameters:
 // 	responseWriter - The response writer.
@@ -48,30 +181,488 @@ func parsePathTracingRequest(request *http.Request) (*path_tracing.PathTracer, i
 // 	none
 //
 func RunPathTracing(responseWriter http.ResponseWriter, request *http.Request) {
-	pathTracer, raysPerPixel, recursions, windowStartLine, windowStartColumn, windowEndLine, windowEndColumn, err :=
-		parsePathTracingRequest(request)
+	responseFormat, isSupported := negotiateResponseFormat(request.Header.Get("Accept"))
+	if !isSupported {
+		writeUnsupportedFormatError(responseWriter)
+		return
+	}
 
-	if err != nil {
-		http.Error(responseWriter, err.Error(), 500)
+	_, pathTracer, parameters, isValid := readValidPathTracingRequest(responseWriter, request)
+	if !isValid {
+		return
 	}
 
 	pathTracingController := path_tracing.Controller{}
-	colorMatrix, err := pathTracingController.Run(pathTracer, raysPerPixel, recursions, windowStartLine,
-		windowStartColumn, windowEndLine, windowEndColumn)
+	radianceMatrix, err := pathTracingController.RunRadiance(request.Context(), pathTracer, parameters)
+	if err != nil {
+		if request.Context().Err() != nil {
+			// The client is gone, so there is no one to answer.
+			return
+		}
+		writeError(responseWriter, http.StatusInternalServerError, renderFailedCode, "failed to run the path tracing")
+		return
+	}
+
+	if request.URL.Query().Get("crop") == "window" {
+		radianceMatrixController := radiance_matrix.Controller{}
+		radianceMatrix, err = radianceMatrixController.Crop(radianceMatrix, parameters.GetWindowStartLine(),
+			parameters.GetWindowStartColumn(), parameters.GetWindowEndLine(), parameters.GetWindowEndColumn())
+		if err != nil {
+			writeError(responseWriter, http.StatusUnprocessableEntity, invalidParametersCode, err.Error())
+			return
+		}
+	}
 
+	imageAsBytes, err := encodePathTracingResponse(radianceMatrix, parameters.GetToneMapping(), responseFormat)
 	if err != nil {
-		http.Error(responseWriter, "failed to run the path tracing.", 500)
+		writeInternalError(responseWriter, "failed to serialize the response")
+		return
 	}
 
-	marshallerController := &marshaller.Controller{}
-	colorMatrixAsBytes, err := marshallerController.ColorMatrixToJson(colorMatrix)
+	responseWriter.Header().Set("Content-Type", responseFormat)
+	responseWriter.Header().Add("Vary", "Accept")
+	_, _ = responseWriter.Write(imageAsBytes)
+}
 
+// ValidateScene checks the requested path tracing without running it, sending {"valid": ..., "errors": [{"path": ...,
+// "message": ...}]} with the invalid fields of the scene, the broken references, degenerate triangles, zero-length
+// normals and NaNs of its objects, or the parameters that can not run it.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	request        - The request.
+//
+// Returns:
+// 	none
+//
+func ValidateScene(responseWriter http.ResponseWriter, request *http.Request) {
+	_, pathTracer, parameters, err := parsePathTracingRequest(request)
+	var schemaError *marshaller.SchemaError
+	var parametersError *marshaller.ParametersError
+	issues := make([]map[string]string, 0)
+	if errors.As(err, &schemaError) {
+		issues = fieldErrorsToMaps(schemaError.GetFieldErrors())
+	} else if errors.As(err, &parametersError) {
+		issues = fieldErrorsToMaps(parametersError.GetFieldErrors())
+	} else if err != nil {
+		writeRequestError(responseWriter, err)
+		return
+	} else {
+		pathTracingController := path_tracing.Controller{}
+		err = pathTracingController.ValidateParameters(pathTracer, parameters)
+		if err != nil {
+			issues = append(issues, map[string]string{"path": "pathTracingParameters", "message": err.Error()})
+		}
+	}
+	writeJson(responseWriter, http.StatusOK, map[string]interface{}{"valid": len(issues) == 0, "errors": issues})
+}
+
+// writeJson writes a JSON response.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	statusCode     - The status code of the response.
+// 	response       - The content of the response.
+//
+// Returns:
+// 	none
+//
+func writeJson(responseWriter http.ResponseWriter, statusCode int, response interface{}) {
+	responseAsBytes, err := json.Marshal(response)
 	if err != nil {
 		http.Error(responseWriter, "failed to serialize the response", 500)
+		return
+	}
+	responseWriter.Header().Set("Content-Type", jsonFormat)
+	responseWriter.WriteHeader(statusCode)
+	_, _ = responseWriter.Write(responseAsBytes)
+}
+
+// jobToMap converts the status of a Job to a map of its JSON response.
+//
+// Parameters:
+// 	requestedJob - The Job.
+//
+// Returns:
+// 	The map.
+//
+func jobToMap(requestedJob *job.Job) map[string]interface{} {
+	progressiveRender := requestedJob.GetProgressiveRender()
+	jobMap := map[string]interface{}{
+		"id":              requestedJob.GetId(),
+		"status":          requestedJob.GetStatus(),
+		"progress":        progressiveRender.GetProgress().GetFraction(),
+		"completedPasses": progressiveRender.GetCompletedPasses(),
+		"passes":          progressiveRender.GetTotalPasses(),
+	}
+	if requestedJob.GetStatus() == job.FailedStatus {
+		jobMap["error"] = progressiveRender.GetError().Error()
 	}
+	return jobMap
+}
 
-	_, err = responseWriter.Write(colorMatrixAsBytes)
+// findRequestedJob finds the Job of the id in the path of the request, sending not found when there is none.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	request        - The request.
+//
+// Returns:
+// 	The Job, nil when it was not found.
+//
+func findRequestedJob(responseWriter http.ResponseWriter, request *http.Request) *job.Job {
+	requestedJob, err := jobManager.Get(mux.Vars(request)["id"])
 	if err != nil {
-		http.Error(responseWriter, err.Error(), 500)
+		writeError(responseWriter, http.StatusNotFound, jobNotFoundCode, err.Error())
+		return nil
+	}
+	return requestedJob
+}
+
+// writeEstimate sends the current estimate of a Job, in the format requested by the Accept header, with the number
+// of completed passes in the X-Completed-Passes header.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	requestedJob   - The Job.
+// 	responseFormat - The media type of the response.
+//
+// Returns:
+// 	none
+//
+func writeEstimate(responseWriter http.ResponseWriter, requestedJob *job.Job, responseFormat string) {
+	progressiveRender := requestedJob.GetProgressiveRender()
+	completedPasses := progressiveRender.GetCompletedPasses()
+	estimateAsBytes, err := encodePathTracingResponse(progressiveRender.GetEstimate(),
+		requestedJob.GetParameters().GetToneMapping(), responseFormat)
+	if err != nil {
+		writeInternalError(responseWriter, "failed to serialize the response")
+		return
+	}
+
+	responseWriter.Header().Set("Content-Type", responseFormat)
+	responseWriter.Header().Add("Vary", "Accept")
+	responseWriter.Header().Set("X-Completed-Passes", strconv.Itoa(completedPasses))
+	responseWriter.Header().Set("X-Total-Passes", strconv.Itoa(progressiveRender.GetTotalPasses()))
+	_, _ = responseWriter.Write(estimateAsBytes)
+}
+
+// SubmitJob starts the requested path tracing in the background, sending the status of its Job with the location of
+// the Job.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	request        - The request.
+//
+// Returns:
+// 	none
+//
+func SubmitJob(responseWriter http.ResponseWriter, request *http.Request) {
+	_, pathTracer, parameters, isValid := readValidPathTracingRequest(responseWriter, request)
+	if !isValid {
+		return
+	}
+
+	submittedJob, err := jobManager.Submit(pathTracer, parameters)
+	if err != nil {
+		writeInternalError(responseWriter, "failed to start the path tracing")
+		return
+	}
+
+	responseWriter.Header().Set("Location", strings.TrimSuffix(request.URL.Path, "/")+"/"+submittedJob.GetId())
+	writeJson(responseWriter, http.StatusAccepted, jobToMap(submittedJob))
+}
+
+// GetJob sends the status and the progress of a Job.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	request        - The request.
+//
+// Returns:
+// 	none
+//
+func GetJob(responseWriter http.ResponseWriter, request *http.Request) {
+	requestedJob := findRequestedJob(responseWriter, request)
+	if requestedJob == nil {
+		return
+	}
+	writeJson(responseWriter, http.StatusOK, jobToMap(requestedJob))
+}
+
+// GetJobResult sends the rendered image of a done Job, in the format requested by the Accept header.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	request        - The request.
+//
+// Returns:
+// 	none
+//
+func GetJobResult(responseWriter http.ResponseWriter, request *http.Request) {
+	responseFormat, isSupported := negotiateResponseFormat(request.Header.Get("Accept"))
+	if !isSupported {
+		writeUnsupportedFormatError(responseWriter)
+		return
+	}
+
+	requestedJob := findRequestedJob(responseWriter, request)
+	if requestedJob == nil {
+		return
+	}
+	switch requestedJob.GetStatus() {
+	case job.RunningStatus:
+		writeError(responseWriter, http.StatusConflict, jobRunningCode, "the path tracing is still running")
+		return
+	case job.CanceledStatus:
+		writeError(responseWriter, http.StatusConflict, jobCanceledCode, "the path tracing was canceled")
+		return
+	case job.FailedStatus:
+		writeError(responseWriter, http.StatusInternalServerError, renderFailedCode, "failed to run the path tracing")
+		return
+	}
+	writeEstimate(responseWriter, requestedJob, responseFormat)
+}
+
+// GetJobEstimate sends the current estimate of a Job while it renders, or its last estimate once it stopped, in the
+// format requested by the Accept header, with the number of completed passes in the X-Completed-Passes header.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	request        - The request.
+//
+// Returns:
+// 	none
+//
+func GetJobEstimate(responseWriter http.ResponseWriter, request *http.Request) {
+	responseFormat, isSupported := negotiateResponseFormat(request.Header.Get("Accept"))
+	if !isSupported {
+		writeUnsupportedFormatError(responseWriter)
+		return
+	}
+
+	requestedJob := findRequestedJob(responseWriter, request)
+	if requestedJob == nil {
+		return
+	}
+	if requestedJob.GetStatus() == job.FailedStatus {
+		writeError(responseWriter, http.StatusInternalServerError, renderFailedCode, "failed to run the path tracing")
+		return
+	}
+	writeEstimate(responseWriter, requestedJob, responseFormat)
+}
+
+// CancelJob cancels a Job, sending its status. The Job stops after the tiles being traced.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	request        - The request.
+//
+// Returns:
+// 	none
+//
+func CancelJob(responseWriter http.ResponseWriter, request *http.Request) {
+	canceledJob, err := jobManager.Cancel(mux.Vars(request)["id"])
+	if err != nil {
+		writeError(responseWriter, http.StatusNotFound, jobNotFoundCode, err.Error())
+		return
+	}
+	writeJson(responseWriter, http.StatusAccepted, jobToMap(canceledJob))
+}
+
+// eventsObserver is a ProgressObserver that forwards the progress of a render to a stream of server-sent events.
+// Progress is dropped while the stream is behind, so a slow client never blocks the render.
+//
+// Members:
+// 	progresses - The Progresses waiting to be sent.
+// 	done       - The end of the render.
+//
+type eventsObserver struct {
+	progresses chan *path_tracing.Progress
+	done       chan error
+}
+
+// OnProgress forwards the progress after a tile is rendered, unless the stream is behind.
+//
+// Parameters:
+// 	progress - The Progress of the render.
+//
+// Returns:
+// 	none
+//
+func (observer *eventsObserver) OnProgress(progress *path_tracing.Progress) {
+	select {
+	case observer.progresses <- progress:
+	default:
+	}
+}
+
+// OnDone forwards the end of the render.
+//
+// Parameters:
+// 	err - The error that stopped the render.
+//
+// Returns:
+// 	none
+//
+func (observer *eventsObserver) OnDone(err error) {
+	select {
+	case observer.done <- err:
+	default:
+	}
+}
+
+// progressToMap converts a Progress to a map of its JSON event.
+//
+// Parameters:
+// 	progress - The Progress.
+//
+// Returns:
+// 	The map.
+//
+func progressToMap(progress *path_tracing.Progress) map[string]interface{} {
+	progressMap := map[string]interface{}{
+		"renderedTiles":    progress.GetRenderedTiles(),
+		"totalTiles":       progress.GetTotalTiles(),
+		"progress":         progress.GetFraction(),
+		"completedPasses":  progress.GetCompletedPasses(),
+		"passes":           progress.GetTotalPasses(),
+		"samples":          progress.GetTracedSamples(),
+		"samplesPerSecond": progress.GetSamplesPerSecond(),
+		"elapsedSeconds":   progress.GetElapsedTime().Seconds(),
+	}
+	if remainingTime, hasEstimate := progress.GetEstimatedTimeRemaining(); hasEstimate {
+		progressMap["etaSeconds"] = remainingTime.Seconds()
+	}
+	if renderedTile := progress.GetRenderedTile(); renderedTile != nil {
+		progressMap["tile"] = map[string]int{
+			"startLine":   renderedTile.GetStartLine(),
+			"startColumn": renderedTile.GetStartColumn(),
+			"endLine":     renderedTile.GetEndLine(),
+			"endColumn":   renderedTile.GetEndColumn(),
+		}
+	}
+	return progressMap
+}
+
+// writeEvent writes a server-sent event with JSON data and flushes it to the client.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	flusher        - The flusher of the response writer.
+// 	eventName      - The name of the event.
+// 	data           - The data of the event.
+//
+// Returns:
+// 	An error.
+//
+func writeEvent(responseWriter http.ResponseWriter, flusher http.Flusher, eventName string,
+	data interface{}) error {
+	dataAsBytes, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(responseWriter, "event: %s\ndata: %s\n\n", eventName, dataAsBytes)
+	if err != nil {
+		return err
+	}
+	flusher.Flush()
+	return nil
+}
+
+// StreamJobEvents streams the progress of a Job as server-sent events, a "progress" event with the current progress
+// and one for each rendered tile, and a "done" event with the status of the Job when it stops.
+//
+// Parameters:
+// 	responseWriter - The response writer.
+// 	request        - The request.
+//
+// Returns:
+// 	none
+//
+func StreamJobEvents(responseWriter http.ResponseWriter, request *http.Request) {
+	requestedJob := findRequestedJob(responseWriter, request)
+	if requestedJob == nil {
+		return
+	}
+	flusher, isFlusher := responseWriter.(http.Flusher)
+	if !isFlusher {
+		writeInternalError(responseWriter, "streaming is not supported")
+		return
+	}
+
+	progressiveRender := requestedJob.GetProgressiveRender()
+	observer := &eventsObserver{progresses: make(chan *path_tracing.Progress, 64), done: make(chan error, 1)}
+	progressiveRender.AddObserver(observer)
+	defer progressiveRender.RemoveObserver(observer)
+
+	responseWriter.Header().Set("Content-Type", "text/event-stream")
+	responseWriter.Header().Set("Cache-Control", "no-cache")
+	if writeEvent(responseWriter, flusher, "progress", progressToMap(progressiveRender.GetProgress())) != nil {
+		return
+	}
+	if requestedJob.GetStatus() != job.RunningStatus {
+		_ = writeEvent(responseWriter, flusher, "done", jobToMap(requestedJob))
+		return
+	}
+
+	for {
+		select {
+		case <-request.Context().Done():
+			return
+		case progress := <-observer.progresses:
+			if writeEvent(responseWriter, flusher, "progress", progressToMap(progress)) != nil {
+				return
+			}
+		case <-observer.done:
+			for len(observer.progresses) > 0 {
+				if writeEvent(responseWriter, flusher, "progress", progressToMap(<-observer.progresses)) != nil {
+					return
+				}
+			}
+			_ = writeEvent(responseWriter, flusher, "done", jobToMap(requestedJob))
+			return
+		}
+	}
+}
+
+// RunDistributedPathTracing builds the handler that runs the requested path tracing split in tiles across the workers
+// of a Coordinator, sending the same responses as RunPathTracing.
+//
+// Parameters:
+// 	coordinator - The Coordinator.
+//
+// Returns:
+// 	The handler.
+//
+func RunDistributedPathTracing(coordinator *distributed.Coordinator) http.HandlerFunc {
+	return func(responseWriter http.ResponseWriter, request *http.Request) {
+		responseFormat, isSupported := negotiateResponseFormat(request.Header.Get("Accept"))
+		if !isSupported {
+			writeUnsupportedFormatError(responseWriter)
+			return
+		}
+
+		pathTracingJson, pathTracer, parameters, isValid := readValidPathTracingRequest(responseWriter, request)
+		if !isValid {
+			return
+		}
+
+		distributedController := distributed.Controller{}
+		radianceMatrix, err := distributedController.Run(request.Context(), coordinator, pathTracingJson, pathTracer,
+			parameters)
+		if err != nil {
+			writeError(responseWriter, http.StatusBadGateway, workersFailedCode, err.Error())
+			return
+		}
+
+		imageAsBytes, err := encodePathTracingResponse(radianceMatrix, parameters.GetToneMapping(), responseFormat)
+		if err != nil {
+			writeInternalError(responseWriter, "failed to serialize the response")
+			return
+		}
+		responseWriter.Header().Set("Content-Type", responseFormat)
+		responseWriter.Header().Add("Vary", "Accept")
+		_, _ = responseWriter.Write(imageAsBytes)
 	}
 }
@@ -0,0 +1,621 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/distributed"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/color_matrix"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/rendering/radiance_matrix"
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestNegotiateResponseFormat tests choosing the format of the response from the Accept header.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestNegotiateResponseFormat(t *testing.T) {
+	expectedFormats := map[string]string{
+		"":                                    jsonFormat,
+		"*/*":                                 jsonFormat,
+		"application/json":                    jsonFormat,
+		"image/png":                           color_matrix.PngFormat,
+		"image/*":                             color_matrix.PngFormat,
+		"*/*, image/jpeg":                     color_matrix.JpegFormat,
+		"image/png;q=0.5, image/jpeg;q=0.8":   color_matrix.JpegFormat,
+		"text/html, image/x-portable-pixmap":  color_matrix.PpmFormat,
+		"image/png;q=0, application/json;q=1": jsonFormat,
+		"image/x-exr":                         radiance_matrix.ExrFormat,
+		"image/x-portable-floatmap":           radiance_matrix.PfmFormat,
+	}
+	for acceptHeader, expectedFormat := range expectedFormats {
+		format, isSupported := negotiateResponseFormat(acceptHeader)
+		test_helpers.AssertEqual(t, true, isSupported)
+		test_helpers.AssertEqual(t, expectedFormat, format)
+	}
+}
+
+// TestNegotiateResponseFormat_NotAcceptable tests choosing the format of the response when none is supported.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestNegotiateResponseFormat_NotAcceptable(t *testing.T) {
+	for _, acceptHeader := range []string{"text/html", "image/gif", "image/png;q=0"} {
+		_, isSupported := negotiateResponseFormat(acceptHeader)
+		test_helpers.AssertEqual(t, false, isSupported)
+	}
+}
+
+// lightWallRequest is the body of a path tracing request of a screen of 7x5 pixels whose camera looks at a wall that
+// is a light of radiance {2, 1, 0.5}, formatted with the path tracing parameters.
+const lightWallRequest = `{
+	"pathTracingParameters": %s,
+	"pixelScreen": {"width": 7, "height": 5},
+	"sceneCamera": {
+		"position": {"coordinates": [0, 0, 0]},
+		"look": {"coordinates": [1, 0, 0]},
+		"up": {"coordinates": [0, 0, 1]},
+		"right": {"coordinates": [0, -1, 0]},
+		"fieldOfView": 60,
+		"distanceToScreen": 1
+	},
+	"lights": [{
+		"lightIntensity": 2,
+		"color": [1, 0.5, 0.25],
+		"lightObject": {
+			"name": "wall",
+			"lightCharacteristics": {"color": [0.5, 0.5, 0.5], "specularReflection": 0, "roughNess": 0,
+				"transmissionReflection": 0, "diffuseReflection": 1},
+			"normals": [{"coordinates": [-1, 0, 0]}],
+			"repository": {"points": [{"coordinates": [10, -100, -100]}, {"coordinates": [10, 100, -100]},
+				{"coordinates": [10, 100, 100]}, {"coordinates": [10, -100, 100]}]},
+			"triangles": [{"verticesIndices": [0, 1, 2], "verticesNormalsIndices": [0, 0, 0]},
+				{"verticesIndices": [0, 2, 3], "verticesNormalsIndices": [0, 0, 0]}]
+		}
+	}],
+	"objects": []
+}`
+
+// errorResponse is the JSON of an error response.
+type errorResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Errors  []struct {
+		Path    string `json:"path"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// assertErrorResponse asserts that a response is a JSON error with the expected status and code.
+//
+// Parameters:
+//  t                  - Test instance.
+//  responseRecorder   - The recorder of the response.
+//  expectedStatusCode - The expected status code.
+//  expectedCode       - The expected code of the error.
+//
+// Returns:
+//  The error response.
+//
+func assertErrorResponse(t *testing.T, responseRecorder *httptest.ResponseRecorder, expectedStatusCode int,
+	expectedCode string) errorResponse {
+	test_helpers.AssertEqual(t, expectedStatusCode, responseRecorder.Code)
+	test_helpers.AssertEqual(t, jsonFormat, responseRecorder.Header().Get("Content-Type"))
+	var response errorResponse
+	test_helpers.AssertNilError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &response))
+	test_helpers.AssertEqual(t, expectedCode, response.Code)
+	return response
+}
+
+// TestRunPathTracing tests running a path tracing, whose image is the only content of the response.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRunPathTracing(t *testing.T) {
+	parameters := `{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 5, "windowEndColumn": 7, "estimator": "unbiased"}`
+	request := httptest.NewRequest(http.MethodPost, "/path-tracing",
+		strings.NewReader(fmt.Sprintf(lightWallRequest, parameters)))
+	request.Header.Set("Accept", color_matrix.PpmFormat)
+	responseRecorder := httptest.NewRecorder()
+	RunPathTracing(responseRecorder, request)
+	test_helpers.AssertEqual(t, http.StatusOK, responseRecorder.Code)
+	test_helpers.AssertEqual(t, color_matrix.PpmFormat, responseRecorder.Header().Get("Content-Type"))
+	test_helpers.AssertEqual(t, "P6\n7 5\n255\n", responseRecorder.Body.String()[:len("P6\n7 5\n255\n")])
+	test_helpers.AssertEqual(t, len("P6\n7 5\n255\n")+7*5*3, responseRecorder.Body.Len())
+}
+
+// TestRunPathTracing_CropWindow tests running a path tracing whose response has only the pixels of its window.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRunPathTracing_CropWindow(t *testing.T) {
+	parameters := `{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 1, "windowStartColumn": 2,
+		"windowEndLine": 4, "windowEndColumn": 6, "estimator": "unbiased"}`
+	request := httptest.NewRequest(http.MethodPost, "/path-tracing?crop=window",
+		strings.NewReader(fmt.Sprintf(lightWallRequest, parameters)))
+	request.Header.Set("Accept", color_matrix.PpmFormat)
+	responseRecorder := httptest.NewRecorder()
+	RunPathTracing(responseRecorder, request)
+	test_helpers.AssertEqual(t, http.StatusOK, responseRecorder.Code)
+	test_helpers.AssertEqual(t, "P6\n4 3\n255\n", responseRecorder.Body.String()[:len("P6\n4 3\n255\n")])
+	test_helpers.AssertEqual(t, len("P6\n4 3\n255\n")+4*3*3, responseRecorder.Body.Len())
+}
+
+// mirrorWallRequest is the body of a path tracing request of a screen of 7x5 pixels without lights whose camera looks
+// at a specular wall.
+const mirrorWallRequest = `{
+	"pathTracingParameters": {"raysPerPixel": 2, "recursions": 3, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 5, "windowEndColumn": 7},
+	"pixelScreen": {"width": 7, "height": 5},
+	"sceneCamera": {
+		"position": {"coordinates": [0, 0, 0]},
+		"look": {"coordinates": [1, 0, 0]},
+		"up": {"coordinates": [0, 0, 1]},
+		"right": {"coordinates": [0, -1, 0]},
+		"fieldOfView": 60,
+		"distanceToScreen": 1
+	},
+	"lights": [],
+	"objects": [{
+		"name": "mirror",
+		"lightCharacteristics": {"color": [1, 1, 1], "specularReflection": 1, "roughNess": 0,
+			"transmissionReflection": 0, "diffuseReflection": 0},
+		"normals": [{"coordinates": [-1, 0, 0]}],
+		"repository": {"points": [{"coordinates": [10, -100, -100]}, {"coordinates": [10, 100, -100]},
+			{"coordinates": [10, 100, 100]}, {"coordinates": [10, -100, 100]}]},
+		"triangles": [{"verticesIndices": [0, 1, 2], "verticesNormalsIndices": [0, 0, 0]},
+			{"verticesIndices": [0, 2, 3], "verticesNormalsIndices": [0, 0, 0]}]
+	}]
+}`
+
+// TestRunPathTracing_SpecularWithoutLights tests running the legacy estimator on a specular object of a scene without
+// lights, whose reflections do not depend on a light.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRunPathTracing_SpecularWithoutLights(t *testing.T) {
+	request := httptest.NewRequest(http.MethodPost, "/path-tracing", strings.NewReader(mirrorWallRequest))
+	request.Header.Set("Accept", color_matrix.PpmFormat)
+	responseRecorder := httptest.NewRecorder()
+	RunPathTracing(responseRecorder, request)
+	test_helpers.AssertEqual(t, http.StatusOK, responseRecorder.Code)
+	test_helpers.AssertEqual(t, len("P6\n7 5\n255\n")+7*5*3, responseRecorder.Body.Len())
+}
+
+// TestRunPathTracing_InvalidScene tests running a path tracing whose scene misses a field, which is reported with its
+// JSON path.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRunPathTracing_InvalidScene(t *testing.T) {
+	parameters := `{"raysPerPixel": 1, "windowStartLine": 0, "windowStartColumn": 0, "windowEndLine": 5,
+		"windowEndColumn": 7}`
+	request := httptest.NewRequest(http.MethodPost, "/path-tracing",
+		strings.NewReader(fmt.Sprintf(lightWallRequest, parameters)))
+	responseRecorder := httptest.NewRecorder()
+	RunPathTracing(responseRecorder, request)
+	response := assertErrorResponse(t, responseRecorder, http.StatusBadRequest, invalidSceneCode)
+	test_helpers.AssertEqual(t, 1, len(response.Errors))
+	test_helpers.AssertEqual(t, "pathTracingParameters.recursions", response.Errors[0].Path)
+	test_helpers.AssertEqual(t, "is required", response.Errors[0].Message)
+}
+
+// TestRunPathTracing_InvalidParameters tests running a path tracing whose parameters can not run its scene, listing
+// the invalid parameter when it is invalid on its own.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRunPathTracing_InvalidParameters(t *testing.T) {
+	for parameters, expectedPath := range map[string]string{
+		`{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0, "windowEndLine": 6,
+			"windowEndColumn": 7}`: "",
+		`{"raysPerPixel": 0, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0, "windowEndLine": 5,
+			"windowEndColumn": 7}`: "",
+		`{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0, "windowEndLine": 5,
+			"windowEndColumn": 7, "passes": 0}`: "pathTracingParameters.passes",
+		`{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0, "windowEndLine": 5,
+			"windowEndColumn": 7, "tileSize": 0}`: "pathTracingParameters.tileSize",
+		`{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0, "windowEndLine": 5,
+			"windowEndColumn": 7, "estimator": "foo"}`: "pathTracingParameters.estimator",
+	} {
+		request := httptest.NewRequest(http.MethodPost, "/path-tracing",
+			strings.NewReader(fmt.Sprintf(lightWallRequest, parameters)))
+		responseRecorder := httptest.NewRecorder()
+		RunPathTracing(responseRecorder, request)
+		response := assertErrorResponse(t, responseRecorder, http.StatusUnprocessableEntity, invalidParametersCode)
+		if expectedPath != "" {
+			test_helpers.AssertEqual(t, 1, len(response.Errors))
+			test_helpers.AssertEqual(t, expectedPath, response.Errors[0].Path)
+		}
+	}
+}
+
+// TestRunPathTracing_UnsupportedFormat tests running a path tracing whose response format is not supported.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRunPathTracing_UnsupportedFormat(t *testing.T) {
+	request := httptest.NewRequest(http.MethodPost, "/path-tracing", strings.NewReader("{}"))
+	request.Header.Set("Accept", "image/gif")
+	responseRecorder := httptest.NewRecorder()
+	RunPathTracing(responseRecorder, request)
+	assertErrorResponse(t, responseRecorder, http.StatusNotAcceptable, unsupportedFormatCode)
+}
+
+// validationResponse is the JSON of a scene validation response.
+type validationResponse struct {
+	Valid  bool `json:"valid"`
+	Errors []struct {
+		Path    string `json:"path"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// validateScene sends a scene validation request.
+//
+// Parameters:
+//  t           - Test instance.
+//  requestBody - The body of the request.
+//
+// Returns:
+//  The validation response.
+//
+func validateScene(t *testing.T, requestBody string) validationResponse {
+	request := httptest.NewRequest(http.MethodPost, "/validate", strings.NewReader(requestBody))
+	responseRecorder := httptest.NewRecorder()
+	ValidateScene(responseRecorder, request)
+	test_helpers.AssertEqual(t, http.StatusOK, responseRecorder.Code)
+	var response validationResponse
+	test_helpers.AssertNilError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &response))
+	return response
+}
+
+// TestValidateScene tests validating a scene that can be rendered.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestValidateScene(t *testing.T) {
+	parameters := `{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 5, "windowEndColumn": 7}`
+	response := validateScene(t, fmt.Sprintf(lightWallRequest, parameters))
+	test_helpers.AssertEqual(t, true, response.Valid)
+	test_helpers.AssertEqual(t, 0, len(response.Errors))
+}
+
+// TestValidateScene_Invalid tests validating scenes with a broken object, a missing field and parameters that can not
+// run them.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestValidateScene_Invalid(t *testing.T) {
+	parameters := `{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 5, "windowEndColumn": 7}`
+	zeroNormalRequest := strings.Replace(fmt.Sprintf(lightWallRequest, parameters), `[-1, 0, 0]`, `[0, 0, 0]`, 1)
+	response := validateScene(t, zeroNormalRequest)
+	test_helpers.AssertEqual(t, false, response.Valid)
+	test_helpers.AssertEqual(t, 1, len(response.Errors))
+	test_helpers.AssertEqual(t, "lights[0].lightObject.normals[0]", response.Errors[0].Path)
+	test_helpers.AssertEqual(t, "zero-length normal", response.Errors[0].Message)
+
+	response = validateScene(t, fmt.Sprintf(lightWallRequest, `{"raysPerPixel": 1}`))
+	test_helpers.AssertEqual(t, false, response.Valid)
+	test_helpers.AssertEqual(t, 5, len(response.Errors))
+
+	windowParameters := `{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 6, "windowEndColumn": 7}`
+	response = validateScene(t, fmt.Sprintf(lightWallRequest, windowParameters))
+	test_helpers.AssertEqual(t, false, response.Valid)
+	test_helpers.AssertEqual(t, 1, len(response.Errors))
+	test_helpers.AssertEqual(t, "pathTracingParameters", response.Errors[0].Path)
+
+	samplerParameters := `{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 5, "windowEndColumn": 7, "sampler": "foo"}`
+	response = validateScene(t, fmt.Sprintf(lightWallRequest, samplerParameters))
+	test_helpers.AssertEqual(t, false, response.Valid)
+	test_helpers.AssertEqual(t, 1, len(response.Errors))
+	test_helpers.AssertEqual(t, "pathTracingParameters.sampler", response.Errors[0].Path)
+}
+
+// waitForJobStatus polls the status of a Job until it stops running.
+//
+// Parameters:
+//  t  - Test instance.
+//  id - The id of the Job.
+//
+// Returns:
+//  The last status of the Job.
+//
+func waitForJobStatus(t *testing.T, id string) map[string]interface{} {
+	var jobStatus map[string]interface{}
+	for attempt := 0; attempt < 1000; attempt++ {
+		request := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/jobs/"+id, nil), map[string]string{
+			"id": id})
+		responseRecorder := httptest.NewRecorder()
+		GetJob(responseRecorder, request)
+		test_helpers.AssertEqual(t, http.StatusOK, responseRecorder.Code)
+		test_helpers.AssertNilError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &jobStatus))
+		if jobStatus["status"] != "running" {
+			return jobStatus
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("The job %s did not stop.", id)
+	return jobStatus
+}
+
+// submitTestJob submits a Job of the light wall scene.
+//
+// Parameters:
+//  t          - Test instance.
+//  parameters - The path tracing parameters of the request.
+//
+// Returns:
+//  The id of the Job.
+//
+func submitTestJob(t *testing.T, parameters string) string {
+	request := httptest.NewRequest(http.MethodPost, "/jobs", strings.NewReader(fmt.Sprintf(lightWallRequest,
+		parameters)))
+	responseRecorder := httptest.NewRecorder()
+	SubmitJob(responseRecorder, request)
+	test_helpers.AssertEqual(t, http.StatusAccepted, responseRecorder.Code)
+
+	var jobStatus map[string]interface{}
+	test_helpers.AssertNilError(t, json.Unmarshal(responseRecorder.Body.Bytes(), &jobStatus))
+	id := jobStatus["id"].(string)
+	test_helpers.AssertEqual(t, "/jobs/"+id, responseRecorder.Header().Get("Location"))
+	return id
+}
+
+// TestSubmitJob tests submitting a Job, waiting for it and fetching its result.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestSubmitJob(t *testing.T) {
+	id := submitTestJob(t, `{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 5, "windowEndColumn": 7, "estimator": "unbiased", "passes": 2}`)
+
+	jobStatus := waitForJobStatus(t, id)
+	test_helpers.AssertEqual(t, "done", jobStatus["status"])
+	test_helpers.AssertEqual(t, 1.0, jobStatus["progress"])
+	test_helpers.AssertEqual(t, 2.0, jobStatus["completedPasses"])
+
+	request := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/jobs/"+id+"/result", nil), map[string]string{
+		"id": id})
+	request.Header.Set("Accept", color_matrix.PpmFormat)
+	responseRecorder := httptest.NewRecorder()
+	GetJobResult(responseRecorder, request)
+	test_helpers.AssertEqual(t, http.StatusOK, responseRecorder.Code)
+	test_helpers.AssertEqual(t, color_matrix.PpmFormat, responseRecorder.Header().Get("Content-Type"))
+	test_helpers.AssertEqual(t, true, strings.HasPrefix(responseRecorder.Body.String(), "P6"))
+}
+
+// TestGetJobEstimate tests fetching the estimate of a Job while it renders its passes.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestGetJobEstimate(t *testing.T) {
+	id := submitTestJob(t, `{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 5, "windowEndColumn": 7, "estimator": "unbiased", "passes": 3}`)
+
+	for attempt := 0; attempt < 100; attempt++ {
+		request := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/jobs/"+id+"/estimate", nil),
+			map[string]string{"id": id})
+		request.Header.Set("Accept", radiance_matrix.PfmFormat)
+		responseRecorder := httptest.NewRecorder()
+		GetJobEstimate(responseRecorder, request)
+		test_helpers.AssertEqual(t, http.StatusOK, responseRecorder.Code)
+		test_helpers.AssertEqual(t, radiance_matrix.PfmFormat, responseRecorder.Header().Get("Content-Type"))
+		test_helpers.AssertEqual(t, "3", responseRecorder.Header().Get("X-Total-Passes"))
+		completedPasses, err := strconv.Atoi(responseRecorder.Header().Get("X-Completed-Passes"))
+		test_helpers.AssertNilError(t, err)
+		if completedPasses == 3 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("The job did not complete its passes.")
+}
+
+// TestSubmitJob_InvalidWindow tests submitting a Job of a window out of the screen.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestSubmitJob_InvalidWindow(t *testing.T) {
+	parameters := `{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 6, "windowEndColumn": 7}`
+	request := httptest.NewRequest(http.MethodPost, "/jobs", strings.NewReader(fmt.Sprintf(lightWallRequest,
+		parameters)))
+	responseRecorder := httptest.NewRecorder()
+	SubmitJob(responseRecorder, request)
+	assertErrorResponse(t, responseRecorder, http.StatusUnprocessableEntity, invalidParametersCode)
+}
+
+// TestCancelJob tests canceling a Job, whose result is no longer available.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestCancelJob(t *testing.T) {
+	id := submitTestJob(t, `{"raysPerPixel": 64, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 5, "windowEndColumn": 7, "estimator": "unbiased", "passes": 100000}`)
+
+	request := mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/jobs/"+id, nil), map[string]string{"id": id})
+	responseRecorder := httptest.NewRecorder()
+	CancelJob(responseRecorder, request)
+	test_helpers.AssertEqual(t, http.StatusAccepted, responseRecorder.Code)
+	test_helpers.AssertEqual(t, "canceled", waitForJobStatus(t, id)["status"])
+
+	request = mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/jobs/"+id+"/result", nil), map[string]string{
+		"id": id})
+	responseRecorder = httptest.NewRecorder()
+	GetJobResult(responseRecorder, request)
+	assertErrorResponse(t, responseRecorder, http.StatusConflict, jobCanceledCode)
+}
+
+// TestGetJob_NotFound tests the endpoints of a Job that was not submitted.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestGetJob_NotFound(t *testing.T) {
+	for _, handler := range []http.HandlerFunc{GetJob, GetJobResult, GetJobEstimate, CancelJob,
+		StreamJobEvents} {
+		request := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/jobs/unknown", nil), map[string]string{
+			"id": "unknown"})
+		responseRecorder := httptest.NewRecorder()
+		handler(responseRecorder, request)
+		assertErrorResponse(t, responseRecorder, http.StatusNotFound, jobNotFoundCode)
+	}
+}
+
+// TestStreamJobEvents tests streaming the progress of a Job until it is done.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestStreamJobEvents(t *testing.T) {
+	id := submitTestJob(t, `{"raysPerPixel": 1, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 5, "windowEndColumn": 7, "estimator": "unbiased", "tileSize": 2, "passes": 2}`)
+
+	request := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/jobs/"+id+"/events", nil), map[string]string{
+		"id": id})
+	responseRecorder := httptest.NewRecorder()
+	StreamJobEvents(responseRecorder, request)
+	test_helpers.AssertEqual(t, http.StatusOK, responseRecorder.Code)
+	test_helpers.AssertEqual(t, "text/event-stream", responseRecorder.Header().Get("Content-Type"))
+
+	events := strings.Split(strings.TrimSpace(responseRecorder.Body.String()), "\n\n")
+	for _, event := range events[:len(events)-1] {
+		test_helpers.AssertEqual(t, true, strings.HasPrefix(event, "event: progress\ndata: {"))
+	}
+	lastEvent := events[len(events)-1]
+	test_helpers.AssertEqual(t, true, strings.HasPrefix(lastEvent, "event: done\ndata: "))
+	var jobStatus map[string]interface{}
+	test_helpers.AssertNilError(t, json.Unmarshal([]byte(strings.TrimPrefix(lastEvent, "event: done\ndata: ")),
+		&jobStatus))
+	test_helpers.AssertEqual(t, "done", jobStatus["status"])
+}
+
+// TestRunDistributedPathTracing tests that a path tracing split across workers on localhost renders the image of a
+// single worker.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRunDistributedPathTracing(t *testing.T) {
+	parameters := `{"raysPerPixel": 2, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 5, "windowEndColumn": 7, "estimator": "unbiased"}`
+	workerAddresses := make([]string, 2)
+	for workerIndex := range workerAddresses {
+		worker := httptest.NewServer(http.HandlerFunc(RunPathTracing))
+		defer worker.Close()
+		workerAddresses[workerIndex] = worker.URL
+	}
+	coordinator, err := distributed.Init(workerAddresses, 2, distributed.DefaultMaxAttempts,
+		distributed.DefaultMaxWorkerFailures, time.Millisecond)
+	test_helpers.AssertNilError(t, err)
+
+	request := httptest.NewRequest(http.MethodPost, "/path-tracing",
+		strings.NewReader(fmt.Sprintf(lightWallRequest, parameters)))
+	distributedRecorder := httptest.NewRecorder()
+	RunDistributedPathTracing(coordinator)(distributedRecorder, request)
+	test_helpers.AssertEqual(t, http.StatusOK, distributedRecorder.Code)
+	test_helpers.AssertEqual(t, jsonFormat, distributedRecorder.Header().Get("Content-Type"))
+
+	request = httptest.NewRequest(http.MethodPost, "/path-tracing",
+		strings.NewReader(fmt.Sprintf(lightWallRequest, parameters)))
+	singleRecorder := httptest.NewRecorder()
+	RunPathTracing(singleRecorder, request)
+	test_helpers.AssertEqual(t, singleRecorder.Body.String(), distributedRecorder.Body.String())
+}
+
+// TestRunDistributedPathTracing_InvalidWindow tests splitting a path tracing of a window out of the screen.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRunDistributedPathTracing_InvalidWindow(t *testing.T) {
+	coordinator, err := distributed.Init([]string{"http://localhost:1"}, 2, 1, 1, time.Millisecond)
+	test_helpers.AssertNilError(t, err)
+	parameters := `{"raysPerPixel": 2, "recursions": 1, "windowStartLine": 0, "windowStartColumn": 0,
+		"windowEndLine": 6, "windowEndColumn": 7}`
+	request := httptest.NewRequest(http.MethodPost, "/path-tracing",
+		strings.NewReader(fmt.Sprintf(lightWallRequest, parameters)))
+	responseRecorder := httptest.NewRecorder()
+	RunDistributedPathTracing(coordinator)(responseRecorder, request)
+	assertErrorResponse(t, responseRecorder, http.StatusUnprocessableEntity, invalidParametersCode)
+}
@@ -1,5 +1,12 @@
 package matrix
 
+import (
+	"math"
+)
+
+// singularTolerance is the absolute value under which a pivot is taken as zero when inverting a Matrix.
+const singularTolerance = 1e-12
+
 // Controller is a class for the Matrix is controller.
 //
 // Members:
@@ -111,3 +118,170 @@ func (*Controller) MultiplyMatrix(firstMatrix *Matrix, secondMatrix *Matrix) (*M
 	}
 	return newMatrix, nil
 }
+
+// BuildTranslation builds the Matrix in homogeneous coordinates that moves 3D points by an offset.
+//
+// Parameters:
+// 	x - The offset along the x axis.
+// 	y - The offset along the y axis.
+// 	z - The offset along the z axis.
+//
+// Returns:
+// 	A 4x4 Matrix.
+//
+func (controller *Controller) BuildTranslation(x, y, z float64) *Matrix {
+	matrix, _ := controller.BuildHomogeneousCoordinates(3)
+	matrix.SetValue(0, 3, x)
+	matrix.SetValue(1, 3, y)
+	matrix.SetValue(2, 3, z)
+	return matrix
+}
+
+// BuildScale builds the Matrix in homogeneous coordinates that scales 3D points from the origin.
+//
+// Parameters:
+// 	x - The factor along the x axis.
+// 	y - The factor along the y axis.
+// 	z - The factor along the z axis.
+//
+// Returns:
+// 	A 4x4 Matrix.
+//
+func (controller *Controller) BuildScale(x, y, z float64) *Matrix {
+	matrix, _ := controller.BuildHomogeneousCoordinates(3)
+	matrix.SetValue(0, 0, x)
+	matrix.SetValue(1, 1, y)
+	matrix.SetValue(2, 2, z)
+	return matrix
+}
+
+// BuildAxisAngleRotation builds the Matrix in homogeneous coordinates that rotates 3D points around an axis through
+// the origin, counterclockwise when the axis points to the viewer.
+//
+// Parameters:
+// 	axis  - The 3 coordinates of the direction of the axis, not necessarily normalized.
+// 	angle - The angle in degrees.
+//
+// Returns:
+// 	A 4x4 Matrix.
+// 	An error.
+//
+func (controller *Controller) BuildAxisAngleRotation(axis []float64, angle float64) (*Matrix, error) {
+	if len(axis) != 3 {
+		return nil, zeroRotationAxisError(axis)
+	}
+	axisNorm := math.Sqrt(axis[0]*axis[0] + axis[1]*axis[1] + axis[2]*axis[2])
+	if axisNorm == 0 || math.IsNaN(axisNorm) || math.IsInf(axisNorm, 0) {
+		return nil, zeroRotationAxisError(axis)
+	}
+	x, y, z := axis[0]/axisNorm, axis[1]/axisNorm, axis[2]/axisNorm
+	sine, cosine := math.Sincos(angle * math.Pi / 180)
+	oneMinusCosine := 1 - cosine
+
+	rotationValues := [][]float64{
+		{cosine + x*x*oneMinusCosine, x*y*oneMinusCosine - z*sine, x*z*oneMinusCosine + y*sine},
+		{y*x*oneMinusCosine + z*sine, cosine + y*y*oneMinusCosine, y*z*oneMinusCosine - x*sine},
+		{z*x*oneMinusCosine - y*sine, z*y*oneMinusCosine + x*sine, cosine + z*z*oneMinusCosine},
+	}
+	matrix, _ := controller.BuildHomogeneousCoordinates(3)
+	for lineIndex := 0; lineIndex < 3; lineIndex++ {
+		for columnIndex := 0; columnIndex < 3; columnIndex++ {
+			matrix.SetValue(lineIndex, columnIndex, rotationValues[lineIndex][columnIndex])
+		}
+	}
+	return matrix, nil
+}
+
+// BuildEulerRotation builds the Matrix in homogeneous coordinates that rotates 3D points around the x axis, then
+// around the y axis and then around the z axis.
+//
+// Parameters:
+// 	x - The angle around the x axis in degrees.
+// 	y - The angle around the y axis in degrees.
+// 	z - The angle around the z axis in degrees.
+//
+// Returns:
+// 	A 4x4 Matrix.
+//
+func (controller *Controller) BuildEulerRotation(x, y, z float64) *Matrix {
+	xRotation, _ := controller.BuildAxisAngleRotation([]float64{1, 0, 0}, x)
+	yRotation, _ := controller.BuildAxisAngleRotation([]float64{0, 1, 0}, y)
+	zRotation, _ := controller.BuildAxisAngleRotation([]float64{0, 0, 1}, z)
+	matrix, _ := controller.Compose(xRotation, yRotation, zRotation)
+	return matrix
+}
+
+// Compose composes transformations into one Matrix, which applies them in order: the first transformation is
+// applied first.
+//
+// Parameters:
+// 	transformations - The matrices of the transformations.
+//
+// Returns:
+// 	The Matrix of the composition.
+// 	An error.
+//
+func (controller *Controller) Compose(transformations ...*Matrix) (*Matrix, error) {
+	if len(transformations) == 0 {
+		return nil, invalidSize(0, 0)
+	}
+	composition := transformations[0]
+	for _, transformation := range transformations[1:] {
+		var err error
+		composition, err = controller.MultiplyMatrix(transformation, composition)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return composition, nil
+}
+
+// Invert inverts a square Matrix by Gauss-Jordan elimination with partial pivoting.
+//
+// Parameters:
+// 	matrix - The Matrix.
+//
+// Returns:
+// 	The inverse Matrix.
+// 	An error.
+//
+func (controller *Controller) Invert(matrix *Matrix) (*Matrix, error) {
+	if matrix.Lines() != matrix.Columns() {
+		return nil, nonSquareMatrixError(matrix)
+	}
+	size := matrix.Lines()
+	values := matrix.CopyAllValues()
+	inverse, _ := controller.BuildIdentity(size)
+	inverseValues := inverse.CopyAllValues()
+
+	for columnIndex := 0; columnIndex < size; columnIndex++ {
+		pivotIndex := columnIndex
+		for lineIndex := columnIndex + 1; lineIndex < size; lineIndex++ {
+			if math.Abs(values[lineIndex][columnIndex]) > math.Abs(values[pivotIndex][columnIndex]) {
+				pivotIndex = lineIndex
+			}
+		}
+		if math.Abs(values[pivotIndex][columnIndex]) <= singularTolerance {
+			return nil, singularMatrixError(matrix)
+		}
+		values[columnIndex], values[pivotIndex] = values[pivotIndex], values[columnIndex]
+		inverseValues[columnIndex], inverseValues[pivotIndex] = inverseValues[pivotIndex], inverseValues[columnIndex]
+
+		pivot := values[columnIndex][columnIndex]
+		for valueIndex := 0; valueIndex < size; valueIndex++ {
+			values[columnIndex][valueIndex] /= pivot
+			inverseValues[columnIndex][valueIndex] /= pivot
+		}
+		for lineIndex := 0; lineIndex < size; lineIndex++ {
+			factor := values[lineIndex][columnIndex]
+			if lineIndex == columnIndex || factor == 0 {
+				continue
+			}
+			for valueIndex := 0; valueIndex < size; valueIndex++ {
+				values[lineIndex][valueIndex] -= factor * values[columnIndex][valueIndex]
+				inverseValues[lineIndex][valueIndex] -= factor * inverseValues[columnIndex][valueIndex]
+			}
+		}
+	}
+	return &Matrix{values: inverseValues}, nil
+}
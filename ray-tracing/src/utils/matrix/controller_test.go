@@ -3,6 +3,7 @@ package matrix
 import (
 	"fmt"
 	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"math"
 	"testing"
 )
 
@@ -250,3 +251,188 @@ func TestMatrixController_MultiplyMatrix_IncompatibleSize(t *testing.T) {
 	_, err = controller.MultiplyMatrix(firstMatrix, secondMatrix)
 	test_helpers.AssertNotNilError(t, err)
 }
+
+// transformPoint applies a Matrix in homogeneous coordinates to a 3D point.
+//
+// Parameters:
+//  t           - Test instance.
+//  matrix      - The Matrix.
+//  coordinates - The coordinates of the point.
+//
+// Returns:
+//  The coordinates of the transformed point.
+//
+func transformPoint(t *testing.T, matrix *Matrix, coordinates []float64) []float64 {
+	pointMatrix, err := Init(4, 1)
+	test_helpers.AssertNilError(t, err)
+	pointMatrix.values = [][]float64{{coordinates[0]}, {coordinates[1]}, {coordinates[2]}, {1}}
+	controller := Controller{}
+	resultingMatrix, err := controller.MultiplyMatrix(matrix, pointMatrix)
+	test_helpers.AssertNilError(t, err)
+	resultingValues := resultingMatrix.CopyAllValues()
+	return []float64{resultingValues[0][0], resultingValues[1][0], resultingValues[2][0]}
+}
+
+// assertCoordinates asserts that coordinates are equal up to the rounding of the trigonometric functions.
+//
+// Parameters:
+//  t                   - Test instance.
+//  expectedCoordinates - The expected coordinates.
+//  coordinates         - The coordinates.
+//
+// Returns:
+//  none
+//
+func assertCoordinates(t *testing.T, expectedCoordinates, coordinates []float64) {
+	for coordinateIndex := range expectedCoordinates {
+		test_helpers.AssertEqual(t, true, math.Abs(expectedCoordinates[coordinateIndex]-coordinates[coordinateIndex]) <
+			1e-9)
+	}
+}
+
+// TestMatrixController_BuildTranslation tests the build of a translation Matrix.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMatrixController_BuildTranslation(t *testing.T) {
+	controller := Controller{}
+	translation := controller.BuildTranslation(1, -2, 3)
+	assertCoordinates(t, []float64{2, -1, 4}, transformPoint(t, translation, []float64{1, 1, 1}))
+}
+
+// TestMatrixController_BuildScale tests the build of a scale Matrix.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMatrixController_BuildScale(t *testing.T) {
+	controller := Controller{}
+	scale := controller.BuildScale(2, 3, -1)
+	assertCoordinates(t, []float64{2, 3, -1}, transformPoint(t, scale, []float64{1, 1, 1}))
+}
+
+// TestMatrixController_BuildAxisAngleRotation tests the build of rotation matrices around an axis.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMatrixController_BuildAxisAngleRotation(t *testing.T) {
+	controller := Controller{}
+	zRotation, err := controller.BuildAxisAngleRotation([]float64{0, 0, 2}, 90)
+	test_helpers.AssertNilError(t, err)
+	assertCoordinates(t, []float64{0, 1, 0}, transformPoint(t, zRotation, []float64{1, 0, 0}))
+
+	diagonalRotation, err := controller.BuildAxisAngleRotation([]float64{1, 1, 1}, 120)
+	test_helpers.AssertNilError(t, err)
+	assertCoordinates(t, []float64{0, 1, 0}, transformPoint(t, diagonalRotation, []float64{1, 0, 0}))
+	assertCoordinates(t, []float64{2, 2, 2}, transformPoint(t, diagonalRotation, []float64{2, 2, 2}))
+}
+
+// TestMatrixController_BuildAxisAngleRotation_ZeroAxis tests the build of a rotation around an axis without a
+// direction.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMatrixController_BuildAxisAngleRotation_ZeroAxis(t *testing.T) {
+	controller := Controller{}
+	_, err := controller.BuildAxisAngleRotation([]float64{0, 0, 0}, 90)
+	test_helpers.AssertNotNilError(t, err)
+	_, err = controller.BuildAxisAngleRotation([]float64{0, 1}, 90)
+	test_helpers.AssertNotNilError(t, err)
+}
+
+// TestMatrixController_BuildEulerRotation tests the build of a rotation around the x, y and z axes in order.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMatrixController_BuildEulerRotation(t *testing.T) {
+	controller := Controller{}
+	rotation := controller.BuildEulerRotation(90, 90, 0)
+	assertCoordinates(t, []float64{1, 0, 0}, transformPoint(t, rotation, []float64{0, 1, 0}))
+	rotation = controller.BuildEulerRotation(0, 0, -90)
+	assertCoordinates(t, []float64{0, -1, 0}, transformPoint(t, rotation, []float64{1, 0, 0}))
+}
+
+// TestMatrixController_Compose tests that a composition applies its transformations in order.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMatrixController_Compose(t *testing.T) {
+	controller := Controller{}
+	scaleThenTranslate, err := controller.Compose(controller.BuildScale(2, 2, 2), controller.BuildTranslation(1, 0, 0))
+	test_helpers.AssertNilError(t, err)
+	assertCoordinates(t, []float64{3, 2, 2}, transformPoint(t, scaleThenTranslate, []float64{1, 1, 1}))
+
+	translateThenScale, err := controller.Compose(controller.BuildTranslation(1, 0, 0), controller.BuildScale(2, 2, 2))
+	test_helpers.AssertNilError(t, err)
+	assertCoordinates(t, []float64{4, 2, 2}, transformPoint(t, translateThenScale, []float64{1, 1, 1}))
+
+	_, err = controller.Compose()
+	test_helpers.AssertNotNilError(t, err)
+}
+
+// TestMatrixController_Invert tests that a Matrix times its inverse is the identity.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMatrixController_Invert(t *testing.T) {
+	controller := Controller{}
+	transformation, err := controller.Compose(controller.BuildScale(2, 0.5, 4),
+		controller.BuildEulerRotation(30, 45, 60), controller.BuildTranslation(1, 2, 3))
+	test_helpers.AssertNilError(t, err)
+
+	inverse, err := controller.Invert(transformation)
+	test_helpers.AssertNilError(t, err)
+	identity, err := controller.MultiplyMatrix(inverse, transformation)
+	test_helpers.AssertNilError(t, err)
+	expectedIdentity, err := controller.BuildIdentity(4)
+	test_helpers.AssertNilError(t, err)
+	for lineIndex, line := range expectedIdentity.CopyAllValues() {
+		assertCoordinates(t, line, identity.CopyAllValues()[lineIndex])
+	}
+}
+
+// TestMatrixController_Invert_Error tests inverting matrices without an inverse.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMatrixController_Invert_Error(t *testing.T) {
+	controller := Controller{}
+	_, err := controller.Invert(controller.BuildScale(1, 0, 1))
+	test_helpers.AssertNotNilError(t, err)
+
+	nonSquareMatrix, err := Init(2, 3)
+	test_helpers.AssertNilError(t, err)
+	_, err = controller.Invert(nonSquareMatrix)
+	test_helpers.AssertNotNilError(t, err)
+}
@@ -69,3 +69,43 @@ func indexError(matrix *Matrix, lineIndex, columnIndex int) error {
 		matrix.Lines(), matrix.Columns(), lineIndex, columnIndex)
 	return errors.New(errorMessage)
 }
+
+// zeroRotationAxisError is the error where a rotation is around an axis without a direction.
+//
+// Parameters:
+//	axis - The coordinates of the axis.
+//
+// Returns:
+//  An Error.
+//
+func zeroRotationAxisError(axis []float64) error {
+	errorMessage := fmt.Sprintf("Invalid rotation axis: %v.", axis)
+	return errors.New(errorMessage)
+}
+
+// nonSquareMatrixError is the error where a Matrix that is not square is inverted.
+//
+// Parameters:
+//	matrix - The Matrix.
+//
+// Returns:
+//  An Error.
+//
+func nonSquareMatrixError(matrix *Matrix) error {
+	errorMessage := fmt.Sprintf("Only square matrices can be inverted. lines: %d and columns: %d.", matrix.Lines(),
+		matrix.Columns())
+	return errors.New(errorMessage)
+}
+
+// singularMatrixError is the error where a Matrix has no inverse.
+//
+// Parameters:
+//	matrix - The Matrix.
+//
+// Returns:
+//  An Error.
+//
+func singularMatrixError(matrix *Matrix) error {
+	errorMessage := fmt.Sprintf("Singular matrix has no inverse: %v.", matrix.CopyAllValues())
+	return errors.New(errorMessage)
+}
@@ -85,3 +85,56 @@ func TestMatrix_IndexError(t *testing.T) {
 	test_helpers.AssertNotNilError(t, err)
 	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
 }
+
+// TestMatrix_ZeroRotationAxisError tests the error where a rotation is around an axis without a direction.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMatrix_ZeroRotationAxisError(t *testing.T) {
+	axis := []float64{0, 0, 0}
+	expectedErrorMessage := fmt.Sprintf("Invalid rotation axis: %v.", axis)
+
+	err := zeroRotationAxisError(axis)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestMatrix_NonSquareMatrixError tests the error where a Matrix that is not square is inverted.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMatrix_NonSquareMatrixError(t *testing.T) {
+	matrix, err := Init(2, 3)
+	test_helpers.AssertNilError(t, err)
+	expectedErrorMessage := "Only square matrices can be inverted. lines: 2 and columns: 3."
+
+	err = nonSquareMatrixError(matrix)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
+
+// TestMatrix_SingularMatrixError tests the error where a Matrix has no inverse.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestMatrix_SingularMatrixError(t *testing.T) {
+	matrix, err := Init(2, 2)
+	test_helpers.AssertNilError(t, err)
+	expectedErrorMessage := "Singular matrix has no inverse: [[0 0] [0 0]]."
+
+	err = singularMatrixError(matrix)
+	test_helpers.AssertNotNilError(t, err)
+	test_helpers.AssertEqual(t, expectedErrorMessage, err.Error())
+}
@@ -0,0 +1,77 @@
+package random_source
+
+// splitMixIncrement is the golden ratio increment of the SplitMix64 generator.
+const splitMixIncrement = 0x9e3779b97f4a7c15
+
+// RandomSource is a class for a SplitMix64 source of random numbers for math/rand, which is cheap to seed so it can
+// be seeded again for every sample.
+//
+// Members:
+// 	state - The state of the generator.
+//
+type RandomSource struct {
+	state uint64
+}
+
+// mix scrambles the bits of a value with the SplitMix64 finalizer.
+//
+// Parameters:
+// 	value - The value.
+//
+// Returns:
+// 	The scrambled value.
+//
+func mix(value uint64) uint64 {
+	value = (value ^ (value >> 30)) * 0xbf58476d1ce4e5b9
+	value = (value ^ (value >> 27)) * 0x94d049bb133111eb
+	return value ^ (value >> 31)
+}
+
+// Uint64 gets the next random number of the RandomSource.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	A random number in [0,2^64).
+//
+func (randomSource *RandomSource) Uint64() uint64 {
+	randomSource.state += splitMixIncrement
+	return mix(randomSource.state)
+}
+
+// Int63 gets the next random number of the RandomSource.
+//
+// Parameters:
+// 	none
+//
+// Returns:
+// 	A random number in [0,2^63).
+//
+func (randomSource *RandomSource) Int63() int64 {
+	return int64(randomSource.Uint64() >> 1)
+}
+
+// Seed restarts the RandomSource from a seed.
+//
+// Parameters:
+// 	seed - The seed.
+//
+// Returns:
+// 	none
+//
+func (randomSource *RandomSource) Seed(seed int64) {
+	randomSource.state = uint64(seed)
+}
+
+// Init initializes a RandomSource.
+//
+// Parameters:
+// 	seed - The seed.
+//
+// Returns:
+// 	A RandomSource.
+//
+func Init(seed int64) *RandomSource {
+	return &RandomSource{state: uint64(seed)}
+}
@@ -0,0 +1,63 @@
+package random_source
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"math/rand"
+	"testing"
+)
+
+// TestRandomSource_Init tests the instantiation of a RandomSource.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRandomSource_Init(t *testing.T) {
+	randomSource := Init(1234567)
+	test_helpers.AssertEqual(t, uint64(6457827717110365317), randomSource.Uint64())
+	test_helpers.AssertEqual(t, uint64(3203168211198807973), randomSource.Uint64())
+}
+
+// TestRandomSource_Seed tests that seeding a RandomSource again repeats its numbers.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRandomSource_Seed(t *testing.T) {
+	randomSource := Init(42)
+	generator := rand.New(randomSource)
+	firstNumbers := []float64{generator.Float64(), generator.Float64(), generator.Float64()}
+
+	randomSource.Seed(42)
+	for _, number := range firstNumbers {
+		test_helpers.AssertEqual(t, number, generator.Float64())
+	}
+	test_helpers.AssertEqual(t, true, randomSource.Int63() >= 0)
+}
+
+// TestRandomSource_Float64 tests that the numbers of a RandomSource are uniform in [0,1).
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestRandomSource_Float64(t *testing.T) {
+	generator := rand.New(Init(7))
+	numberOfSamples := 100000
+	histogram := make([]int, 10)
+	for sampleIndex := 0; sampleIndex < numberOfSamples; sampleIndex++ {
+		number := generator.Float64()
+		test_helpers.AssertEqual(t, true, number >= 0 && number < 1)
+		histogram[int(number*10)]++
+	}
+	for _, count := range histogram {
+		test_helpers.AssertEqual(t, true, count > 9500 && count < 10500)
+	}
+}
@@ -0,0 +1,26 @@
+package random_source
+
+// Controller is a class for controlling random sources.
+//
+// Members:
+// 	none
+//
+type Controller struct {}
+
+// DeriveSeed derives the seed of a stream of random numbers from a base seed and the indexes that identify the
+// stream, such as the pixel and the sample. Different indexes give unrelated streams.
+//
+// Parameters:
+// 	seed    - The base seed.
+// 	indexes - The indexes of the stream.
+//
+// Returns:
+// 	The seed of the stream.
+//
+func (*Controller) DeriveSeed(seed int64, indexes ...int) int64 {
+	derivedSeed := mix(uint64(seed))
+	for _, index := range indexes {
+		derivedSeed = mix(derivedSeed + splitMixIncrement + uint64(index))
+	}
+	return int64(derivedSeed)
+}
@@ -0,0 +1,31 @@
+package random_source
+
+import (
+	"github.com/lucas625/Distributed-Ray-Tracing/ray-tracing/src/test_helpers"
+	"testing"
+)
+
+// TestController_DeriveSeed tests that the derived seeds depend on the base seed and on every index.
+//
+// Parameters:
+//  t - Test instance.
+//
+// Returns:
+//  none
+//
+func TestController_DeriveSeed(t *testing.T) {
+	controller := Controller{}
+	seed := controller.DeriveSeed(5, 1, 2, 3)
+	test_helpers.AssertEqual(t, seed, controller.DeriveSeed(5, 1, 2, 3))
+
+	otherSeeds := []int64{
+		controller.DeriveSeed(6, 1, 2, 3),
+		controller.DeriveSeed(5, 2, 1, 3),
+		controller.DeriveSeed(5, 1, 2, 4),
+		controller.DeriveSeed(5, 1, 2),
+		controller.DeriveSeed(5),
+	}
+	for _, otherSeed := range otherSeeds {
+		test_helpers.AssertEqual(t, false, seed == otherSeed)
+	}
+}
@@ -13,7 +13,7 @@ _MAX_PATH_TRACING_PARAMETERS = dict(
     width=1920,
     height=1080,
     raysPerPixel=1000,
-    recursions=5
+    recursions=64
 )
 
 